@@ -1,22 +1,572 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
+	"go.uber.org/zap/zapcore"
 )
 
-// LoadEnv load YAML file using Viper
-func LoadEnv() error {
-	// Set the file name and type
-	viper.SetConfigName("config") // config.yaml
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath(".") // Look for the config file in the current directory
+// EnvPrefix prefixes the environment variables overriding the configuration,
+// e.g. KAPI_API_SERVICE_PORT overrides api_service.port.
+const EnvPrefix = "KAPI"
 
-	// Read the config file
-	if err := viper.ReadInConfig(); err != nil {
-		return fmt.Errorf("error reading config file: %v", err)
+// Config is the application configuration.
+type Config struct {
+	Stage      string           `mapstructure:"stage"`
+	Fake       FakeConfig       `mapstructure:"fake"`
+	JWT        JWTConfig        `mapstructure:"jwt"`
+	OAuth2     OAuth2Config     `mapstructure:"oauth2"`
+	Log        LogConfig        `mapstructure:"log"`
+	Clusters   ClustersConfig   `mapstructure:"clusters"`
+	UIService  UIServiceConfig  `mapstructure:"ui_service"`
+	Events     EventsConfig     `mapstructure:"events"`
+	Database   DatabaseConfig   `mapstructure:"database"`
+	RateLimit  RateLimitConfig  `mapstructure:"rate_limit"`
+	APIService APIServiceConfig `mapstructure:"api_service"`
+	Metrics    MetricsConfig    `mapstructure:"metrics"`
+	Tracing    TracingConfig    `mapstructure:"tracing"`
+}
+
+type UIServiceConfig struct {
+	AllowOrigin  string   `mapstructure:"allow_origin"`
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
+// Origins returns the origins allowed by CORS, allow_origin included.
+func (rc UIServiceConfig) Origins() []string {
+	origins := make([]string, 0, len(rc.AllowOrigins)+1)
+	if rc.AllowOrigin != "" {
+		origins = append(origins, rc.AllowOrigin)
+	}
+
+	return append(origins, rc.AllowOrigins...)
+}
+
+// APIServiceConfig sets the port of the API and how long it waits on shutdown and readiness checks.
+// ShutdownDelay keeps serving after reporting not ready, so the load balancers stop routing to the API
+// before it stops accepting connections. MaxReplicas bounds the replicas a deployment can be created or scaled to.
+type APIServiceConfig struct {
+	Port             int           `mapstructure:"port"`
+	MaxReplicas      int32         `mapstructure:"max_replicas"`
+	ShutdownDelay    time.Duration `mapstructure:"shutdown_delay"`
+	ShutdownTimeout  time.Duration `mapstructure:"shutdown_timeout"`
+	ReadinessTimeout time.Duration `mapstructure:"readiness_timeout"`
+}
+
+// MetricsConfig exposes the Prometheus metrics on path, on a separate port unless it is 0, in which
+// case they are served on the API to the administrators only.
+type MetricsConfig struct {
+	Path    string `mapstructure:"path"`
+	Port    int    `mapstructure:"port"`
+	Enabled bool   `mapstructure:"enabled"`
+}
+
+// TracingConfig exports the spans to an OpenTelemetry collector over OTLP/HTTP.
+type TracingConfig struct {
+	Headers     map[string]string `mapstructure:"headers"`
+	Endpoint    string            `mapstructure:"endpoint"`
+	ServiceName string            `mapstructure:"service_name"`
+	SampleRatio float64           `mapstructure:"sample_ratio"`
+	Enabled     bool              `mapstructure:"enabled"`
+	Insecure    bool              `mapstructure:"insecure"`
+}
+
+// LogConfig sets the level and format of the logs. The values of the query parameters
+// named in Redact are masked in the request logs.
+type LogConfig struct {
+	Level  string   `mapstructure:"level"`
+	Format string   `mapstructure:"format"`
+	Redact []string `mapstructure:"redact"`
+}
+
+// RateLimitConfig limits the requests of each client IP, a zero rate disables the limit.
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	Burst             int     `mapstructure:"burst"`
+}
+
+type DatabaseConfig struct {
+	Memory           MemoryConfig  `mapstructure:"memory"`
+	Driver           string        `mapstructure:"driver"`
+	Host             string        `mapstructure:"host"`
+	Name             string        `mapstructure:"name"`
+	User             string        `mapstructure:"user"`
+	Password         string        `mapstructure:"password"`
+	PasswordFile     string        `mapstructure:"password_file"`
+	SSLMode          string        `mapstructure:"sslmode"`
+	SSLRootCert      string        `mapstructure:"ssl_root_cert"`
+	Pool             PoolConfig    `mapstructure:"pool"`
+	Connect          ConnectConfig `mapstructure:"connect"`
+	Port             int           `mapstructure:"port"`
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+	AutoMigrate      bool          `mapstructure:"auto_migrate"`
+}
+
+type MemoryConfig struct {
+	Admin AdminConfig `mapstructure:"admin"`
+}
+
+type AdminConfig struct {
+	Username string `mapstructure:"username"`
+	Email    string `mapstructure:"email"`
+	Password string `mapstructure:"password"`
+}
+
+type PoolConfig struct {
+	Size        int           `mapstructure:"size"`
+	MinIdle     int           `mapstructure:"min_idle"`
+	MaxConnAge  time.Duration `mapstructure:"max_conn_age"`
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+}
+
+type ConnectConfig struct {
+	Attempts   int           `mapstructure:"attempts"`
+	Backoff    time.Duration `mapstructure:"backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+}
+
+type FakeConfig struct {
+	FixturesDir string `mapstructure:"fixtures_dir"`
+}
+
+type ClustersConfig struct {
+	Default       string          `mapstructure:"default"`
+	EncryptionKey string          `mapstructure:"encryption_key"`
+	Items         []ClusterConfig `mapstructure:"items"`
+	HealthTimeout time.Duration   `mapstructure:"health_timeout"`
+}
+
+type ClusterConfig struct {
+	Name       string `mapstructure:"name"`
+	Kubeconfig string `mapstructure:"kubeconfig"`
+	Context    string `mapstructure:"context"`
+}
+
+type EventsConfig struct {
+	Retention RetentionConfig `mapstructure:"retention"`
+}
+
+type RetentionConfig struct {
+	ArchiveDir string        `mapstructure:"archive_dir"`
+	HotDays    int           `mapstructure:"hot_days"`
+	BatchSize  int           `mapstructure:"batch_size"`
+	Interval   time.Duration `mapstructure:"interval"`
+}
+
+type JWTConfig struct {
+	PrivateKey string `mapstructure:"private_key"`
+	TokenTTL   int    `mapstructure:"token_ttl"`
+}
+
+type OAuth2Config struct {
+	Google OAuth2ProviderConfig `mapstructure:"google"`
+	Github OAuth2ProviderConfig `mapstructure:"github"`
+}
+
+type OAuth2ProviderConfig struct {
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	RedirectURL  string `mapstructure:"redirect_url"`
+}
+
+// defaults are applied below the configuration file and the environment.
+var defaults = map[string]any{
+	"stage":                          "dev",
+	"ui_service.allow_origin":        "",
+	"ui_service.allow_origins":       []string{},
+	"api_service.port":               8080,
+	"api_service.max_replicas":       100,
+	"api_service.shutdown_delay":     "10s",
+	"api_service.shutdown_timeout":   "30s",
+	"api_service.readiness_timeout":  "2s",
+	"metrics.enabled":                true,
+	"metrics.path":                   "/metrics",
+	"metrics.port":                   9090,
+	"tracing.enabled":                false,
+	"tracing.endpoint":               "localhost:4318",
+	"tracing.insecure":               true,
+	"tracing.service_name":           "kubernetes-api",
+	"tracing.sample_ratio":           1.0,
+	"tracing.headers":                map[string]string{},
+	"log.level":                      "info",
+	"log.format":                     "json",
+	"log.redact":                     []string{"code", "state", "token", "access_token", "password", "client_secret"},
+	"rate_limit.requests_per_second": 0,
+	"rate_limit.burst":               0,
+	"database.driver":                "postgres",
+	"database.auto_migrate":          true,
+	"database.host":                  "",
+	"database.port":                  5432,
+	"database.name":                  "kubernetes-api",
+	"database.user":                  "postgres",
+	"database.password":              "",
+	"database.password_file":         "",
+	"database.sslmode":               "disable",
+	"database.ssl_root_cert":         "",
+	"database.statement_timeout":     "0s",
+	"database.pool.size":             0,
+	"database.pool.min_idle":         0,
+	"database.pool.max_conn_age":     "0s",
+	"database.pool.idle_timeout":     "0s",
+	"database.pool.timeout":          "0s",
+	"database.connect.attempts":      5,
+	"database.connect.backoff":       "1s",
+	"database.connect.max_backoff":   "30s",
+	"database.memory.admin.username": "",
+	"database.memory.admin.email":    "",
+	"database.memory.admin.password": "",
+	"fake.fixtures_dir":              "./fixtures",
+	"clusters.default":               "default",
+	"clusters.encryption_key":        "",
+	"clusters.health_timeout":        "5s",
+	"clusters.items":                 []ClusterConfig{},
+	"events.retention.hot_days":      0,
+	"events.retention.interval":      "1h",
+	"events.retention.batch_size":    1000,
+	"events.retention.archive_dir":   "./archive",
+	"jwt.private_key":                "",
+	"jwt.token_ttl":                  86400,
+	"oauth2.google.client_id":        "",
+	"oauth2.google.client_secret":    "",
+	"oauth2.google.redirect_url":     "",
+	"oauth2.github.client_id":        "",
+	"oauth2.github.client_secret":    "",
+	"oauth2.github.redirect_url":     "",
+}
+
+// pgEnv are the libpq variables also overriding the database settings, after the KAPI_ ones.
+// PGPASSWORD_FILE reads the password from a mounted secret.
+var pgEnv = map[string]string{
+	"database.host":          "PGHOST",
+	"database.port":          "PGPORT",
+	"database.name":          "PGDATABASE",
+	"database.user":          "PGUSER",
+	"database.password":      "PGPASSWORD",
+	"database.password_file": "PGPASSWORD_FILE",
+	"database.sslmode":       "PGSSLMODE",
+	"database.ssl_root_cert": "PGSSLROOTCERT",
+}
+
+var (
+	current   atomic.Pointer[Config]
+	reloadMu  sync.Mutex
+	listeners []func(*Config)
+)
+
+// Get returns the loaded configuration, the defaults until Load is called.
+func Get() *Config {
+	if cfg := current.Load(); cfg != nil {
+		return cfg
+	}
+
+	cfg, err := decode(newViper())
+	if err != nil {
+		log.Fatalf("invalid default configuration: %v", err)
+	}
+
+	return cfg
+}
+
+// Load reads the configuration from the defaults, the YAML file and the environment, in increasing precedence.
+// The file is path, or ./config.yaml when path is empty, in which case it may also be missing.
+func Load(path string) (*Config, error) {
+	return load(path, (*Config).Validate)
+}
+
+// LoadDatabase reads the configuration like Load, only checking the database section. It serves the
+// migrate subcommand, which doesn't need the settings of the API.
+func LoadDatabase(path string) (*Config, error) {
+	return load(path, (*Config).ValidateDatabase)
+}
+
+func load(path string, validate func(*Config) error) (*Config, error) {
+	v := viper.GetViper()
+	setDefaults(v)
+
+	if path != "" {
+		v.SetConfigFile(path)
+	} else {
+		v.SetConfigName("config") // config.yaml
+		v.SetConfigType("yaml")
+		v.AddConfigPath(".") // Look for the config file in the current directory
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if path != "" || !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("error reading config file: %v", err)
+		}
+
+		log.Println("No config.yaml found, using the defaults and the environment")
+	}
+
+	cfg, err := decode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(cfg); err != nil {
+		return nil, err
+	}
+
+	current.Store(cfg)
+
+	return cfg, nil
+}
+
+// OnReload registers fn to be called with the configuration after each reload.
+func OnReload(fn func(*Config)) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	listeners = append(listeners, fn)
+}
+
+// Watch reloads the configuration file when it changes.
+// Only the log level and redacted parameters, the CORS origins and the rate limit are applied, other changes need a restart.
+// An invalid file is ignored and the running configuration kept.
+func Watch() {
+	v := viper.GetViper()
+	if v.ConfigFileUsed() == "" {
+		return
+	}
+
+	v.OnConfigChange(func(e fsnotify.Event) {
+		next, err := decode(v)
+		if err == nil {
+			err = next.Validate()
+		}
+		if err != nil {
+			log.Printf("Ignoring the changes of %s: %v", e.Name, err)
+			return
+		}
+
+		reload(next)
+	})
+	v.WatchConfig()
+}
+
+// reload applies the reloadable settings of next to the running configuration.
+func reload(next *Config) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	cfg := *Get()
+	cfg.Log.Level = next.Log.Level
+	cfg.Log.Redact = next.Log.Redact
+	cfg.UIService = next.UIService
+	cfg.RateLimit = next.RateLimit
+
+	if !reflect.DeepEqual(&cfg, next) {
+		log.Println("Configuration changes other than log.level, log.redact, ui_service and rate_limit need a restart to apply")
+	}
+
+	current.Store(&cfg)
+	log.Println("Configuration reloaded")
+
+	for _, fn := range listeners {
+		fn(&cfg)
+	}
+}
+
+// Validate checks the configuration, listing every problem found.
+func (rc *Config) Validate() error {
+	var problems []string
+	addProblem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch rc.Stage {
+	case "dev", "prod", "fake":
+	default:
+		addProblem("stage must be dev, prod or fake, got %q", rc.Stage)
+	}
+
+	if rc.APIService.Port < 1 || rc.APIService.Port > 65535 {
+		addProblem("api_service.port must be between 1 and 65535, got %d", rc.APIService.Port)
+	}
+
+	if rc.APIService.ShutdownTimeout <= 0 || rc.APIService.ReadinessTimeout <= 0 {
+		addProblem("api_service.shutdown_timeout and api_service.readiness_timeout must be positive")
+	}
+	if rc.APIService.ShutdownDelay < 0 {
+		addProblem("api_service.shutdown_delay can't be negative")
+	}
+
+	if rc.APIService.MaxReplicas < 1 {
+		addProblem("api_service.max_replicas must be positive, got %d", rc.APIService.MaxReplicas)
+	}
+
+	if rc.Metrics.Enabled {
+		if !strings.HasPrefix(rc.Metrics.Path, "/") {
+			addProblem("metrics.path must start with /, got %q", rc.Metrics.Path)
+		}
+		if rc.Metrics.Port < 0 || rc.Metrics.Port > 65535 || rc.Metrics.Port == rc.APIService.Port {
+			addProblem("metrics.port must be 0 or another port than api_service.port, got %d", rc.Metrics.Port)
+		}
+	}
+
+	if rc.Tracing.Enabled {
+		if rc.Tracing.Endpoint == "" || rc.Tracing.ServiceName == "" {
+			addProblem("tracing.endpoint and tracing.service_name are required when tracing")
+		}
+		if rc.Tracing.SampleRatio < 0 || rc.Tracing.SampleRatio > 1 {
+			addProblem("tracing.sample_ratio must be between 0 and 1, got %v", rc.Tracing.SampleRatio)
+		}
+	}
+
+	if _, err := zapcore.ParseLevel(rc.Log.Level); err != nil {
+		addProblem("log.level must be debug, info, warn or error, got %q", rc.Log.Level)
+	}
+
+	if rc.Log.Format != "json" && rc.Log.Format != "console" {
+		addProblem("log.format must be json or console, got %q", rc.Log.Format)
+	}
+
+	if rc.RateLimit.RequestsPerSecond < 0 || rc.RateLimit.Burst < 0 {
+		addProblem("rate_limit.requests_per_second and rate_limit.burst can't be negative")
+	}
+
+	problems = append(problems, rc.Database.problems()...)
+
+	if rc.Clusters.Default == "" {
+		addProblem("clusters.default is required")
+	}
+	if rc.Clusters.HealthTimeout <= 0 {
+		addProblem("clusters.health_timeout must be positive")
+	}
+
+	names := map[string]bool{rc.Clusters.Default: true}
+	for i, v := range rc.Clusters.Items {
+		if v.Name == "" || v.Kubeconfig == "" {
+			addProblem("clusters.items[%d] needs a name and a kubeconfig", i)
+		} else if names[v.Name] {
+			addProblem("clusters.items[%d] duplicates the cluster name %q", i, v.Name)
+		}
+		names[v.Name] = true
+	}
+
+	if retention := rc.Events.Retention; retention.HotDays < 0 {
+		addProblem("events.retention.hot_days can't be negative")
+	} else if retention.HotDays > 0 {
+		if retention.Interval <= 0 || retention.BatchSize <= 0 {
+			addProblem("events.retention.interval and events.retention.batch_size must be positive when archiving")
+		}
+		if retention.ArchiveDir == "" {
+			addProblem("events.retention.archive_dir is required when archiving")
+		}
+	}
+
+	if rc.JWT.PrivateKey == "" {
+		addProblem("jwt.private_key is required")
+	}
+	if rc.JWT.TokenTTL <= 0 {
+		addProblem("jwt.token_ttl must be positive")
+	}
+
+	if len(problems) != 0 {
+		return &ValidationError{Problems: problems}
 	}
 
 	return nil
 }
+
+// ValidateDatabase checks the database section of the configuration only.
+func (rc *Config) ValidateDatabase() error {
+	if problems := rc.Database.problems(); len(problems) != 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func (rc DatabaseConfig) problems() []string {
+	var problems []string
+	addProblem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch rc.Driver {
+	case "memory":
+		if rc.Memory.Admin.Username != "" && rc.Memory.Admin.Password == "" {
+			addProblem("database.memory.admin.password is required with database.memory.admin.username")
+		}
+	case "postgres":
+		if rc.Port < 1 || rc.Port > 65535 {
+			addProblem("database.port must be between 1 and 65535, got %d", rc.Port)
+		}
+		if rc.Name == "" || rc.User == "" {
+			addProblem("database.name and database.user are required")
+		}
+		// no default password, a forgotten setting would connect with a well known one
+		if rc.Password == "" && rc.PasswordFile == "" {
+			addProblem("database.password or database.password_file is required")
+		}
+		switch rc.SSLMode {
+		case "disable", "require", "verify-ca", "verify-full":
+		default:
+			addProblem("database.sslmode must be disable, require, verify-ca or verify-full, got %q", rc.SSLMode)
+		}
+		if rc.Connect.Attempts < 1 {
+			addProblem("database.connect.attempts must be at least 1, got %d", rc.Connect.Attempts)
+		}
+		if rc.Pool.Size < 0 || rc.Pool.MinIdle < 0 {
+			addProblem("database.pool.size and database.pool.min_idle can't be negative")
+		}
+	default:
+		addProblem("database.driver must be postgres or memory, got %q", rc.Driver)
+	}
+
+	return problems
+}
+
+// ValidationError lists the problems of an invalid configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (rc *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(rc.Problems, "\n  - ")
+}
+
+func newViper() *viper.Viper {
+	v := viper.New()
+	setDefaults(v)
+
+	return v
+}
+
+// setDefaults registers the defaults and the environment variables of every setting.
+func setDefaults(v *viper.Viper) {
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	for key, env := range pgEnv {
+		// binding replaces the automatic name, list it first to keep its precedence
+		_ = v.BindEnv(key, EnvPrefix+"_"+strings.ToUpper(strings.ReplaceAll(key, ".", "_")), env)
+	}
+}
+
+func decode(v *viper.Viper) (*Config, error) {
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("error decoding configuration: %v", err)
+	}
+
+	return &cfg, nil
+}
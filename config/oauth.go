@@ -1,7 +1,6 @@
 package config
 
 import (
-	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
@@ -16,9 +15,9 @@ var AppConfig OAuthConfig
 
 func GoogleConfig() oauth2.Config {
 	AppConfig.GoogleLoginConfig = oauth2.Config{
-		RedirectURL:  viper.GetString("oauth2.google.redirect_url"),
-		ClientID:     viper.GetString("oauth2.google.client_id"),
-		ClientSecret: viper.GetString("oauth2.google.client_secret"),
+		RedirectURL:  Get().OAuth2.Google.RedirectURL,
+		ClientID:     Get().OAuth2.Google.ClientID,
+		ClientSecret: Get().OAuth2.Google.ClientSecret,
 		Scopes: []string{
 			"https://www.googleapis.com/auth/userinfo.email",
 			"https://www.googleapis.com/auth/userinfo.profile",
@@ -31,9 +30,9 @@ func GoogleConfig() oauth2.Config {
 
 func GithubConfig() oauth2.Config {
 	AppConfig.GitHubLoginConfig = oauth2.Config{
-		RedirectURL:  viper.GetString("oauth2.github.redirect_url"),
-		ClientID:     viper.GetString("oauth2.github.client_id"),
-		ClientSecret: viper.GetString("oauth2.github.client_secret"),
+		RedirectURL:  Get().OAuth2.Github.RedirectURL,
+		ClientID:     Get().OAuth2.Github.ClientID,
+		ClientSecret: Get().OAuth2.Github.ClientSecret,
 		Scopes:       []string{"user", "repo"},
 		Endpoint:     github.Endpoint,
 	}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxManifestBytes bounds the manifests read from the requests.
+const maxManifestBytes = 10 * 1024 * 1024
+
+type ApplyHandler struct {
+	applyUC *uc.ApplyUC
+}
+
+func NewApplyHandler(applyUC *uc.ApplyUC) *ApplyHandler {
+	return &ApplyHandler{
+		applyUC: applyUC,
+	}
+}
+
+// Apply godoc
+//
+//	@Summary		Apply manifests
+//	@Description	Server-side applies the objects of multi-document YAML or JSON list manifests, of any kind the cluster serves.
+//	@Description	Namespaces and custom resource definitions are applied first, and every object is reported as created, configured, unchanged or failed.
+//	@Description	With prune, the objects of the applied kinds matching the label selector but missing from the manifests are deleted, unless an object failed.
+//	@Description	The response is 207 when an object failed.
+//	@Tags			apply
+//	@Accept			application/yaml
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string										false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string										false	"Namespace of the objects not naming one, default when empty"
+//	@Param			fieldManager	query		string										false	"Name of the actor making the changes, kubernetes-api when empty"
+//	@Param			force			query		bool										false	"Take over the fields managed by others"
+//	@Param			dryRun			query		string										false	"true or All to only run the apply"
+//	@Param			prune			query		string										false	"Label selector of the objects to delete when missing from the manifests"
+//	@Param			manifests		body		string										true	"The manifests"
+//	@Success		200				{object}	SuccessResponse{data=model.ApplyResultList}	"Result of every object"
+//	@Success		207				{object}	SuccessResponse{data=model.ApplyResultList}	"Result of every object, some failed"
+//	@Failure		400				{object}	FailureResponse								"Invalid manifests or parameters"
+//	@Failure		413				{object}	FailureResponse								"The manifests are too large"
+//	@Failure		500				{object}	FailureResponse								"Interval error"
+//	@Router			/apply [post]
+func (rc *ApplyHandler) Apply(c echo.Context) error {
+	manifests, err := readBody(c, maxManifestBytes)
+	if err != nil {
+		return newFailure(err, "Invalid manifests", "Invalid manifests. Please check the request body and try again.")
+	}
+
+	force, err := getBoolQuery(c, "force")
+	if err != nil {
+		return newFailure(err, "Invalid apply parameters", "Invalid parameters. Please check them and try again.")
+	}
+
+	dryRun, _, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid apply parameters", "Invalid parameters. Please check them and try again.")
+	}
+
+	opts := model.ApplyOptions{
+		Namespace:    c.QueryParam("namespace"),
+		FieldManager: c.QueryParam("fieldManager"),
+		Prune:        c.QueryParam("prune"),
+		DryRun:       dryRun,
+		Force:        force != nil && *force,
+	}
+	if opts.FieldManager == "" {
+		opts.FieldManager = defaultFieldManager
+	}
+
+	list, err := rc.applyUC.Apply(c.Request().Context(), manifests, opts)
+	if err != nil {
+		return newFailure(err, "Failed to apply manifests", "Error applying the manifests. Please check them and try again.")
+	}
+
+	if list.Failed() {
+		return c.JSON(http.StatusMultiStatus, SuccessResponse{
+			Data:    list,
+			Message: "Some objects could not be applied, see their results.",
+		})
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list,
+		Message: "Manifests applied successfully.",
+	})
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// getBulkDeleteOpts returns the options of the bulk deletions, evict is only read when the objects can be evicted.
+func getBulkDeleteOpts(c echo.Context, evictable bool) (model.BulkDeleteOptions, error) {
+	opts := model.BulkDeleteOptions{
+		LabelSelector:     c.QueryParam("labelSelector"),
+		Action:            model.BulkActionDelete,
+		ConfirmationToken: c.QueryParam("confirm"),
+	}
+
+	propagationPolicy, err := getPropagationPolicy(c)
+	if err != nil {
+		return opts, err
+	}
+	opts.PropagationPolicy = propagationPolicy
+
+	dryRun, _, err := getDryRun(c)
+	if err != nil {
+		return opts, err
+	}
+	opts.DryRun = dryRun
+
+	if evictable {
+		evict, err := getBoolQuery(c, "evict")
+		if err != nil {
+			return opts, err
+		}
+		if evict != nil && *evict {
+			opts.Action = model.BulkActionEvict
+		}
+	}
+
+	return opts, nil
+}
+
+// bulkPreviewResponse answers the preview of a bulk deletion, asking to confirm it with the token.
+func bulkPreviewResponse(c echo.Context, preview *model.BulkDeletePreview, resources string) error {
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data: preview,
+		Message: fmt.Sprintf("%d %s match, nothing was changed. Send the request again with confirm set to the confirmation token to %s them.",
+			len(preview.Items), resources, preview.Action),
+	})
+}
+
+// bulkResultResponse answers the result of a confirmed bulk deletion, the objects failing to delete come with their error.
+func bulkResultResponse(c echo.Context, result *model.BulkDeleteResult, resources string, dryRun []string) error {
+	past := "deleted"
+	if result.Action == model.BulkActionEvict {
+		past = "evicted"
+	}
+
+	message := fmt.Sprintf("%d %s %s.", result.Succeeded, resources, past)
+	if result.Failed > 0 {
+		message = fmt.Sprintf("%d %s %s, %d failed. Please check the errors of the items.", result.Succeeded, resources, past, result.Failed)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    result,
+		Message: dryRunMessage(message, dryRun),
+	})
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ClusterHandler struct {
+	clusterUC *uc.ClusterUC
+}
+
+func NewClusterHandler(clusterUC *uc.ClusterUC) *ClusterHandler {
+	return &ClusterHandler{
+		clusterUC: clusterUC,
+	}
+}
+
+// Create godoc
+//
+//	@Summary		Register a cluster
+//	@Description	Registers a Kubernetes cluster from a kubeconfig embedding its credentials, file paths, exec and auth-provider are rejected. The kubeconfig is stored encrypted and never returned.
+//	@Tags			clusters
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			body		model.ClusterRequest	true	"Cluster request body"
+//	@Success		201				{object}	SuccessResponse			"Successfully registered cluster"
+//	@Failure		400				{object}	FailureResponse			"Bad request or error message"
+//	@Failure		409				{object}	FailureResponse			"Already exists"
+//	@Failure		422				{object}	FailureResponse			"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse			"Interval error"
+//	@Router			/clusters [post]
+func (rc *ClusterHandler) Create(c echo.Context) error {
+	var request model.ClusterRequest
+
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, FailureResponse{
+			Error:   fmt.Sprintf("Failed to bind cluster request: %v", err),
+			Message: "Invalid input. Please verify the data and try again.",
+		})
+	}
+
+	cluster, err := rc.clusterUC.Create(c.Request().Context(), request)
+	if err != nil {
+		return newFailure(err, "Failed to register cluster", "There was an error registering the cluster. Please verify the kubeconfig and try again.")
+	}
+
+	return c.JSON(http.StatusCreated, SuccessResponse{
+		Data:    cluster.Name,
+		Message: "Cluster registered successfully.",
+	})
+}
+
+// List godoc
+//
+//	@Summary		List clusters
+//	@Description	Retrieves every cluster the API can manage, with the health of its API server.
+//	@Tags			clusters
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Success		200				{object}	SuccessResponse	"List of clusters"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Router			/clusters [get]
+func (rc *ClusterHandler) List(c echo.Context) error {
+	list, err := rc.clusterUC.List(c.Request().Context())
+	if err != nil {
+		return newFailure(err, "Failed to retrieve clusters", "There was an error retrieving the list of clusters. Please try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list,
+		Message: "Clusters retrieved successfully.",
+	})
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a cluster
+//	@Description	Removes a cluster registered through the API. Clusters defined in the configuration cannot be deleted.
+//	@Tags			clusters
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			path		string			true	"Name of the cluster"
+//	@Success		200				{object}	SuccessResponse	"Success message"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Router			/clusters/{cluster} [delete]
+func (rc *ClusterHandler) Delete(c echo.Context) error {
+	name := c.Param("cluster")
+
+	if err := rc.clusterUC.Delete(c.Request().Context(), name); err != nil {
+		return newFailure(err, "Failed to delete cluster", "Error deleting cluster. Please check the name and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Cluster deleted successfully.",
+	})
+}
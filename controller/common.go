@@ -1,9 +1,12 @@
 package controller
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/uc"
 
 	"github.com/labstack/echo/v4"
 )
@@ -13,9 +16,37 @@ type SuccessResponse struct {
 	Message string      `json:"message"`
 }
 
+// FailureResponse is the body of the error responses. Code is the stable, machine readable
+// kind of the error and Causes list the rejected fields of invalid requests.
 type FailureResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Error   string          `json:"error"`
+	Message string          `json:"message"`
+	Code    uc.ErrorCode    `json:"code,omitempty"`
+	Causes  []uc.ErrorCause `json:"causes,omitempty"`
+	TraceID string          `json:"trace_id,omitempty"`
+}
+
+// JSONSerializer adds the trace ID of the request to the failure responses, to find their trace from an error report,
+// and the code of their status when they have none.
+// The error of the failure responses is kept on the context to be logged with the request.
+type JSONSerializer struct {
+	echo.DefaultJSONSerializer
+}
+
+func (rc JSONSerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	if failure, ok := i.(FailureResponse); ok {
+		c.Set(pkg.LogErrorKey, failure.Error)
+
+		if failure.TraceID == "" {
+			failure.TraceID = pkg.TraceID(c.Request().Context())
+		}
+		if failure.Code == "" {
+			failure.Code = codeOfStatus(c.Response().Status)
+		}
+		i = failure
+	}
+
+	return rc.DefaultJSONSerializer.Serialize(c, i, indent)
 }
 
 type AuthResponse struct {
@@ -71,3 +102,23 @@ func getKubeListOpts(c echo.Context) model.ListOptions {
 		Limit:         int64(limit),
 	}
 }
+
+// getClusterEventListOpts returns list options for cluster events, narrowed to the requested event type.
+func getClusterEventListOpts(c echo.Context) (model.ListOptions, error) {
+	opts := getKubeListOpts(c)
+
+	if eventType := c.QueryParam("type"); eventType != "" {
+		// only the known types, the value ends up in the field selector
+		if eventType != model.ClusterEventTypeNormal && eventType != model.ClusterEventTypeWarning {
+			return opts, uc.NewError(uc.CodeBadRequest, "type must be %s or %s, got %s", model.ClusterEventTypeNormal, model.ClusterEventTypeWarning, eventType)
+		}
+
+		typeSelector := fmt.Sprintf("type=%s", eventType)
+		if opts.FieldSelector != "" {
+			typeSelector = opts.FieldSelector + "," + typeSelector
+		}
+		opts.FieldSelector = typeSelector
+	}
+
+	return opts, nil
+}
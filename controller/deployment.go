@@ -12,11 +12,13 @@ import (
 
 type DeploymentHandler struct {
 	deploymentUC *uc.DeploymentUC
+	exportUC     *uc.ExportUC
 }
 
-func NewDeploymentHandler(deploymentUC *uc.DeploymentUC) *DeploymentHandler {
+func NewDeploymentHandler(deploymentUC *uc.DeploymentUC, exportUC *uc.ExportUC) *DeploymentHandler {
 	return &DeploymentHandler{
 		deploymentUC: deploymentUC,
+		exportUC:     exportUC,
 	}
 }
 
@@ -24,14 +26,23 @@ func NewDeploymentHandler(deploymentUC *uc.DeploymentUC) *DeploymentHandler {
 //
 //	@Summary		Create a new deployment
 //	@Description	Creates a new deployment in the Kubernetes cluster.
+//	@Description	The config maps, secrets and keys referenced by the environment of its containers but missing from the namespace are returned as warnings.
+//	@Description	With diff, nothing is created and the deployment computed by the API server is returned as a diff.
 //	@Tags			deployments
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string							true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			deployment		body		model.DeploymentCreateRequest	true	"Deployment request body"
-//	@Success		201				{object}	map[string]string				"Suxccessfully created deployment"
-//	@Failure		400				{object}	FailureResponse					"Bad request or error message"
-//	@Failure		500				{object}	FailureResponse					"Interval error"
+//	@Param			Authorization	header		string								true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string								false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			dryRun			query		string								false	"true or All to only run the creation"
+//	@Param			diff			query		bool								false	"Dry run the creation and return the diff"
+//	@Param			deployment		body		model.DeploymentCreateRequest		true	"Deployment request body"
+//	@Success		200				{object}	SuccessResponse{data=model.Diff}	"Diff of the dry run"
+//	@Success		201				{object}	map[string]string					"Suxccessfully created deployment"
+//	@Failure		400				{object}	FailureResponse						"Bad request or error message"
+//	@Failure		409				{object}	FailureResponse						"Already exists"
+//	@Failure		422				{object}	FailureResponse						"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse						"Interval error"
+//	@Failure		504				{object}	FailureResponse						"The API server timed out"
 //	@Router			/deployments [post]
 func (rc *DeploymentHandler) Create(c echo.Context) error {
 	var request model.DeploymentCreateRequest
@@ -43,17 +54,30 @@ func (rc *DeploymentHandler) Create(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid deployment request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
 	deployment, err := rc.deploymentUC.Create(c.Request().Context(), &request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to create deployment: %v", err),
-			Message: "There was an error creating the deployment. Please check your data and try again.",
-		})
+		return newFailure(err, "Failed to create deployment", "There was an error creating the deployment. Please check your data and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, nil, deployment)
 	}
 
 	return c.JSON(http.StatusCreated, SuccessResponse{
 		Data:    deployment.Name,
-		Message: "Deployment created successfully.",
+		Message: dryRunMessage("Deployment created successfully.", request.Opts.DryRun),
 	})
 }
 
@@ -61,14 +85,25 @@ func (rc *DeploymentHandler) Create(c echo.Context) error {
 //
 //	@Summary		Update an existing deployment
 //	@Description	Updates an existing deployment in the Kubernetes cluster.
+//	@Description	With diff, nothing is changed and the differences between the live deployment and the result are returned.
 //	@Tags			deployments
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string							true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string							false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			deployment		body		model.DeploymentUpdateRequest	true	"Deployment request body"
-//	@Success		200				{object}	SuccessResponse					"Successfully updated the deployment"
+//	@Param			dryRun			query		string							false	"true or All to only run the update"
+//	@Param			diff			query		bool							false	"Dry run the update and return the diff"
+//	@Param			If-Match		header		string							false	"ETag of the deployment, refused with 412 when the deployment changed since"
+//	@Success		200				{object}	SuccessResponse					"Successfully updated the deployment, or the diff of the dry run"
+//	@Header			200				{string}	ETag							"resourceVersion of the deployment"
 //	@Failure		400				{object}	FailureResponse					"Bad request or invalid data"
+//	@Failure		404				{object}	FailureResponse					"Not found"
+//	@Failure		409				{object}	FailureResponse					"Conflicting update"
+//	@Failure		412				{object}	FailureResponse					"The deployment changed since it was read"
+//	@Failure		422				{object}	FailureResponse					"Rejected by the validation"
 //	@Failure		500				{object}	FailureResponse					"Interval error"
+//	@Failure		504				{object}	FailureResponse					"The API server timed out"
 //	@Router			/deployments [put]
 func (rc *DeploymentHandler) Update(c echo.Context) error {
 	id := c.Param("id")
@@ -83,17 +118,121 @@ func (rc *DeploymentHandler) Update(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid deployment request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	preconditions, err := ifMatchPreconditions(c)
+	if err != nil {
+		return newFailure(err, "Invalid If-Match header", "The If-Match header must hold the ETag of the deployment. Please fetch it again and retry.")
+	}
+	if preconditions != nil {
+		request.Opts.Preconditions = preconditions
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
+	var live *model.Deployment
+	if diff {
+		live, err = rc.liveDeployment(c, namespace, id)
+		if err != nil {
+			return newFailure(err, "Failed to update deployment", "There was an error updating the deployment. Please check your data and try again.")
+		}
+	}
+
 	deployment, err := rc.deploymentUC.Update(c.Request().Context(), namespace, id, &request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to update deployment: %v", err),
-			Message: "There was an error updating the deployment. Please check your data and try again.",
-		})
+		return newFailure(err, "Failed to update deployment", "There was an error updating the deployment. Please check your data and try again.")
 	}
 
+	if diff {
+		return diffResponse(c, live, deployment)
+	}
+
+	setETag(c, deployment.ResourceVersion)
+
 	return c.JSON(http.StatusOK, SuccessResponse{
 		Data:    deployment.Name,
-		Message: "Deployment updated successfully.",
+		Message: dryRunMessage("Deployment updated successfully.", request.Opts.DryRun),
+	})
+}
+
+// Patch godoc
+//
+//	@Summary		Patch a deployment
+//	@Description	Patches any field of a deployment, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.
+//	@Description	Server-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.
+//	@Description	With diff, nothing is changed and the differences between the live deployment and the result are returned.
+//	@Tags			deployments
+//	@Accept			application/merge-patch+json
+//	@Accept			application/strategic-merge-patch+json
+//	@Accept			application/apply-patch+yaml
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string			false	"Namespace of the deployment"
+//	@Param			id				path		string			true	"Name or UID of the deployment"
+//	@Param			fieldManager	query		string			false	"Name of the actor making the changes"
+//	@Param			fieldValidation	query		string			false	"How unknown or duplicate fields are handled (Ignore, Warn or Strict)"
+//	@Param			dryRun			query		string			false	"true or All to only run the patch"
+//	@Param			diff			query		bool			false	"Dry run the patch and return the diff"
+//	@Param			force			query		bool			false	"Take over the fields managed by others, server-side apply only"
+//	@Param			If-Match		header		string			false	"ETag of the deployment, refused with 412 when the deployment changed since"
+//	@Param			patch			body		object			true	"The patch"
+//	@Success		200				{object}	SuccessResponse	"The patched deployment, or the diff of the dry run"
+//	@Header			200				{string}	ETag			"resourceVersion of the deployment"
+//	@Failure		400				{object}	FailureResponse	"Bad request or invalid patch"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		409				{object}	FailureResponse	"Conflicting fields or update"
+//	@Failure		412				{object}	FailureResponse	"The deployment changed since it was read"
+//	@Failure		415				{object}	FailureResponse	"Unsupported patch format"
+//	@Failure		422				{object}	FailureResponse	"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/deployments/{id} [patch]
+func (rc *DeploymentHandler) Patch(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+	nameOrUID := c.Param("id")
+
+	patchType, data, opts, err := getPatch(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+	opts.DryRun = dryRun
+
+	var live *model.Deployment
+	if diff {
+		live, err = rc.liveDeployment(c, namespace, nameOrUID)
+		if err != nil {
+			return newFailure(err, "Failed to patch deployment", "There was an error patching the deployment. Please verify the patch and try again.")
+		}
+	}
+
+	deployment, err := rc.deploymentUC.Patch(c.Request().Context(), namespace, nameOrUID, patchType, data, opts)
+	if err != nil {
+		return newFailure(err, "Failed to patch deployment", "There was an error patching the deployment. Please verify the patch and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, deployment)
+	}
+
+	setETag(c, deployment.ResourceVersion)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    deployment,
+		Message: dryRunMessage("Deployment patched successfully.", opts.DryRun),
 	})
 }
 
@@ -105,11 +244,13 @@ func (rc *DeploymentHandler) Update(c echo.Context) error {
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			limit			query		string			false	"Maximum number of deployments to retrieve"
 //	@Param			continue		query		string			false	"Pagination token for fetching more deployments"
 //	@Param			namespace		query		string			false	"Namespace to filter deployments by"
 //	@Success		200				{object}	SuccessResponse	"List of deployments"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/deployments [get]
 func (rc *DeploymentHandler) List(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
@@ -118,10 +259,7 @@ func (rc *DeploymentHandler) List(c echo.Context) error {
 
 	list, err := rc.deploymentUC.List(c.Request().Context(), namespace, opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to list deployments: %v", err),
-			Message: "There was an issue retrieving deployments. Please try again.",
-		})
+		return newFailure(err, "Failed to list deployments", "There was an issue retrieving deployments. Please try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -134,29 +272,45 @@ func (rc *DeploymentHandler) List(c echo.Context) error {
 //
 //	@Summary		Get a deployment by name or UID
 //	@Description	Retrieves a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.
+//	@Description	With export, the native deployment is returned as a manifest to apply again, without its status and the fields set by the cluster.
 //	@Tags			deployments
 //	@Accept			json
 //	@Produce		json
+//	@Produce		application/yaml
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			namespace		query		string			false	"Namespace to filter the deployment by"
 //	@Param			id				path		string			true	"Name or UID of the deployment"
+//	@Param			export			query		bool			false	"Return the deployment as a manifest to apply again"
+//	@Param			format			query		string			false	"Format of the export, yaml or json, yaml when empty"
 //	@Success		200				{object}	SuccessResponse	"Details of the requested deployment"
+//	@Header			200				{string}	ETag			"resourceVersion of the deployment"
+//	@Failure		400				{object}	FailureResponse	"Invalid export parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/deployments/{id} [get]
 func (rc *DeploymentHandler) GetByNameOrUID(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
 	nameOrUID := c.Param("id")
 
+	format, export, err := getExport(c)
+	if err != nil {
+		return newFailure(err, "Invalid export parameters", "Invalid parameters. Please check them and try again.")
+	}
+	if export {
+		return exportResponse(c, rc.exportUC, uc.DeploymentKind, namespace, nameOrUID, format)
+	}
+
 	opts := model.ListOptions{}
 
 	list, err := rc.deploymentUC.GetByNameOrUID(c.Request().Context(), namespace, nameOrUID, opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve deployment: %v", err),
-			Message: "Could not find the requested deployment. Please verify the name or UID and try again.",
-		})
+		return newFailure(err, "Failed to retrieve deployment", "Could not find the requested deployment. Please verify the name or UID and try again.")
 	}
 
+	setETag(c, list.ResourceVersion)
+
 	return c.JSON(http.StatusOK, SuccessResponse{
 		Data:    list,
 		Message: "Deployment retrieved successfully.",
@@ -167,33 +321,163 @@ func (rc *DeploymentHandler) GetByNameOrUID(c echo.Context) error {
 //
 //	@Summary		Delete a deployment by name or UID
 //	@Description	Deletes a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.
+//	@Description	With diff, nothing is deleted and the live deployment is returned as a diff.
 //	@Tags			deployments
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			namespace		query		string			false	"Namespace to filter the deployment by"
-//	@Param			id				path		string			true	"Name or UID of the deployment"
-//	@Success		200				{string}	SuccessResponse	"Success message"
-//	@Failure		500				{object}	FailureResponse	"Bad request or error message"
+//	@Param			Authorization		header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace			query		string			false	"Namespace to filter the deployment by"
+//	@Param			id					path		string			true	"Name or UID of the deployment"
+//	@Param			propagationPolicy	query		string			false	"How the dependents are deleted (Orphan, Background or Foreground)"
+//	@Param			dryRun				query		string			false	"true or All to only run the deletion"
+//	@Param			diff				query		bool			false	"Dry run the deletion and return the diff"
+//	@Param			If-Match			header		string			false	"ETag of the deployment, refused with 412 when the deployment changed since"
+//	@Success		200					{string}	SuccessResponse	"Success message, or the diff of the dry run"
+//	@Failure		404					{object}	FailureResponse	"Not found"
+//	@Failure		412					{object}	FailureResponse	"The deployment changed since it was read"
+//	@Failure		500					{object}	FailureResponse	"Bad request or error message"
+//	@Failure		504					{object}	FailureResponse	"The API server timed out"
 //	@Router			/deployments/{id} [delete]
 func (rc *DeploymentHandler) Delete(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
 	nameOrUID := c.Param("id")
 
+	preconditions, err := ifMatchPreconditions(c)
+	if err != nil {
+		return newFailure(err, "Invalid If-Match header", "The If-Match header must hold the ETag of the deployment. Please fetch it again and retry.")
+	}
+
+	propagationPolicy, err := getPropagationPolicy(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment deletion", "Invalid parameters. Please check them and try again.")
+	}
+
 	opts := model.DeleteOptions{
-		Preconditions: &model.Preconditions{
-			UID: &nameOrUID,
-		},
+		Preconditions:     preconditions,
+		PropagationPolicy: propagationPolicy,
+		DryRun:            dryRun,
+	}
+
+	var live *model.Deployment
+	if diff {
+		live, err = rc.liveDeployment(c, namespace, nameOrUID)
+		if err != nil {
+			return newFailure(err, "Failed to delete deployment", "There was an error deleting the deployment. Please check the name or UID and try again.")
+		}
 	}
 
 	if err := rc.deploymentUC.Delete(c.Request().Context(), namespace, nameOrUID, opts); err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to delete deployment: %v", err),
-			Message: "There was an error deleting the deployment. Please check the name or UID and try again.",
-		})
+		return newFailure(err, "Failed to delete deployment", "There was an error deleting the deployment. Please check the name or UID and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, nil)
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
-		Message: "Deployment deleted successfully.",
+		Message: dryRunMessage("Deployment deleted successfully.", opts.DryRun),
 	})
 }
+
+// BulkDelete godoc
+//
+//	@Summary		Delete the deployments matched by a label selector
+//	@Description	Without confirm, nothing is deleted and the deployments matched by the label selector are returned with a confirmation token.
+//	@Description	With the token as confirm, the deployments are deleted one by one with an event recorded for each, as long as the same deployments still match, 412 otherwise.
+//	@Description	The deployments failing to delete are reported with their error, the others are still deleted. When an event can't be recorded the deletion stops, the deployments left are reported as failed with its error.
+//	@Tags			deployments
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization		header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace			query		string			false	"Namespace of the deployments, default when empty"
+//	@Param			labelSelector		query		string			true	"Label selector of the deployments to delete"
+//	@Param			confirm				query		string			false	"Confirmation token of the preview, the deployments are only previewed when empty"
+//	@Param			propagationPolicy	query		string			false	"How the dependents are deleted (Orphan, Background or Foreground)"
+//	@Param			dryRun				query		string			false	"true or All to only run the deletions"
+//	@Success		200					{object}	SuccessResponse	"The preview with its confirmation token, or the result of each deletion"
+//	@Failure		400					{object}	FailureResponse	"Missing or invalid label selector, or invalid parameters"
+//	@Failure		412					{object}	FailureResponse	"The deployments matched changed since the preview"
+//	@Failure		500					{object}	FailureResponse	"Interval error"
+//	@Failure		504					{object}	FailureResponse	"The API server timed out"
+//	@Router			/deployments [delete]
+func (rc *DeploymentHandler) BulkDelete(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+
+	opts, err := getBulkDeleteOpts(c, false)
+	if err != nil {
+		return newFailure(err, "Invalid deployment bulk deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	if opts.ConfirmationToken == "" {
+		preview, err := rc.deploymentUC.PreviewBulkDelete(c.Request().Context(), namespace, opts)
+		if err != nil {
+			return newFailure(err, "Failed to preview deployment bulk deletion", "Error listing the deployments to delete. Please check the label selector and try again.")
+		}
+
+		return bulkPreviewResponse(c, preview, "deployments")
+	}
+
+	result, err := rc.deploymentUC.BulkDelete(c.Request().Context(), namespace, opts)
+	if err != nil {
+		return newFailure(err, "Failed to bulk delete deployments", "Error deleting the deployments. Please preview them again and confirm with the new token.")
+	}
+
+	return bulkResultResponse(c, result, "deployments", opts.DryRun)
+}
+
+// ListEvents godoc
+//
+//	@Summary		List cluster events of a deployment
+//	@Description	Retrieves the events reported by Kubernetes for a deployment, newest first within each page. These are cluster events such as scaling failures, not the audit log.
+//	@Tags			deployments
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string			false	"Namespace of the deployment"
+//	@Param			id				path		string			true	"Name or UID of the deployment"
+//	@Param			type			query		string			false	"Event type to filter by (Normal or Warning)"
+//	@Success		200				{object}	SuccessResponse	"List of cluster events"
+//	@Failure		400				{object}	FailureResponse	"Bad request"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/deployments/{id}/events [get]
+func (rc *DeploymentHandler) ListEvents(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+	nameOrUID := c.Param("id")
+
+	opts, err := getClusterEventListOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid deployment events request", "Invalid parameters. Please check the event type and try again.")
+	}
+
+	list, err := rc.deploymentUC.ListClusterEvents(c.Request().Context(), namespace, nameOrUID, opts)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve deployment events", "There was an error retrieving the deployment events. Please check the name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list,
+		Message: "Deployment events retrieved successfully.",
+	})
+}
+
+// liveDeployment returns the deployment as it is before a change, without the warnings of the cluster which are not part of it.
+func (rc *DeploymentHandler) liveDeployment(c echo.Context, namespace, nameOrUID string) (*model.Deployment, error) {
+	deployment, err := rc.deploymentUC.GetByNameOrUID(c.Request().Context(), namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	deployment.Warnings = nil
+
+	return deployment, nil
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// dryRunAll runs every stage of a change on the API server without persisting it.
+const dryRunAll = "All"
+
+// getDryRun returns the dry run options of the dryRun query parameter, true or All, and whether
+// the diff query parameter asks for the differences the change would make, which implies a dry run.
+// The dry run options are nil when the change is to be made.
+func getDryRun(c echo.Context) ([]string, bool, error) {
+	diff, err := getBoolQuery(c, "diff")
+	if err != nil {
+		return nil, false, err
+	}
+	if diff != nil && *diff {
+		return []string{dryRunAll}, true, nil
+	}
+
+	switch dryRun := c.QueryParam("dryRun"); dryRun {
+	case "", "false":
+		return nil, false, nil
+	case "true", dryRunAll:
+		return []string{dryRunAll}, false, nil
+	default:
+		return nil, false, uc.NewError(uc.CodeBadRequest, "dryRun must be true, false or All, got %s", dryRun)
+	}
+}
+
+// getPropagationPolicy returns the propagationPolicy query parameter, nil when it is not set.
+func getPropagationPolicy(c echo.Context) (*model.DeletionPropagation, error) {
+	policy := model.DeletionPropagation(c.QueryParam("propagationPolicy"))
+
+	switch policy {
+	case "":
+		return nil, nil
+	case model.DeletePropagationOrphan, model.DeletePropagationBackground, model.DeletePropagationForeground:
+		return &policy, nil
+	default:
+		return nil, uc.NewError(uc.CodeBadRequest, "propagationPolicy must be Orphan, Background or Foreground, got %s", policy)
+	}
+}
+
+// diffResponse returns the differences between the live object and the result of the dry run of a change.
+func diffResponse(c echo.Context, live, result interface{}) error {
+	diff, err := pkg.Diff(live, result)
+	if err != nil {
+		return newFailure(err, "Failed to diff the change", "Error comparing the object with the result of the change. Please try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    diff,
+		Message: "Dry run of the change, nothing was changed.",
+	})
+}
+
+// dryRunMessage notes in the message of the response when the change was only a dry run.
+func dryRunMessage(message string, dryRun []string) string {
+	if len(dryRun) == 0 {
+		return message
+	}
+
+	return message + " Dry run, nothing was changed."
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// failure is the error of a use case along with what to tell the client about it.
+// HTTPErrorHandler picks the status of the response from the error.
+type failure struct {
+	err     error
+	summary string
+	message string
+}
+
+func newFailure(err error, summary, message string) error {
+	return &failure{
+		err:     err,
+		summary: summary,
+		message: message,
+	}
+}
+
+func (rc *failure) Error() string {
+	return fmt.Sprintf("%s: %v", rc.summary, rc.err)
+}
+
+func (rc *failure) Unwrap() error {
+	return rc.err
+}
+
+// statusOfCode is the HTTP status answered for each use case error code.
+var statusOfCode = map[uc.ErrorCode]int{
+	uc.CodeBadRequest:         http.StatusBadRequest,
+	uc.CodeNotFound:           http.StatusNotFound,
+	uc.CodeAlreadyExists:      http.StatusConflict,
+	uc.CodeConflict:           http.StatusConflict,
+	uc.CodePreconditionFailed: http.StatusPreconditionFailed,
+	uc.CodeForbidden:          http.StatusForbidden,
+	uc.CodeInvalid:            http.StatusUnprocessableEntity,
+	uc.CodeTimeout:            http.StatusGatewayTimeout,
+	uc.CodeUnavailable:        http.StatusServiceUnavailable,
+	uc.CodeInternal:           http.StatusInternalServerError,
+}
+
+// HTTPErrorHandler answers the errors returned by the handlers and middlewares with a FailureResponse.
+// Use case errors are answered with the status of their code, like 404 for a missing pod or 422 for
+// an object rejected by the API server, other errors with their echo.HTTPError status or 500.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status, response := failureResponse(err)
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, response)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+func failureResponse(err error) (int, FailureResponse) {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code, FailureResponse{
+			Error:   err.Error(),
+			Message: http.StatusText(httpErr.Code),
+			Code:    codeOfStatus(httpErr.Code),
+		}
+	}
+
+	ucErr := uc.AsError(err)
+
+	status, ok := statusOfCode[ucErr.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	message := "Something went wrong. Please try again later."
+	var f *failure
+	if errors.As(err, &f) {
+		message = f.message
+	}
+
+	return status, FailureResponse{
+		Error:   err.Error(),
+		Message: message,
+		Code:    ucErr.Code,
+		Causes:  ucErr.Causes,
+	}
+}
+
+// codeOfStatus returns the error code of the failure responses written with a status only.
+func codeOfStatus(status int) uc.ErrorCode {
+	for code, v := range statusOfCode {
+		// already_exists and conflict share 409, conflict is the generic one
+		if v == status && code != uc.CodeAlreadyExists {
+			return code
+		}
+	}
+
+	if status >= http.StatusInternalServerError {
+		return uc.CodeInternal
+	}
+
+	return uc.CodeBadRequest
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// setETag returns the resourceVersion of the object as the ETag of the response.
+func setETag(c echo.Context, resourceVersion string) {
+	if resourceVersion == "" {
+		return
+	}
+
+	c.Response().Header().Set(headerETag, strconv.Quote(resourceVersion))
+}
+
+// ifMatchPreconditions returns the resourceVersion precondition of the If-Match header of the request,
+// nil without the header or with "*". One ETag may be given, weak ones never match a resourceVersion.
+func ifMatchPreconditions(c echo.Context) (*model.Preconditions, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	if strings.Contains(ifMatch, ",") {
+		return nil, uc.NewError(uc.CodeBadRequest, "If-Match must hold a single ETag, got %s", ifMatch)
+	}
+
+	if strings.HasPrefix(ifMatch, "W/") {
+		return nil, uc.NewError(uc.CodePreconditionFailed, "weak ETag %s does not match the resourceVersion of the object", ifMatch)
+	}
+
+	resourceVersion, err := strconv.Unquote(ifMatch)
+	if err != nil || resourceVersion == "" {
+		return nil, uc.NewError(uc.CodeBadRequest, "If-Match must be a quoted ETag, got %s", ifMatch)
+	}
+
+	return &model.Preconditions{
+		ResourceVersion: &resourceVersion,
+	}, nil
+}
@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
@@ -43,10 +42,7 @@ func (rc *EventHandler) List(c echo.Context) error {
 	// Attempt to retrieve the list of events
 	list, err := rc.eventsUC.List(c.Request().Context(), &opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve events: %v", err),
-			Message: "There was an error fetching the events. Please verify the filters and try again.",
-		})
+		return newFailure(err, "Failed to retrieve events", "There was an error fetching the events. Please verify the filters and try again.")
 	}
 
 	// Return the list of events if successful
@@ -66,6 +62,7 @@ func (rc *EventHandler) List(c echo.Context) error {
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
 //	@Param			id				path		string			true	"ID of the event"
 //	@Success		200				{object}	SuccessResponse	"Details of the requested event"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
 //	@Router			/events/{id} [get]
 func (rc *EventHandler) GetByID(c echo.Context) error {
@@ -73,10 +70,7 @@ func (rc *EventHandler) GetByID(c echo.Context) error {
 
 	event, err := rc.eventsUC.GetByID(c.Request().Context(), nameOrUID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve event: %v", err),
-			Message: "Error fetching the event details. Please verify the event name or UID and try again.",
-		})
+		return newFailure(err, "Failed to retrieve event", "Error fetching the event details. Please verify the event name or UID and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -85,6 +79,34 @@ func (rc *EventHandler) GetByID(c echo.Context) error {
 	})
 }
 
+// Verify godoc
+//
+//	@Summary		Verify the event hash chain
+//	@Description	Walks the event hash chain, starting from the last archive anchor, and reports the first broken link.
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Success		200				{object}	SuccessResponse	"Verification report of the event chain"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Router			/events/verify [get]
+func (rc *EventHandler) Verify(c echo.Context) error {
+	report, err := rc.eventsUC.Verify(c.Request().Context())
+	if err != nil {
+		return newFailure(err, "Failed to verify events", "There was an error verifying the event chain. Please try again.")
+	}
+
+	message := "Event chain verified successfully."
+	if !report.Valid {
+		message = "Event chain is broken."
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    report,
+		Message: message,
+	})
+}
+
 func (rc *EventHandler) getEventsFindOpts(c echo.Context) model.EventFindOpts {
 	return model.EventFindOpts{
 		PaginationOpts: getPagination(c),
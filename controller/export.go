@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// mimeApplicationYAML is the content type of the exported YAML manifests.
+const mimeApplicationYAML = "application/yaml"
+
+// getExport returns the format of the format query parameter, yaml when it is not set, and whether
+// the export query parameter asks for the native object as a manifest to apply again.
+func getExport(c echo.Context) (model.ExportFormat, bool, error) {
+	export, err := getBoolQuery(c, "export")
+	if err != nil {
+		return "", false, err
+	}
+
+	format := model.ExportFormat(c.QueryParam("format"))
+	switch format {
+	case "":
+		format = model.ExportFormatYAML
+	case model.ExportFormatYAML, model.ExportFormatJSON:
+	default:
+		return "", false, uc.NewError(uc.CodeBadRequest, "format must be yaml or json, got %s", format)
+	}
+
+	if export == nil || !*export {
+		if c.QueryParam("format") != "" {
+			return "", false, uc.NewError(uc.CodeBadRequest, "format is only supported with export=true")
+		}
+
+		return "", false, nil
+	}
+
+	return format, true, nil
+}
+
+// exportResponse returns the object of the kind as a manifest to apply again, in the format.
+func exportResponse(c echo.Context, exportUC *uc.ExportUC, gvk schema.GroupVersionKind, namespace, nameOrUID string, format model.ExportFormat) error {
+	manifest, err := exportUC.Export(c.Request().Context(), gvk, namespace, nameOrUID)
+	if err != nil {
+		return newFailure(err, "Failed to export "+gvk.Kind, "Error exporting the object. Please verify the name or UID and try again.")
+	}
+
+	return manifestResponse(c, []*unstructured.Unstructured{manifest}, format)
+}
+
+// manifestResponse writes the manifests as they are, without the response envelope, so they apply again as returned.
+func manifestResponse(c echo.Context, manifests []*unstructured.Unstructured, format model.ExportFormat) error {
+	if format == model.ExportFormatJSON && len(manifests) == 1 {
+		data, err := json.MarshalIndent(manifests[0].Object, "", "  ")
+		if err != nil {
+			return newFailure(err, "Failed to encode manifest", "Error encoding the manifest. Please try again.")
+		}
+
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, data)
+	}
+
+	data, err := pkg.EncodeManifests(manifests)
+	if err != nil {
+		return newFailure(err, "Failed to encode manifests", "Error encoding the manifests. Please try again.")
+	}
+
+	return c.Blob(http.StatusOK, mimeApplicationYAML, data)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthHandler struct {
+	healthUC *uc.HealthUC
+}
+
+func NewHealthHandler(healthUC *uc.HealthUC) *HealthHandler {
+	return &HealthHandler{
+		healthUC: healthUC,
+	}
+}
+
+// Healthz godoc
+//
+//	@Summary		Liveness probe
+//	@Description	Reports the process is alive, without checking its dependencies.
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	model.HealthReport	"The process is alive"
+//	@Router			/healthz [get]
+func (rc *HealthHandler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, model.HealthReport{
+		Status: model.HealthStatusOK,
+	})
+}
+
+// Readyz godoc
+//
+//	@Summary		Readiness probe
+//	@Description	Checks the database and the API server of the default cluster, with the result of each check. Fails while the API shuts down.
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	model.HealthReport	"The API is ready to serve requests"
+//	@Failure		503	{object}	model.HealthReport	"A check failed or the API is shutting down"
+//	@Router			/readyz [get]
+func (rc *HealthHandler) Readyz(c echo.Context) error {
+	report := rc.healthUC.Ready(c.Request().Context())
+	if report.Status != model.HealthStatusOK {
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// getMetricsSort returns the resource of the sort query parameter, empty when the list is not sorted by usage.
+func getMetricsSort(c echo.Context) (model.MetricsSort, error) {
+	sort := model.MetricsSort(c.QueryParam("sort"))
+	switch sort {
+	case "", model.MetricsSortCPU, model.MetricsSortMemory:
+		return sort, nil
+	}
+
+	return "", uc.NewError(uc.CodeBadRequest, "sort must be cpu or memory, got %s", sort)
+}
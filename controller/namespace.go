@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/uc"
 
 	"github.com/labstack/echo/v4"
@@ -12,11 +13,15 @@ import (
 
 type NamespaceHandler struct {
 	namespaceUC *uc.NamespaceUC
+	exportUC    *uc.ExportUC
+	metricsUC   *uc.MetricsUC
 }
 
-func NewNamespaceHandler(namespaceUC *uc.NamespaceUC) *NamespaceHandler {
+func NewNamespaceHandler(namespaceUC *uc.NamespaceUC, exportUC *uc.ExportUC, metricsUC *uc.MetricsUC) *NamespaceHandler {
 	return &NamespaceHandler{
 		namespaceUC: namespaceUC,
+		exportUC:    exportUC,
+		metricsUC:   metricsUC,
 	}
 }
 
@@ -24,14 +29,22 @@ func NewNamespaceHandler(namespaceUC *uc.NamespaceUC) *NamespaceHandler {
 //
 //	@Summary		Create a new namespace
 //	@Description	Creates a new namespace in the Kubernetes cluster.
+//	@Description	With diff, nothing is created and the namespace computed by the API server is returned as a diff.
 //	@Tags			namespaces
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string							true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			namespace		body		model.NamespaceCreateRequest	true	"Namespace request body"
-//	@Success		201				{object}	SuccessResponse					"Successfully created namespace"
-//	@Failure		400				{object}	FailureResponse					"Bad request or error message"
-//	@Failure		500				{object}	FailureResponse					"Interval error"
+//	@Param			Authorization	header		string								true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string								false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			dryRun			query		string								false	"true or All to only run the creation"
+//	@Param			diff			query		bool								false	"Dry run the creation and return the diff"
+//	@Param			namespace		body		model.NamespaceCreateRequest		true	"Namespace request body"
+//	@Success		200				{object}	SuccessResponse{data=model.Diff}	"Diff of the dry run"
+//	@Success		201				{object}	SuccessResponse						"Successfully created namespace"
+//	@Failure		400				{object}	FailureResponse						"Bad request or error message"
+//	@Failure		409				{object}	FailureResponse						"Already exists"
+//	@Failure		422				{object}	FailureResponse						"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse						"Interval error"
+//	@Failure		504				{object}	FailureResponse						"The API server timed out"
 //	@Router			/namespaces [post]
 func (rc *NamespaceHandler) Create(c echo.Context) error {
 	var request model.NamespaceCreateRequest
@@ -43,17 +56,30 @@ func (rc *NamespaceHandler) Create(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid namespace request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
 	namespace, err := rc.namespaceUC.Create(c.Request().Context(), request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to create namespace: %v", err),
-			Message: "There was an error creating the namespace. Please try again.",
-		})
+		return newFailure(err, "Failed to create namespace", "There was an error creating the namespace. Please try again.")
+	}
+
+	if diff {
+		return diffResponse(c, nil, namespace)
 	}
 
 	return c.JSON(http.StatusCreated, SuccessResponse{
 		Data:    namespace.Name,
-		Message: "Namespace created successfully.",
+		Message: dryRunMessage("Namespace created successfully.", request.Opts.DryRun),
 	})
 }
 
@@ -61,14 +87,22 @@ func (rc *NamespaceHandler) Create(c echo.Context) error {
 //
 //	@Summary		Update an existing namespace
 //	@Description	Updates an existing namespace in the Kubernetes cluster.
+//	@Description	With diff, nothing is changed and the differences between the live namespace and the result are returned.
 //	@Tags			namespaces
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string							true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string							false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			dryRun			query		string							false	"true or All to only run the update"
+//	@Param			diff			query		bool							false	"Dry run the update and return the diff"
 //	@Param			namespace		body		model.NamespaceUpdateRequest	true	"Namespace request body"
-//	@Success		200				{object}	SuccessResponse					"Successfully updated the namespace"
+//	@Success		200				{object}	SuccessResponse					"Successfully updated the namespace, or the diff of the dry run"
 //	@Failure		400				{object}	FailureResponse					"Bad request or invalid data"
+//	@Failure		404				{object}	FailureResponse					"Not found"
+//	@Failure		409				{object}	FailureResponse					"Conflicting update"
+//	@Failure		422				{object}	FailureResponse					"Rejected by the validation"
 //	@Failure		500				{object}	FailureResponse					"Interval error"
+//	@Failure		504				{object}	FailureResponse					"The API server timed out"
 //	@Router			/namespaces [put]
 func (rc *NamespaceHandler) Update(c echo.Context) error {
 	id := c.Param("id")
@@ -81,17 +115,109 @@ func (rc *NamespaceHandler) Update(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid namespace request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
+	var live *model.Namespace
+	if diff {
+		live, err = rc.namespaceUC.GetByNameOrUID(c.Request().Context(), id, model.ListOptions{})
+		if err != nil {
+			return newFailure(err, "Failed to update namespace", "Error updating namespace. Please try again.")
+		}
+	}
+
 	namespace, err := rc.namespaceUC.Update(c.Request().Context(), id, &request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to update namespace: %v", err),
-			Message: "Error updating namespace. Please try again.",
-		})
+		return newFailure(err, "Failed to update namespace", "Error updating namespace. Please try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, namespace)
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
 		Data:    namespace.Name,
-		Message: "Namespace updated successfully.",
+		Message: dryRunMessage("Namespace updated successfully.", request.Opts.DryRun),
+	})
+}
+
+// Patch godoc
+//
+//	@Summary		Patch a namespace
+//	@Description	Patches any field of a namespace, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.
+//	@Description	Server-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.
+//	@Description	With diff, nothing is changed and the differences between the live namespace and the result are returned.
+//	@Tags			namespaces
+//	@Accept			application/merge-patch+json
+//	@Accept			application/strategic-merge-patch+json
+//	@Accept			application/apply-patch+yaml
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string			true	"Name or UID of the namespace"
+//	@Param			fieldManager	query		string			false	"Name of the actor making the changes"
+//	@Param			fieldValidation	query		string			false	"How unknown or duplicate fields are handled (Ignore, Warn or Strict)"
+//	@Param			dryRun			query		string			false	"true or All to only run the patch"
+//	@Param			diff			query		bool			false	"Dry run the patch and return the diff"
+//	@Param			force			query		bool			false	"Take over the fields managed by others, server-side apply only"
+//	@Param			If-Match		header		string			false	"ETag of the namespace, refused with 412 when the namespace changed since"
+//	@Param			patch			body		object			true	"The patch"
+//	@Success		200				{object}	SuccessResponse	"The patched namespace"
+//	@Header			200				{string}	ETag			"resourceVersion of the namespace"
+//	@Failure		400				{object}	FailureResponse	"Bad request or invalid patch"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		409				{object}	FailureResponse	"Conflicting fields or update"
+//	@Failure		412				{object}	FailureResponse	"The namespace changed since it was read"
+//	@Failure		415				{object}	FailureResponse	"Unsupported patch format"
+//	@Failure		422				{object}	FailureResponse	"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/namespaces/{id} [patch]
+func (rc *NamespaceHandler) Patch(c echo.Context) error {
+	nameOrUID := c.Param("id")
+
+	patchType, data, opts, err := getPatch(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+	opts.DryRun = dryRun
+
+	var live *model.Namespace
+	if diff {
+		live, err = rc.namespaceUC.GetByNameOrUID(c.Request().Context(), nameOrUID, model.ListOptions{})
+		if err != nil {
+			return newFailure(err, "Failed to patch namespace", "Error patching namespace. Please verify the patch and try again.")
+		}
+	}
+
+	namespace, err := rc.namespaceUC.Patch(c.Request().Context(), nameOrUID, patchType, data, opts)
+	if err != nil {
+		return newFailure(err, "Failed to patch namespace", "Error patching namespace. Please verify the patch and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, namespace)
+	}
+
+	setETag(c, namespace.ResourceVersion)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    namespace,
+		Message: dryRunMessage("Namespace patched successfully.", opts.DryRun),
 	})
 }
 
@@ -103,20 +229,19 @@ func (rc *NamespaceHandler) Update(c echo.Context) error {
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			limit			query		string			false	"Maximum number of namespaces to retrieve"
 //	@Param			continue		query		string			false	"Pagination token for fetching more namespaces"
 //	@Success		200				{object}	SuccessResponse	"List of namespaces"
 //	@Failure		500				{object}	FailureResponse	"Bad request or error message"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/namespaces [get]
 func (rc *NamespaceHandler) List(c echo.Context) error {
 	opts := getKubeListOpts(c)
 
 	list, err := rc.namespaceUC.List(c.Request().Context(), opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve namespaces: %v", err),
-			Message: "There was an error retrieving the list of namespaces. Please try again.",
-		})
+		return newFailure(err, "Failed to retrieve namespaces", "There was an error retrieving the list of namespaces. Please try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -129,25 +254,38 @@ func (rc *NamespaceHandler) List(c echo.Context) error {
 //
 //	@Summary		Get a namespace by name or UID
 //	@Description	Retrieves a namespace from the Kubernetes cluster by its name or UID.
+//	@Description	With export, the native namespace is returned as a manifest to apply again, without its status and the fields set by the cluster.
 //	@Tags			namespaces
 //	@Accept			json
 //	@Produce		json
+//	@Produce		application/yaml
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			id				path		string			true	"Name or UID of the namespace"
+//	@Param			export			query		bool			false	"Return the namespace as a manifest to apply again"
+//	@Param			format			query		string			false	"Format of the export, yaml or json, yaml when empty"
 //	@Success		200				{object}	SuccessResponse	"Details of the requested namespace"
+//	@Failure		400				{object}	FailureResponse	"Invalid export parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/namespaces/{id} [get]
 func (rc *NamespaceHandler) GetByNameOrUID(c echo.Context) error {
 	nameOrUID := c.Param("id")
 
+	format, export, err := getExport(c)
+	if err != nil {
+		return newFailure(err, "Invalid export parameters", "Invalid parameters. Please check them and try again.")
+	}
+	if export {
+		return exportResponse(c, rc.exportUC, uc.NamespaceKind, "", nameOrUID, format)
+	}
+
 	opts := model.ListOptions{}
 
 	list, err := rc.namespaceUC.GetByNameOrUID(c.Request().Context(), nameOrUID, opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve namespace: %v", err),
-			Message: "Error retrieving namespace. Please check the name or UID and try again.",
-		})
+		return newFailure(err, "Failed to retrieve namespace", "Error retrieving namespace. Please check the name or UID and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -160,27 +298,187 @@ func (rc *NamespaceHandler) GetByNameOrUID(c echo.Context) error {
 //
 //	@Summary		Delete a namespace by name or UID
 //	@Description	Deletes a namespace from the Kubernetes cluster by its name or UID.
+//	@Description	With diff, nothing is deleted and the live namespace is returned as a diff.
 //	@Tags			namespaces
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			name			path		string			true	"Name of the Namespace"
-//	@Success		200				{string}	SuccessResponse	"Success message"
-//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Param			Authorization		header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			name				path		string			true	"Name of the Namespace"
+//	@Param			propagationPolicy	query		string			false	"How the dependents are deleted (Orphan, Background or Foreground)"
+//	@Param			dryRun				query		string			false	"true or All to only run the deletion"
+//	@Param			diff				query		bool			false	"Dry run the deletion and return the diff"
+//	@Success		200					{string}	SuccessResponse	"Success message, or the diff of the dry run"
+//	@Failure		404					{object}	FailureResponse	"Not found"
+//	@Failure		500					{object}	FailureResponse	"Interval error"
+//	@Failure		504					{object}	FailureResponse	"The API server timed out"
 //	@Router			/namespaces/{id} [delete]
 func (rc *NamespaceHandler) Delete(c echo.Context) error {
 	name := c.Param("name")
 
-	opts := model.DeleteOptions{}
+	propagationPolicy, err := getPropagationPolicy(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	opts := model.DeleteOptions{
+		PropagationPolicy: propagationPolicy,
+		DryRun:            dryRun,
+	}
+
+	var live *model.Namespace
+	if diff {
+		live, err = rc.namespaceUC.GetByNameOrUID(c.Request().Context(), name, model.ListOptions{})
+		if err != nil {
+			return newFailure(err, "Failed to delete namespace", "Error deleting namespace. Please check the name or UID and try again.")
+		}
+	}
 
 	if err := rc.namespaceUC.Delete(c.Request().Context(), name, opts); err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to delete namespace: %v", err),
-			Message: "Error deleting namespace. Please check the name or UID and try again.",
-		})
+		return newFailure(err, "Failed to delete namespace", "Error deleting namespace. Please check the name or UID and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, nil)
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Message: dryRunMessage("Namespace deleted successfully.", opts.DryRun),
+	})
+}
+
+// ListClusterEvents godoc
+//
+//	@Summary		List cluster events of a namespace
+//	@Description	Retrieves every event reported by Kubernetes in a namespace, newest first within each page. These are cluster events, not the audit log.
+//	@Tags			namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string			true	"Name or UID of the namespace"
+//	@Param			type			query		string			false	"Event type to filter by (Normal or Warning)"
+//	@Param			limit			query		string			false	"Maximum number of events to retrieve"
+//	@Param			continue		query		string			false	"Pagination token for fetching more events"
+//	@Success		200				{object}	SuccessResponse	"List of cluster events"
+//	@Failure		400				{object}	FailureResponse	"Bad request"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/namespaces/{id}/cluster-events [get]
+func (rc *NamespaceHandler) ListClusterEvents(c echo.Context) error {
+	nameOrUID := c.Param("id")
+
+	opts, err := getClusterEventListOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid namespace events request", "Invalid parameters. Please check the event type and try again.")
+	}
+
+	list, err := rc.namespaceUC.ListClusterEvents(c.Request().Context(), nameOrUID, opts)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve namespace events", "Error retrieving namespace events. Please check the name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list,
+		Message: "Namespace events retrieved successfully.",
+	})
+}
+
+// Metrics godoc
+//
+//	@Summary		Get the resource usage of a namespace
+//	@Description	Retrieves the CPU and memory used by a namespace and each of its pods, most CPU using pod first, as reported by metrics-server, compared with their requests and limits.
+//	@Tags			namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string											true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string											false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string											true	"Name or UID of the namespace"
+//	@Success		200				{object}	SuccessResponse{data=model.NamespaceMetrics}	"Resource usage of the namespace"
+//	@Failure		404				{object}	FailureResponse									"Not found"
+//	@Failure		500				{object}	FailureResponse									"Interval error"
+//	@Failure		503				{object}	FailureResponse									"The metrics API is not available"
+//	@Failure		504				{object}	FailureResponse									"The API server timed out"
+//	@Router			/namespaces/{id}/metrics [get]
+func (rc *NamespaceHandler) Metrics(c echo.Context) error {
+	nameOrUID := c.Param("id")
+
+	metrics, err := rc.metricsUC.NamespaceMetrics(c.Request().Context(), nameOrUID)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve namespace metrics", "Error reading the resource usage of the namespace. Please make sure metrics-server is installed and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
-		Message: "Namespace deleted successfully.",
+		Data:    metrics,
+		Message: "Namespace metrics retrieved successfully.",
 	})
 }
+
+// Export godoc
+//
+//	@Summary		Export a namespace
+//	@Description	Exports the namespace and every supported object in it as manifests to apply again, without their status and the fields set by the cluster.
+//	@Description	The objects the cluster creates by itself, like the pods of a deployment, are left out, and so are the secrets unless includeSecrets is set.
+//	@Description	The manifests are returned as multi-document YAML, or as a tar.gz archive holding a file for each object.
+//	@Tags			namespaces
+//	@Accept			json
+//	@Produce		application/yaml
+//	@Produce		application/gzip
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string			true	"Name or UID of the namespace"
+//	@Param			format			query		string			false	"Format of the export, yaml or tar.gz, yaml when empty"
+//	@Param			includeSecrets	query		bool			false	"Export the secrets too"
+//	@Success		200				{string}	string			"The manifests"
+//	@Failure		400				{object}	FailureResponse	"Invalid export parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/namespaces/{id}/export [get]
+func (rc *NamespaceHandler) Export(c echo.Context) error {
+	nameOrUID := c.Param("id")
+
+	format := model.ExportFormat(c.QueryParam("format"))
+	switch format {
+	case "":
+		format = model.ExportFormatYAML
+	case model.ExportFormatYAML, model.ExportFormatTarGz:
+	default:
+		err := uc.NewError(uc.CodeBadRequest, "format must be yaml or tar.gz, got %s", format)
+		return newFailure(err, "Invalid export parameters", "Invalid parameters. Please check them and try again.")
+	}
+
+	includeSecrets, err := getBoolQuery(c, "includeSecrets")
+	if err != nil {
+		return newFailure(err, "Invalid export parameters", "Invalid parameters. Please check them and try again.")
+	}
+
+	opts := model.NamespaceExportOptions{
+		IncludeSecrets: includeSecrets != nil && *includeSecrets,
+	}
+
+	manifests, err := rc.exportUC.ExportNamespace(c.Request().Context(), nameOrUID, opts)
+	if err != nil {
+		return newFailure(err, "Failed to export namespace", "Error exporting the namespace. Please check the name or UID and try again.")
+	}
+
+	name := manifests[0].GetName()
+	if format == model.ExportFormatTarGz {
+		data, err := pkg.ArchiveManifests(name, manifests)
+		if err != nil {
+			return newFailure(err, "Failed to archive manifests", "Error archiving the manifests. Please try again.")
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".tar.gz"))
+		return c.Blob(http.StatusOK, "application/gzip", data)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".yaml"))
+	return manifestResponse(c, manifests, format)
+}
@@ -0,0 +1,255 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// defaultDrainTimeout bounds the drains requested without a timeout.
+const defaultDrainTimeout = 5 * time.Minute
+
+type NodeHandler struct {
+	nodeUC *uc.NodeUC
+}
+
+func NewNodeHandler(nodeUC *uc.NodeUC) *NodeHandler {
+	return &NodeHandler{
+		nodeUC: nodeUC,
+	}
+}
+
+// List godoc
+//
+//	@Summary		List nodes
+//	@Description	Retrieves a list of nodes from the Kubernetes cluster, with their capacity, taints and the number of pods scheduled on them.
+//	@Tags			nodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			labelSelector	query		string			false	"Label selector to filter the nodes by"
+//	@Param			limit			query		string			false	"Maximum number of nodes to retrieve"
+//	@Param			continue		query		string			false	"Pagination token for fetching more nodes"
+//	@Success		200				{object}	SuccessResponse	"List of nodes"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/nodes [get]
+func (rc *NodeHandler) List(c echo.Context) error {
+	opts := getKubeListOpts(c)
+
+	list, err := rc.nodeUC.List(c.Request().Context(), opts)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve nodes", "There was an error retrieving the list of nodes. Please try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list.ConvertMini(),
+		Message: "Nodes retrieved successfully.",
+	})
+}
+
+// GetByNameOrUID godoc
+//
+//	@Summary		Get a node by name or UID
+//	@Description	Retrieves a node from the Kubernetes cluster by its name or UID, with its capacity, allocatable resources, conditions, taints and the pods scheduled on it.
+//	@Tags			nodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string								false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string								true	"Name or UID of the node"
+//	@Success		200				{object}	SuccessResponse{data=model.Node}	"Node details"
+//	@Failure		404				{object}	FailureResponse						"Not found"
+//	@Failure		500				{object}	FailureResponse						"Interval error"
+//	@Failure		504				{object}	FailureResponse						"The API server timed out"
+//	@Router			/nodes/{id} [get]
+func (rc *NodeHandler) GetByNameOrUID(c echo.Context) error {
+	nameOrUID := c.Param("id")
+
+	node, err := rc.nodeUC.GetByNameOrUID(c.Request().Context(), nameOrUID, model.ListOptions{})
+	if err != nil {
+		return newFailure(err, "Failed to retrieve node", "Error retrieving the node. Please verify the node name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    node,
+		Message: "Node retrieved successfully.",
+	})
+}
+
+// Cordon godoc
+//
+//	@Summary		Cordon a node
+//	@Description	Marks a node unschedulable, no new pod is scheduled on it and the pods running on it are left alone.
+//	@Tags			nodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string			true	"Name or UID of the node"
+//	@Param			dryRun			query		string			false	"true or All to only run the cordon"
+//	@Success		200				{object}	SuccessResponse	"Successfully cordoned the node"
+//	@Failure		400				{object}	FailureResponse	"Bad request or invalid parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/nodes/{id}/cordon [post]
+func (rc *NodeHandler) Cordon(c echo.Context) error {
+	opts, err := getNodePatchOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid node cordon", "Invalid parameters. Please check them and try again.")
+	}
+
+	node, err := rc.nodeUC.Cordon(c.Request().Context(), c.Param("id"), opts)
+	if err != nil {
+		return newFailure(err, "Failed to cordon node", "Error cordoning the node. Please verify the node name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    node.Name,
+		Message: dryRunMessage("Node cordoned successfully.", opts.DryRun),
+	})
+}
+
+// Uncordon godoc
+//
+//	@Summary		Uncordon a node
+//	@Description	Marks a cordoned or drained node schedulable again.
+//	@Tags			nodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id				path		string			true	"Name or UID of the node"
+//	@Param			dryRun			query		string			false	"true or All to only run the uncordon"
+//	@Success		200				{object}	SuccessResponse	"Successfully uncordoned the node"
+//	@Failure		400				{object}	FailureResponse	"Bad request or invalid parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/nodes/{id}/uncordon [post]
+func (rc *NodeHandler) Uncordon(c echo.Context) error {
+	opts, err := getNodePatchOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid node uncordon", "Invalid parameters. Please check them and try again.")
+	}
+
+	node, err := rc.nodeUC.Uncordon(c.Request().Context(), c.Param("id"), opts)
+	if err != nil {
+		return newFailure(err, "Failed to uncordon node", "Error uncordoning the node. Please verify the node name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    node.Name,
+		Message: dryRunMessage("Node uncordoned successfully.", opts.DryRun),
+	})
+}
+
+// Drain godoc
+//
+//	@Summary		Drain a node
+//	@Description	Cordons a node and evicts its pods through the eviction API, which respects the PodDisruptionBudgets. The pods of DaemonSets and the static pods are left on the node.
+//	@Description	The evictions refused by a PodDisruptionBudget are retried every 5 seconds until the timeout. The pods no controller recreates are lost once evicted, the drain refuses to start with 400 unless force is set.
+//	@Description	The progress is streamed as newline delimited JSON, one model.DrainProgress per line, ending with a done step, or a failed step without pod when the drain failed or timed out.
+//	@Tags			nodes
+//	@Accept			json
+//	@Produce		application/x-ndjson
+//	@Param			Authorization		header		string				true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string				false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			id					path		string				true	"Name or UID of the node"
+//	@Param			timeout				query		string				false	"Duration the drain is bounded by, like 90s or 10m, 5m when empty"
+//	@Param			gracePeriodSeconds	query		int					false	"Termination grace period of the evicted pods, the one of each pod when empty"
+//	@Param			force				query		bool				false	"Evict the pods no controller recreates too"
+//	@Param			dryRun				query		string				false	"true or All to only run the cordon and the evictions"
+//	@Success		200					{object}	model.DrainProgress	"Progress of the drain, one per line"
+//	@Failure		400					{object}	FailureResponse		"Bad request, invalid parameters or pods not managed by a controller"
+//	@Failure		404					{object}	FailureResponse		"Not found"
+//	@Failure		500					{object}	FailureResponse		"Interval error"
+//	@Failure		504					{object}	FailureResponse		"The API server timed out"
+//	@Router			/nodes/{id}/drain [post]
+func (rc *NodeHandler) Drain(c echo.Context) error {
+	opts, err := getDrainOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid node drain", "Invalid parameters. Please check them and try again.")
+	}
+
+	// the response starts with the first step, the errors before it are answered as usual
+	res := c.Response()
+	encoder := json.NewEncoder(res)
+	progress := func(p model.DrainProgress) {
+		if !res.Committed {
+			res.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+			res.WriteHeader(http.StatusOK)
+		}
+
+		if err := encoder.Encode(p); err != nil {
+			c.Logger().Error(err)
+		}
+		res.Flush()
+	}
+
+	err = rc.nodeUC.Drain(c.Request().Context(), c.Param("id"), opts, progress)
+	if err != nil && !res.Committed {
+		return newFailure(err, "Failed to drain node", "Error draining the node. Please verify the node name or UID and try again.")
+	}
+	if err != nil {
+		progress(model.DrainProgress{
+			Time:    time.Now(),
+			Step:    model.DrainStepFailed,
+			Message: err.Error(),
+		})
+	}
+
+	return nil
+}
+
+// getNodePatchOpts returns the options of the cordon and the uncordon.
+func getNodePatchOpts(c echo.Context) (model.PatchOptions, error) {
+	dryRun, _, err := getDryRun(c)
+	if err != nil {
+		return model.PatchOptions{}, err
+	}
+
+	return model.PatchOptions{UpdateOptions: model.UpdateOptions{DryRun: dryRun}}, nil
+}
+
+func getDrainOpts(c echo.Context) (model.DrainOptions, error) {
+	opts := model.DrainOptions{Timeout: defaultDrainTimeout}
+
+	dryRun, _, err := getDryRun(c)
+	if err != nil {
+		return opts, err
+	}
+	opts.DryRun = dryRun
+
+	if query := c.QueryParam("timeout"); query != "" {
+		timeout, err := time.ParseDuration(query)
+		if err != nil || timeout <= 0 {
+			return opts, uc.NewError(uc.CodeBadRequest, "timeout must be a positive duration like 90s or 10m, got %s", query)
+		}
+		opts.Timeout = timeout
+	}
+
+	if query := c.QueryParam("gracePeriodSeconds"); query != "" {
+		gracePeriod, err := strconv.ParseInt(query, 10, 64)
+		if err != nil || gracePeriod < 0 {
+			return opts, uc.NewError(uc.CodeBadRequest, "gracePeriodSeconds must be a non-negative integer, got %s", query)
+		}
+		opts.GracePeriodSeconds = &gracePeriod
+	}
+
+	force, err := getBoolQuery(c, "force")
+	if err != nil {
+		return opts, err
+	}
+	opts.Force = force != nil && *force
+
+	return opts, nil
+}
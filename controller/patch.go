@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+)
+
+// defaultFieldManager manages the fields set by server-side apply when the request names no manager.
+const defaultFieldManager = "kubernetes-api"
+
+// maxPatchBytes bounds the patches read from the requests, the size limit of the objects of the API server.
+const maxPatchBytes = 3 * 1024 * 1024
+
+// patchTypes are the patch formats accepted by the PATCH endpoints, chosen by the Content-Type of the request.
+var patchTypes = []model.PatchType{
+	model.MergePatchType,
+	model.StrategicMergePatchType,
+	model.ApplyPatchType,
+}
+
+// getPatch reads the patch of the request, its format and the fieldManager, fieldValidation, force
+// query parameters, along with the If-Match precondition.
+func getPatch(c echo.Context) (model.PatchType, []byte, model.PatchOptions, error) {
+	opts := model.PatchOptions{}
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	patchType := model.PatchType(mediaType)
+	if !slices.Contains(patchTypes, patchType) {
+		return "", nil, opts, echo.NewHTTPError(http.StatusUnsupportedMediaType, "patches must be one of application/merge-patch+json, application/strategic-merge-patch+json or application/apply-patch+yaml")
+	}
+
+	data, err := readBody(c, maxPatchBytes)
+	if err != nil {
+		return "", nil, opts, err
+	}
+
+	opts.FieldManager = c.QueryParam("fieldManager")
+	opts.FieldValidation = c.QueryParam("fieldValidation")
+
+	opts.Force, err = getBoolQuery(c, "force")
+	if err != nil {
+		return "", nil, opts, err
+	}
+
+	if patchType == model.ApplyPatchType && opts.FieldManager == "" {
+		opts.FieldManager = defaultFieldManager
+	}
+
+	opts.Preconditions, err = ifMatchPreconditions(c)
+	if err != nil {
+		return "", nil, opts, err
+	}
+
+	return patchType, data, opts, nil
+}
+
+// readBody reads the body of the request, refusing empty ones and those over limit bytes.
+func readBody(c echo.Context, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(c.Request().Body, limit+1))
+	if err != nil {
+		return nil, uc.NewError(uc.CodeBadRequest, "failed to read the request body: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, uc.NewError(uc.CodeBadRequest, "the request body is empty")
+	}
+	if int64(len(data)) > limit {
+		return nil, echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("the request body exceeds %d bytes", limit))
+	}
+
+	return data, nil
+}
+
+// getBoolQuery returns the boolean query parameter, nil when it is not set.
+func getBoolQuery(c echo.Context, name string) (*bool, error) {
+	query := c.QueryParam(name)
+	if query == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(query)
+	if err != nil {
+		return nil, uc.NewError(uc.CodeBadRequest, "%s must be a boolean, got %s", name, query)
+	}
+
+	return &value, nil
+}
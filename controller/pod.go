@@ -11,12 +11,16 @@ import (
 )
 
 type PodHandler struct {
-	podsUC *uc.PodUC
+	podsUC    *uc.PodUC
+	exportUC  *uc.ExportUC
+	metricsUC *uc.MetricsUC
 }
 
-func NewPodHandler(podsUC *uc.PodUC) *PodHandler {
+func NewPodHandler(podsUC *uc.PodUC, exportUC *uc.ExportUC, metricsUC *uc.MetricsUC) *PodHandler {
 	return &PodHandler{
-		podsUC: podsUC,
+		podsUC:    podsUC,
+		exportUC:  exportUC,
+		metricsUC: metricsUC,
 	}
 }
 
@@ -24,14 +28,23 @@ func NewPodHandler(podsUC *uc.PodUC) *PodHandler {
 //
 //	@Summary		Create a new pod
 //	@Description	Creates a new pod in the Kubernetes cluster.
+//	@Description	The config maps, secrets and keys referenced by the environment of its containers but missing from the namespace are returned as warnings.
+//	@Description	With diff, nothing is created and the pod computed by the API server is returned as a diff.
 //	@Tags			pods
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string					true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			pod				body		model.PodsCreateRequest	true	"Pod request body"
-//	@Success		201				{object}	SuccessResponse			"Successfully created the pod"
-//	@Failure		400				{object}	FailureResponse			"Bad request or invalid data"
-//	@Failure		500				{object}	FailureResponse			"Interval error"
+//	@Param			Authorization	header		string								true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string								false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			dryRun			query		string								false	"true or All to only run the creation"
+//	@Param			diff			query		bool								false	"Dry run the creation and return the diff"
+//	@Param			pod				body		model.PodsCreateRequest				true	"Pod request body"
+//	@Success		200				{object}	SuccessResponse{data=model.Diff}	"Diff of the dry run"
+//	@Success		201				{object}	SuccessResponse						"Successfully created the pod"
+//	@Failure		400				{object}	FailureResponse						"Bad request or invalid data"
+//	@Failure		409				{object}	FailureResponse						"Already exists"
+//	@Failure		422				{object}	FailureResponse						"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse						"Interval error"
+//	@Failure		504				{object}	FailureResponse						"The API server timed out"
 //	@Router			/pods [post]
 func (rc *PodHandler) Create(c echo.Context) error {
 	var request model.PodsCreateRequest
@@ -43,17 +56,30 @@ func (rc *PodHandler) Create(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid pod request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
 	pod, err := rc.podsUC.Create(c.Request().Context(), request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to create pod: %v", err),
-			Message: "Pod creation failed. Please verify the details and try again.",
-		})
+		return newFailure(err, "Failed to create pod", "Pod creation failed. Please verify the details and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, nil, pod)
 	}
 
 	return c.JSON(http.StatusCreated, SuccessResponse{
 		Data:    pod.Name,
-		Message: "Pod created successfully.",
+		Message: dryRunMessage("Pod created successfully.", request.Opts.DryRun),
 	})
 }
 
@@ -66,16 +92,27 @@ func (rc *PodHandler) Create(c echo.Context) error {
 //	@Description	- tolerations (only additions)
 //	@Description	- activeDeadlineSeconds
 //	@Description	- terminationGracePeriodSeconds
+//	@Description	With diff, nothing is changed and the differences between the live pod and the result are returned.
 //	@Tags			pods
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string					true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string					false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			pod				body		model.PodsUpdateRequest	true	"Pod update request body"
 //	@Param			namespace		query		string					false	"Namespace to filter the pod by"
 //	@Param			id				path		string					true	"Name or UID of the pod"
-//	@Success		200				{object}	SuccessResponse			"Pod successfully updated"
+//	@Param			dryRun			query		string					false	"true or All to only run the update"
+//	@Param			diff			query		bool					false	"Dry run the update and return the diff"
+//	@Param			If-Match		header		string					false	"ETag of the pod, refused with 412 when the pod changed since"
+//	@Success		200				{object}	SuccessResponse			"Pod successfully updated, or the diff of the dry run"
+//	@Header			200				{string}	ETag					"resourceVersion of the pod"
 //	@Failure		400				{object}	FailureResponse			"Bad request or invalid input data"
+//	@Failure		404				{object}	FailureResponse			"Not found"
+//	@Failure		409				{object}	FailureResponse			"Conflicting update"
+//	@Failure		412				{object}	FailureResponse			"The pod changed since it was read"
+//	@Failure		422				{object}	FailureResponse			"Rejected by the validation"
 //	@Failure		500				{object}	FailureResponse			"Interval error"
+//	@Failure		504				{object}	FailureResponse			"The API server timed out"
 //	@Router			/pods/{id} [put]
 func (rc *PodHandler) Update(c echo.Context) error {
 	id := c.Param("id")
@@ -89,49 +126,173 @@ func (rc *PodHandler) Update(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&request); err != nil {
+		return newFailure(err, "Invalid pod request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
+	preconditions, err := ifMatchPreconditions(c)
+	if err != nil {
+		return newFailure(err, "Invalid If-Match header", "The If-Match header must hold the ETag of the pod. Please fetch it again and retry.")
+	}
+	if preconditions != nil {
+		request.Opts.Preconditions = preconditions
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod request", "Invalid parameters. Please check them and try again.")
+	}
+	if dryRun != nil {
+		request.Opts.DryRun = dryRun
+	}
+
+	var live *model.Pod
+	if diff {
+		live, err = rc.livePod(c, namespace, id)
+		if err != nil {
+			return newFailure(err, "Failed to update pod", "Pod update failed. Please verify the details and try again.")
+		}
+	}
+
 	pod, err := rc.podsUC.Update(c.Request().Context(), namespace, id, &request)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to update pod: %v", err),
-			Message: "Pod update failed. Please verify the details and try again.",
-		})
+		return newFailure(err, "Failed to update pod", "Pod update failed. Please verify the details and try again.")
 	}
 
+	if diff {
+		return diffResponse(c, live, pod)
+	}
+
+	setETag(c, pod.ResourceVersion)
+
 	return c.JSON(http.StatusOK, SuccessResponse{
 		Data:    pod.Name,
-		Message: "Pod updated successfully.",
+		Message: dryRunMessage("Pod updated successfully.", request.Opts.DryRun),
+	})
+}
+
+// Patch godoc
+//
+//	@Summary		Patch a pod
+//	@Description	Patches any field of a pod, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.
+//	@Description	Server-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.
+//	@Description	With diff, nothing is changed and the differences between the live pod and the result are returned.
+//	@Tags			pods
+//	@Accept			application/merge-patch+json
+//	@Accept			application/strategic-merge-patch+json
+//	@Accept			application/apply-patch+yaml
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string			false	"Namespace of the pod"
+//	@Param			id				path		string			true	"Name or UID of the pod"
+//	@Param			fieldManager	query		string			false	"Name of the actor making the changes"
+//	@Param			fieldValidation	query		string			false	"How unknown or duplicate fields are handled (Ignore, Warn or Strict)"
+//	@Param			dryRun			query		string			false	"true or All to only run the patch"
+//	@Param			diff			query		bool			false	"Dry run the patch and return the diff"
+//	@Param			force			query		bool			false	"Take over the fields managed by others, server-side apply only"
+//	@Param			If-Match		header		string			false	"ETag of the pod, refused with 412 when the pod changed since"
+//	@Param			patch			body		object			true	"The patch"
+//	@Success		200				{object}	SuccessResponse	"The patched pod, or the diff of the dry run"
+//	@Header			200				{string}	ETag			"resourceVersion of the pod"
+//	@Failure		400				{object}	FailureResponse	"Bad request or invalid patch"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		409				{object}	FailureResponse	"Conflicting fields or update"
+//	@Failure		412				{object}	FailureResponse	"The pod changed since it was read"
+//	@Failure		415				{object}	FailureResponse	"Unsupported patch format"
+//	@Failure		422				{object}	FailureResponse	"Rejected by the validation"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/pods/{id} [patch]
+func (rc *PodHandler) Patch(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+	nameOrUID := c.Param("id")
+
+	patchType, data, opts, err := getPatch(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod patch", "Invalid patch. Please check the Content-Type and the parameters and try again.")
+	}
+	opts.DryRun = dryRun
+
+	var live *model.Pod
+	if diff {
+		live, err = rc.livePod(c, namespace, nameOrUID)
+		if err != nil {
+			return newFailure(err, "Failed to patch pod", "Pod patch failed. Please verify the patch and try again.")
+		}
+	}
+
+	pod, err := rc.podsUC.Patch(c.Request().Context(), namespace, nameOrUID, patchType, data, opts)
+	if err != nil {
+		return newFailure(err, "Failed to patch pod", "Pod patch failed. Please verify the patch and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, pod)
+	}
+
+	setETag(c, pod.ResourceVersion)
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    pod,
+		Message: dryRunMessage("Pod patched successfully.", opts.DryRun),
 	})
 }
 
 // List godoc
 //
 //	@Summary		List pods
-//	@Description	Retrieves a list of pods from the Kubernetes cluster. You can filter results by namespace or paginate the response using the limit and continue parameters.
+//	@Description	Retrieves a list of pods from the Kubernetes cluster. You can filter results by namespace or paginate the response using the limit and continue parameters. Each pod comes with a summary of its ready containers, restarts, age and status, like kubectl get pods.
 //	@Tags			pods
 //	@Accept			json
 //	@Produce		json
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			limit			query		string			false	"Maximum number of pods to retrieve"
 //	@Param			continue		query		string			false	"Pagination token for fetching more pods"
 //	@Param			namespace		query		string			false	"Namespace to filter pods by"
+//	@Param			sort			query		string			false	"Sort every pod from the most using cpu or memory, as reported by metrics-server, can't be paginated"
 //	@Success		200				{object}	SuccessResponse	"List of pods"
+//	@Failure		400				{object}	FailureResponse	"Invalid sort, or sort with limit or continue"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		503				{object}	FailureResponse	"The metrics API is not available"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/pods [get]
 func (rc *PodHandler) List(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
 
+	sort, err := getMetricsSort(c)
+	if err != nil {
+		return newFailure(err, "Invalid sort", "Invalid sort. Please use cpu or memory and try again.")
+	}
+
 	opts := getKubeListOpts(c)
 
+	// a page would only be sorted within itself, the next one may hold pods using more
+	if sort != "" && (opts.Limit > 0 || opts.Continue != "") {
+		err := uc.NewError(uc.CodeBadRequest, "sort can't be used with limit or continue, the pods are sorted across every page")
+		return newFailure(err, "Invalid sort", "Invalid sort. Please list the pods without limit and continue to sort them.")
+	}
+
 	list, err := rc.podsUC.List(c.Request().Context(), namespace, opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve pods: %v", err),
-			Message: "Error fetching the list of pods. Please try again.",
-		})
+		return newFailure(err, "Failed to retrieve pods", "Error fetching the list of pods. Please try again.")
+	}
+
+	miniList := list.ConvertMini()
+	if sort != "" {
+		if err := rc.metricsUC.SortPods(c.Request().Context(), namespace, &miniList, sort); err != nil {
+			return newFailure(err, "Failed to sort pods by usage", "Error reading the resource usage of the pods. Please make sure metrics-server is installed and try again.")
+		}
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
-		Data:    list.ConvertMini(),
+		Data:    miniList,
 		Message: "Pods retrieved successfully.",
 	})
 }
@@ -140,29 +301,45 @@ func (rc *PodHandler) List(c echo.Context) error {
 //
 //	@Summary		Get a pod by name or UID
 //	@Description	Retrieves a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.
+//	@Description	With export, the native pod is returned as a manifest to apply again, without its status and the fields set by the cluster.
 //	@Tags			pods
 //	@Accept			json
 //	@Produce		json
+//	@Produce		application/yaml
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
 //	@Param			namespace		query		string			false	"Namespace to filter the pod by"
 //	@Param			id				path		string			true	"Name or UID of the pod"
+//	@Param			export			query		bool			false	"Return the pod as a manifest to apply again"
+//	@Param			format			query		string			false	"Format of the export, yaml or json, yaml when empty"
 //	@Success		200				{object}	SuccessResponse	"Details of the requested pod"
+//	@Header			200				{string}	ETag			"resourceVersion of the pod"
+//	@Failure		400				{object}	FailureResponse	"Invalid export parameters"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
 //	@Router			/pods/{id} [get]
 func (rc *PodHandler) GetByNameOrUID(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
 	nameOrUID := c.Param("id")
 
+	format, export, err := getExport(c)
+	if err != nil {
+		return newFailure(err, "Invalid export parameters", "Invalid parameters. Please check them and try again.")
+	}
+	if export {
+		return exportResponse(c, rc.exportUC, uc.PodKind, namespace, nameOrUID, format)
+	}
+
 	opts := model.ListOptions{}
 
 	list, err := rc.podsUC.GetByNameOrUID(c.Request().Context(), namespace, nameOrUID, opts)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve pod: %v", err),
-			Message: "Error fetching the pod details. Please verify the pod name or UID and try again.",
-		})
+		return newFailure(err, "Failed to retrieve pod", "Error fetching the pod details. Please verify the pod name or UID and try again.")
 	}
 
+	setETag(c, list.ResourceVersion)
+
 	return c.JSON(http.StatusOK, SuccessResponse{
 		Data:    list,
 		Message: "Pod retrieved successfully.",
@@ -173,29 +350,196 @@ func (rc *PodHandler) GetByNameOrUID(c echo.Context) error {
 //
 //	@Summary		Delete a pod by name or UID
 //	@Description	Deletes a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.
+//	@Description	With diff, nothing is deleted and the live pod is returned as a diff.
 //	@Tags			pods
 //	@Accept			json
 //	@Produce		json
-//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
-//	@Param			namespace		query		string			false	"Namespace to filter the pod by"
-//	@Param			id				path		string			true	"Name or UID of the pod"
-//	@Success		200				{string}	SuccessResponse	"Success message"
-//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Param			Authorization		header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace			query		string			false	"Namespace to filter the pod by"
+//	@Param			id					path		string			true	"Name or UID of the pod"
+//	@Param			propagationPolicy	query		string			false	"How the dependents are deleted (Orphan, Background or Foreground)"
+//	@Param			dryRun				query		string			false	"true or All to only run the deletion"
+//	@Param			diff				query		bool			false	"Dry run the deletion and return the diff"
+//	@Param			If-Match			header		string			false	"ETag of the pod, refused with 412 when the pod changed since"
+//	@Success		200					{string}	SuccessResponse	"Success message, or the diff of the dry run"
+//	@Failure		404					{object}	FailureResponse	"Not found"
+//	@Failure		412					{object}	FailureResponse	"The pod changed since it was read"
+//	@Failure		500					{object}	FailureResponse	"Interval error"
+//	@Failure		504					{object}	FailureResponse	"The API server timed out"
 //	@Router			/pods/{id} [delete]
 func (rc *PodHandler) Delete(c echo.Context) error {
 	namespace := c.QueryParam("namespace")
 	nameOrUID := c.Param("id")
 
-	opts := model.DeleteOptions{}
+	preconditions, err := ifMatchPreconditions(c)
+	if err != nil {
+		return newFailure(err, "Invalid If-Match header", "The If-Match header must hold the ETag of the pod. Please fetch it again and retry.")
+	}
+
+	propagationPolicy, err := getPropagationPolicy(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	dryRun, diff, err := getDryRun(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	opts := model.DeleteOptions{
+		Preconditions:     preconditions,
+		PropagationPolicy: propagationPolicy,
+		DryRun:            dryRun,
+	}
+
+	var live *model.Pod
+	if diff {
+		live, err = rc.livePod(c, namespace, nameOrUID)
+		if err != nil {
+			return newFailure(err, "Failed to delete pod", "Error deleting the pod. Please verify the pod name or UID and try again.")
+		}
+	}
 
 	if err := rc.podsUC.Delete(c.Request().Context(), namespace, nameOrUID, opts); err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to delete pod: %v", err),
-			Message: "Error deleting the pod. Please verify the pod name or UID and try again.",
-		})
+		return newFailure(err, "Failed to delete pod", "Error deleting the pod. Please verify the pod name or UID and try again.")
+	}
+
+	if diff {
+		return diffResponse(c, live, nil)
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
-		Message: "Pod deleted successfully.",
+		Message: dryRunMessage("Pod deleted successfully.", opts.DryRun),
 	})
 }
+
+// BulkDelete godoc
+//
+//	@Summary		Delete the pods matched by a label selector
+//	@Description	Without confirm, nothing is deleted and the pods matched by the label selector are returned with a confirmation token.
+//	@Description	With the token as confirm, the pods are deleted one by one with an event recorded for each, as long as the same pods still match, 412 otherwise. With evict, the pods are evicted instead, a pod a PodDisruptionBudget protects fails with its error.
+//	@Description	The pods failing to delete are reported with their error, the others are still deleted. When an event can't be recorded the deletion stops, the pods left are reported as failed with its error.
+//	@Tags			pods
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization		header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster				query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace			query		string			false	"Namespace of the pods, default when empty"
+//	@Param			labelSelector		query		string			true	"Label selector of the pods to delete"
+//	@Param			confirm				query		string			false	"Confirmation token of the preview, the pods are only previewed when empty"
+//	@Param			evict				query		bool			false	"Evict the pods through the eviction API, which respects the PodDisruptionBudgets, instead of deleting them"
+//	@Param			propagationPolicy	query		string			false	"How the dependents are deleted (Orphan, Background or Foreground)"
+//	@Param			dryRun				query		string			false	"true or All to only run the deletions"
+//	@Success		200					{object}	SuccessResponse	"The preview with its confirmation token, or the result of each deletion"
+//	@Failure		400					{object}	FailureResponse	"Missing or invalid label selector, or invalid parameters"
+//	@Failure		412					{object}	FailureResponse	"The pods matched changed since the preview"
+//	@Failure		500					{object}	FailureResponse	"Interval error"
+//	@Failure		504					{object}	FailureResponse	"The API server timed out"
+//	@Router			/pods [delete]
+func (rc *PodHandler) BulkDelete(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+
+	opts, err := getBulkDeleteOpts(c, true)
+	if err != nil {
+		return newFailure(err, "Invalid pod bulk deletion", "Invalid parameters. Please check them and try again.")
+	}
+
+	if opts.ConfirmationToken == "" {
+		preview, err := rc.podsUC.PreviewBulkDelete(c.Request().Context(), namespace, opts)
+		if err != nil {
+			return newFailure(err, "Failed to preview pod bulk deletion", "Error listing the pods to delete. Please check the label selector and try again.")
+		}
+
+		return bulkPreviewResponse(c, preview, "pods")
+	}
+
+	result, err := rc.podsUC.BulkDelete(c.Request().Context(), namespace, opts)
+	if err != nil {
+		return newFailure(err, "Failed to bulk delete pods", "Error deleting the pods. Please preview them again and confirm with the new token.")
+	}
+
+	return bulkResultResponse(c, result, "pods", opts.DryRun)
+}
+
+// ListEvents godoc
+//
+//	@Summary		List cluster events of a pod
+//	@Description	Retrieves the events reported by Kubernetes for a pod, newest first within each page. These are cluster events such as image pull failures, not the audit log.
+//	@Tags			pods
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string			false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string			false	"Namespace of the pod"
+//	@Param			id				path		string			true	"Name or UID of the pod"
+//	@Param			type			query		string			false	"Event type to filter by (Normal or Warning)"
+//	@Success		200				{object}	SuccessResponse	"List of cluster events"
+//	@Failure		400				{object}	FailureResponse	"Bad request"
+//	@Failure		404				{object}	FailureResponse	"Not found"
+//	@Failure		500				{object}	FailureResponse	"Interval error"
+//	@Failure		504				{object}	FailureResponse	"The API server timed out"
+//	@Router			/pods/{id}/events [get]
+func (rc *PodHandler) ListEvents(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+	nameOrUID := c.Param("id")
+
+	opts, err := getClusterEventListOpts(c)
+	if err != nil {
+		return newFailure(err, "Invalid pod events request", "Invalid parameters. Please check the event type and try again.")
+	}
+
+	list, err := rc.podsUC.ListClusterEvents(c.Request().Context(), namespace, nameOrUID, opts)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve pod events", "Error fetching the pod events. Please verify the pod name or UID and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    list,
+		Message: "Pod events retrieved successfully.",
+	})
+}
+
+// Metrics godoc
+//
+//	@Summary		Get the resource usage of a pod
+//	@Description	Retrieves the CPU and memory used by a pod and each of its containers, as reported by metrics-server, compared with their requests and limits.
+//	@Tags			pods
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string									true	"Insert your access token"	default(Bearer <Add access token here>)
+//	@Param			cluster			query		string									false	"Cluster to run the request against, the default cluster when empty"
+//	@Param			namespace		query		string									false	"Namespace of the pod"
+//	@Param			id				path		string									true	"Name or UID of the pod"
+//	@Success		200				{object}	SuccessResponse{data=model.PodMetrics}	"Resource usage of the pod"
+//	@Failure		404				{object}	FailureResponse							"Not found, or no metrics reported yet"
+//	@Failure		500				{object}	FailureResponse							"Interval error"
+//	@Failure		503				{object}	FailureResponse							"The metrics API is not available"
+//	@Failure		504				{object}	FailureResponse							"The API server timed out"
+//	@Router			/pods/{id}/metrics [get]
+func (rc *PodHandler) Metrics(c echo.Context) error {
+	namespace := c.QueryParam("namespace")
+	nameOrUID := c.Param("id")
+
+	metrics, err := rc.metricsUC.PodMetrics(c.Request().Context(), namespace, nameOrUID)
+	if err != nil {
+		return newFailure(err, "Failed to retrieve pod metrics", "Error reading the resource usage of the pod. Please make sure metrics-server is installed and try again.")
+	}
+
+	return c.JSON(http.StatusOK, SuccessResponse{
+		Data:    metrics,
+		Message: "Pod metrics retrieved successfully.",
+	})
+}
+
+// livePod returns the pod as it is before a change, without the warnings of the cluster which are not part of it.
+func (rc *PodHandler) livePod(c echo.Context, namespace, nameOrUID string) (*model.Pod, error) {
+	pod, err := rc.podsUC.GetByNameOrUID(c.Request().Context(), namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pod.Warnings = nil
+
+	return pod, nil
+}
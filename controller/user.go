@@ -31,6 +31,8 @@ func NewUserHandlers(uc *uc.UserUC) *UserHandlers {
 //	@Param			body			body		model.UserRequest	true	"User creation input"
 //	@Success		201				{object}	SuccessResponse		"user username"
 //	@Failure		400				{object}	FailureResponse		"Error message including details on failure"
+//	@Failure		409				{object}	FailureResponse		"Already exists"
+//	@Failure		422				{object}	FailureResponse		"Rejected by the validation"
 //	@Failure		500				{object}	FailureResponse		"Interval error"
 //	@Router			/users [post]
 func (rc *UserHandlers) CreateUser(c echo.Context) error {
@@ -43,6 +45,10 @@ func (rc *UserHandlers) CreateUser(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&input); err != nil {
+		return newFailure(err, "Invalid user request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
 	user := model.User{
 		Username: input.Username,
 		Email:    input.Email,
@@ -52,10 +58,7 @@ func (rc *UserHandlers) CreateUser(c echo.Context) error {
 
 	_, err := rc.userUC.Create(c.Request().Context(), user)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to create user: %v", err),
-			Message: "User creation failed. Please check the provided details and try again.",
-		})
+		return newFailure(err, "Failed to create user", "User creation failed. Please check the provided details and try again.")
 	}
 
 	return c.JSON(http.StatusCreated, SuccessResponse{
@@ -75,6 +78,9 @@ func (rc *UserHandlers) CreateUser(c echo.Context) error {
 //	@Param			body			body		model.UserRequest	true	"User update input"
 //	@Success		200				{object}	SuccessResponse		"user username"
 //	@Failure		400				{object}	FailureResponse		"Error message including details on failure"
+//	@Failure		404				{object}	FailureResponse		"Not found"
+//	@Failure		409				{object}	FailureResponse		"Conflicting update"
+//	@Failure		422				{object}	FailureResponse		"Rejected by the validation"
 //	@Failure		500				{object}	FailureResponse		"Interval error"
 //	@Router			/users/{id} [put]
 func (rc *UserHandlers) UpdateUser(c echo.Context) error {
@@ -88,6 +94,10 @@ func (rc *UserHandlers) UpdateUser(c echo.Context) error {
 		})
 	}
 
+	if err := c.Validate(&input); err != nil {
+		return newFailure(err, "Invalid user request", "Invalid request data. Please fix the listed fields and try again.")
+	}
+
 	user := model.User{
 		Username: input.Username,
 		Email:    input.Email,
@@ -97,10 +107,7 @@ func (rc *UserHandlers) UpdateUser(c echo.Context) error {
 
 	_, err := rc.userUC.Update(c.Request().Context(), id, user)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to update user: %v", err),
-			Message: "User update failed. Please check the provided details and try again.",
-		})
+		return newFailure(err, "Failed to update user", "User update failed. Please check the provided details and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -130,10 +137,7 @@ func (rc *UserHandlers) List(c echo.Context) error {
 
 	list, err := rc.userUC.List(c.Request().Context(), &opts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to retrieve user list: %v", err),
-			Message: "Unable to retrieve the list of users. Please check the query parameters and try again.",
-		})
+		return newFailure(err, "Failed to retrieve user list", "Unable to retrieve the list of users. Please check the query parameters and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -152,6 +156,7 @@ func (rc *UserHandlers) List(c echo.Context) error {
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
 //	@Param			id				path		string			true	"User ID"
 //	@Success		200				{object}	SuccessResponse	"Successful response containing the user information"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Internal server error"
 //	@Router			/users/{id} [get]
 func (rc *UserHandlers) GetByID(c echo.Context) error {
@@ -159,10 +164,7 @@ func (rc *UserHandlers) GetByID(c echo.Context) error {
 
 	user, err := rc.userUC.GetByID(c.Request().Context(), id)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   "Internal Server Error",
-			Message: fmt.Sprintf("Failed to retrieve user: %v", err),
-		})
+		return newFailure(err, "Failed to retrieve user", "Unable to retrieve the user. Please check the ID and try again.")
 	}
 
 	// Remove the password from the user object before returning it
@@ -183,16 +185,14 @@ func (rc *UserHandlers) GetByID(c echo.Context) error {
 //	@Produce		json
 //	@Param			Authorization	header		string			true	"Insert your access token"	default(Bearer <Add access token here>)
 //	@Success		200				{object}	SuccessResponse	"user username"
+//	@Failure		404				{object}	FailureResponse	"Not found"
 //	@Failure		500				{object}	FailureResponse	"Interval error"
 //	@Router			/users/{id} [delete]
 func (rc *UserHandlers) DeleteUser(c echo.Context) error {
 	id := c.Param("id")
 
 	if err := rc.userUC.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusInternalServerError, FailureResponse{
-			Error:   fmt.Sprintf("Failed to delete user: %v", err),
-			Message: "User delete failed. Please check the provided details and try again.",
-		})
+		return newFailure(err, "Failed to delete user", "User delete failed. Please check the provided details and try again.")
 	}
 
 	return c.JSON(http.StatusOK, SuccessResponse{
@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/distribution/reference"
+	"github.com/go-playground/validator/v10"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// totalAnnotationSizeLimit is the most bytes the annotations of an object can hold, as the API server enforces.
+const totalAnnotationSizeLimit = 256 * 1024
+
+// stringRules are the Kubernetes rules of the string fields, by tag. They return what is wrong with the value.
+var stringRules = map[string]func(string) []string{
+	"dns1123label":     validation.IsDNS1123Label,
+	"dns1123subdomain": validation.IsDNS1123Subdomain,
+	"labelkey":         validation.IsQualifiedName,
+	"envvarname":       validation.IsEnvVarName,
+	"portname":         validation.IsValidPortName,
+	"image":            imageProblems,
+	"quantity":         quantityProblems,
+}
+
+// Validator checks the bound requests against the binding tags of the models, along with the
+// Kubernetes rules of names, labels, images, ports and replicas. It is the validator of Echo,
+// invalid requests are answered with 422 and every failing field.
+type Validator struct {
+	validate *validator.Validate
+}
+
+func NewValidator() *Validator {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	// report the json path of the fields, like pod.spec.containers[0].image
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	for tag, rule := range stringRules {
+		validate.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return len(rule(fl.Field().String())) == 0
+		})
+	}
+
+	validate.RegisterValidation("labels", func(fl validator.FieldLevel) bool {
+		labels, _ := fl.Field().Interface().(map[string]string)
+		return len(labelsProblems(labels)) == 0
+	})
+	validate.RegisterValidation("annotations", func(fl validator.FieldLevel) bool {
+		annotations, _ := fl.Field().Interface().(map[string]string)
+		return len(annotationsProblems(annotations)) == 0
+	})
+	validate.RegisterValidation("replicas", func(fl validator.FieldLevel) bool {
+		replicas := fl.Field().Int()
+		return replicas >= 0 && replicas <= int64(config.Get().APIService.MaxReplicas)
+	})
+
+	validate.RegisterStructValidation(validatePodsCreateRequest, model.PodsCreateRequest{})
+	validate.RegisterStructValidation(validateDeploymentCreateRequest, model.DeploymentCreateRequest{})
+	validate.RegisterStructValidation(validateNamespaceCreateRequest, model.NamespaceCreateRequest{})
+	validate.RegisterStructValidation(validateDeploymentSpec, model.DeploymentSpec{})
+
+	return &Validator{
+		validate: validate,
+	}
+}
+
+// Validate returns a uc.Error listing the invalid fields of i, if any.
+func (rc *Validator) Validate(i interface{}) error {
+	err := rc.validate.Struct(i)
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	ucErr := uc.NewError(uc.CodeInvalid, "request has %d invalid fields", len(validationErrors))
+	for _, v := range validationErrors {
+		ucErr.Causes = append(ucErr.Causes, uc.ErrorCause{
+			Field:   fieldPath(v),
+			Message: causeMessage(v),
+		})
+	}
+
+	return ucErr
+}
+
+func validatePodsCreateRequest(sl validator.StructLevel) {
+	request := sl.Current().Interface().(model.PodsCreateRequest)
+
+	validateObjectName(sl, "pod.metadata", request.Pod.ObjectMeta, "dns1123subdomain")
+
+	if len(request.Pod.Spec.Containers) == 0 {
+		sl.ReportError(request.Pod.Spec.Containers, "pod.spec.containers", "Containers", "min", "1")
+	}
+}
+
+func validateDeploymentCreateRequest(sl validator.StructLevel) {
+	request := sl.Current().Interface().(model.DeploymentCreateRequest)
+
+	validateObjectName(sl, "deployment.metadata", request.Deployment.ObjectMeta, "dns1123subdomain")
+
+	if len(request.Deployment.Spec.Template.Spec.Containers) == 0 {
+		sl.ReportError(request.Deployment.Spec.Template.Spec.Containers, "deployment.spec.template.spec.containers", "Containers", "min", "1")
+	}
+}
+
+func validateNamespaceCreateRequest(sl validator.StructLevel) {
+	request := sl.Current().Interface().(model.NamespaceCreateRequest)
+
+	validateObjectName(sl, "namespace.metadata", request.Namespace.ObjectMeta, "dns1123label")
+}
+
+// validateDeploymentSpec requires a selector matching the labels of the pod template,
+// otherwise the deployment would not own the pods it creates.
+func validateDeploymentSpec(sl validator.StructLevel) {
+	spec := sl.Current().Interface().(model.DeploymentSpec)
+
+	switch {
+	case spec.Selector == nil || spec.Selector.IsEmpty():
+		sl.ReportError(spec.Selector, "selector", "Selector", "required", "")
+	case !spec.Selector.Matches(spec.Template.Labels):
+		sl.ReportError(spec.Template.Labels, "template.metadata.labels", "Labels", "selector", "")
+	}
+}
+
+// validateObjectName requires a name following the rule of tag, unless the name is to be generated.
+func validateObjectName(sl validator.StructLevel, path string, meta model.ObjectMeta, tag string) {
+	switch {
+	case meta.Name == "" && meta.GenerateName == "":
+		sl.ReportError(meta.Name, path+".name", "Name", "required", "")
+	case meta.Name != "" && len(stringRules[tag](meta.Name)) > 0:
+		sl.ReportError(meta.Name, path+".name", "Name", tag, "")
+	}
+}
+
+func imageProblems(image string) []string {
+	if _, err := reference.ParseNormalizedNamed(image); err != nil {
+		return []string{fmt.Sprintf("must be a valid image reference: %v", err)}
+	}
+
+	return nil
+}
+
+func quantityProblems(quantity string) []string {
+	if _, err := resource.ParseQuantity(quantity); err != nil {
+		return []string{"must be a quantity like 500m, 2 or 128Mi"}
+	}
+
+	return nil
+}
+
+func labelsProblems(labels map[string]string) []string {
+	problems := make([]string, 0)
+	for _, k := range sortedKeys(labels) {
+		for _, v := range validation.IsQualifiedName(k) {
+			problems = append(problems, fmt.Sprintf("key %q: %s", k, v))
+		}
+		for _, v := range validation.IsValidLabelValue(labels[k]) {
+			problems = append(problems, fmt.Sprintf("value of %q: %s", k, v))
+		}
+	}
+
+	return problems
+}
+
+func annotationsProblems(annotations map[string]string) []string {
+	problems := make([]string, 0)
+
+	size := 0
+	for _, k := range sortedKeys(annotations) {
+		for _, v := range validation.IsQualifiedName(strings.ToLower(k)) {
+			problems = append(problems, fmt.Sprintf("key %q: %s", k, v))
+		}
+		size += len(k) + len(annotations[k])
+	}
+
+	if size > totalAnnotationSizeLimit {
+		problems = append(problems, fmt.Sprintf("must have at most %d bytes", totalAnnotationSizeLimit))
+	}
+
+	return problems
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// fieldPath returns the json path of the field, without the name of the validated struct.
+func fieldPath(fe validator.FieldError) string {
+	_, path, _ := strings.Cut(fe.Namespace(), ".")
+	return path
+}
+
+func causeMessage(fe validator.FieldError) string {
+	if rule, ok := stringRules[fe.Tag()]; ok {
+		value, _ := fe.Value().(string)
+		return strings.Join(rule(value), "; ")
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "Required value"
+	case "min":
+		if fe.Kind() == reflect.Slice || fe.Kind() == reflect.Map {
+			return fmt.Sprintf("must have at least %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be greater than or equal to %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be less than or equal to %s", fe.Param())
+	case "excluded_with":
+		param := fe.Param()
+		return fmt.Sprintf("must not be set along with %s", strings.ToLower(param[:1])+param[1:])
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.ReplaceAll(fe.Param(), " ", ", "))
+	case "email":
+		return "must be a valid email address"
+	case "ip":
+		return "must be a valid IP address"
+	case "labels":
+		labels, _ := fe.Value().(map[string]string)
+		return strings.Join(labelsProblems(labels), "; ")
+	case "annotations":
+		annotations, _ := fe.Value().(map[string]string)
+		return strings.Join(annotationsProblems(annotations), "; ")
+	case "replicas":
+		return fmt.Sprintf("must be between 0 and %d", config.Get().APIService.MaxReplicas)
+	case "selector":
+		return "must match the selector of the deployment"
+	}
+
+	return fe.Error()
+}
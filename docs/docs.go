@@ -15,6 +15,133 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/apply": {
+            "post": {
+                "description": "Server-side applies the objects of multi-document YAML or JSON list manifests, of any kind the cluster serves.\nNamespaces and custom resource definitions are applied first, and every object is reported as created, configured, unchanged or failed.\nWith prune, the objects of the applied kinds matching the label selector but missing from the manifests are deleted, unless an object failed.\nThe response is 207 when an object failed.",
+                "consumes": [
+                    "application/yaml",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "apply"
+                ],
+                "summary": "Apply manifests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the objects not naming one, default when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the actor making the changes, kubernetes-api when empty",
+                        "name": "fieldManager",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Take over the fields managed by others",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the apply",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector of the objects to delete when missing from the manifests",
+                        "name": "prune",
+                        "in": "query"
+                    },
+                    {
+                        "description": "The manifests",
+                        "name": "manifests",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Result of every object",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ApplyResultList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "Result of every object, some failed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ApplyResultList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid manifests or parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "The manifests are too large",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/github_callback": {
             "get": {
                 "description": "This endpoint handles the callback from Github after a user authorizes the app. It exchanges the authorization code for an access token and retrieves the users profile information.",
@@ -203,9 +330,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/deployments": {
+        "/clusters": {
             "get": {
-                "description": "Retrieves a list of deployments from the Kubernetes cluster, optionally filtered by namespace.",
+                "description": "Retrieves every cluster the API can manage, with the health of its API server.",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,9 +340,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "deployments"
+                    "clusters"
                 ],
-                "summary": "List deployments",
+                "summary": "List clusters",
                 "parameters": [
                     {
                         "type": "string",
@@ -224,29 +351,11 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Maximum number of deployments to retrieve",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pagination token for fetching more deployments",
-                        "name": "continue",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Namespace to filter deployments by",
-                        "name": "namespace",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of deployments",
+                        "description": "List of clusters",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
@@ -259,8 +368,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing deployment in the Kubernetes cluster.",
+            "post": {
+                "description": "Registers a Kubernetes cluster from a kubeconfig embedding its credentials, file paths, exec and auth-provider are rejected. The kubeconfig is stored encrypted and never returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -268,9 +377,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "deployments"
+                    "clusters"
                 ],
-                "summary": "Update an existing deployment",
+                "summary": "Register a cluster",
                 "parameters": [
                     {
                         "type": "string",
@@ -281,24 +390,36 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Deployment request body",
-                        "name": "deployment",
+                        "description": "Cluster request body",
+                        "name": "cluster",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.DeploymentUpdateRequest"
+                            "$ref": "#/definitions/model.ClusterRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully updated the deployment",
+                    "201": {
+                        "description": "Successfully registered cluster",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request or invalid data",
+                        "description": "Bad request or error message",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
@@ -310,9 +431,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new deployment in the Kubernetes cluster.",
+            }
+        },
+        "/clusters/{cluster}": {
+            "delete": {
+                "description": "Removes a cluster registered through the API. Clusters defined in the configuration cannot be deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -320,9 +443,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "deployments"
+                    "clusters"
                 ],
-                "summary": "Create a new deployment",
+                "summary": "Delete a cluster",
                 "parameters": [
                     {
                         "type": "string",
@@ -333,27 +456,22 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Deployment request body",
-                        "name": "deployment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.DeploymentCreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Name of the cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Suxccessfully created deployment",
+                    "200": {
+                        "description": "Success message",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request or error message",
+                    "404": {
+                        "description": "Not found",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
@@ -367,9 +485,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/deployments/{id}": {
+        "/deployments": {
             "get": {
-                "description": "Retrieves a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.",
+                "description": "Retrieves a list of deployments from the Kubernetes cluster, optionally filtered by namespace.",
                 "consumes": [
                     "application/json"
                 ],
@@ -379,7 +497,7 @@ const docTemplate = `{
                 "tags": [
                     "deployments"
                 ],
-                "summary": "Get a deployment by name or UID",
+                "summary": "List deployments",
                 "parameters": [
                     {
                         "type": "string",
@@ -391,21 +509,32 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter the deployment by",
-                        "name": "namespace",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Name or UID of the deployment",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Maximum number of deployments to retrieve",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination token for fetching more deployments",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter deployments by",
+                        "name": "namespace",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Details of the requested deployment",
+                        "description": "List of deployments",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
@@ -415,11 +544,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.",
+            "put": {
+                "description": "Updates an existing deployment in the Kubernetes cluster.\nWith diff, nothing is changed and the differences between the live deployment and the result are returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -429,7 +564,7 @@ const docTemplate = `{
                 "tags": [
                     "deployments"
                 ],
-                "summary": "Delete a deployment by name or UID",
+                "summary": "Update an existing deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -441,37 +576,97 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter the deployment by",
-                        "name": "namespace",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Deployment request body",
+                        "name": "deployment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeploymentUpdateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the update",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the update and return the diff",
+                        "name": "diff",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Name or UID of the deployment",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag of the deployment, refused with 412 when the deployment changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Successfully updated the deployment, or the diff of the dry run",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the deployment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid data",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The deployment changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
-                        "description": "Bad request or error message",
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     }
                 }
-            }
-        },
-        "/events": {
-            "get": {
-                "description": "Retrieves a list of events from the database.",
+            },
+            "post": {
+                "description": "Creates a new deployment in the Kubernetes cluster.\nThe config maps, secrets and keys referenced by the environment of its containers but missing from the namespace are returned as warnings.\nWith diff, nothing is created and the deployment computed by the API server is returned as a diff.",
                 "consumes": [
                     "application/json"
                 ],
@@ -479,9 +674,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "deployments"
                 ],
-                "summary": "List events",
+                "summary": "Create a new deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -493,40 +688,76 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "kind to filter events by",
-                        "name": "kind",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "event kind to filter events by",
-                        "name": "event_kind",
+                        "description": "true or All to only run the creation",
+                        "name": "dryRun",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "creation time to filter events by",
-                        "name": "creation_time",
+                        "type": "boolean",
+                        "description": "Dry run the creation and return the diff",
+                        "name": "diff",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "owner id to filter events by",
-                        "name": "owner_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "owner username to filter events by",
-                        "name": "owner_username",
-                        "in": "query"
+                        "description": "Deployment request body",
+                        "name": "deployment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeploymentCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of events",
+                        "description": "Diff of the dry run",
                         "schema": {
-                            "$ref": "#/definitions/controller.SuccessResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.Diff"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "201": {
+                        "description": "Suxccessfully created deployment",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or error message",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
@@ -534,13 +765,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/events/{id}": {
-            "get": {
-                "description": "Retrieves a event from Database by its ID, optionally filtered by namespace.",
+            },
+            "delete": {
+                "description": "Without confirm, nothing is deleted and the deployments matched by the label selector are returned with a confirmation token.\nWith the token as confirm, the deployments are deleted one by one with an event recorded for each, as long as the same deployments still match, 412 otherwise.\nThe deployments failing to delete are reported with their error, the others are still deleted. When an event can't be recorded the deletion stops, the deployments left are reported as failed with its error.",
                 "consumes": [
                     "application/json"
                 ],
@@ -548,9 +783,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "deployments"
                 ],
-                "summary": "Get a event by ID",
+                "summary": "Delete the deployments matched by a label selector",
                 "parameters": [
                     {
                         "type": "string",
@@ -562,41 +797,90 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "ID of the event",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the deployments, default when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector of the deployments to delete",
+                        "name": "labelSelector",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirmation token of the preview, the deployments are only previewed when empty",
+                        "name": "confirm",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How the dependents are deleted (Orphan, Background or Foreground)",
+                        "name": "propagationPolicy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the deletions",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Details of the requested event",
+                        "description": "The preview with its confirmation token, or the result of each deletion",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
+                    "400": {
+                        "description": "Missing or invalid label selector, or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The deployments matched changed since the preview",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
                     "500": {
                         "description": "Interval error",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
             }
         },
-        "/namespaces": {
+        "/deployments/{id}": {
             "get": {
-                "description": "Retrieves a list of namespaces from the Kubernetes cluster.",
+                "description": "Retrieves a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.\nWith export, the native deployment is returned as a manifest to apply again, without its status and the fields set by the cluster.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "namespaces"
+                    "deployments"
                 ],
-                "summary": "List namespaces",
+                "summary": "Get a deployment by name or UID",
                 "parameters": [
                     {
                         "type": "string",
@@ -608,34 +892,77 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Maximum number of namespaces to retrieve",
-                        "name": "limit",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Pagination token for fetching more namespaces",
-                        "name": "continue",
+                        "description": "Namespace to filter the deployment by",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the deployment",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the deployment as a manifest to apply again",
+                        "name": "export",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the export, yaml or json, yaml when empty",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of namespaces",
+                        "description": "Details of the requested deployment",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the deployment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
-                        "description": "Bad request or error message",
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing namespace in the Kubernetes cluster.",
+            "delete": {
+                "description": "Deletes a deployment from the Kubernetes cluster by its name or UID, optionally filtered by namespace.\nWith diff, nothing is deleted and the live deployment is returned as a diff.",
                 "consumes": [
                     "application/json"
                 ],
@@ -643,9 +970,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "namespaces"
+                    "deployments"
                 ],
-                "summary": "Update an existing namespace",
+                "summary": "Delete a deployment by name or UID",
                 "parameters": [
                     {
                         "type": "string",
@@ -656,48 +983,96 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Namespace request body",
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter the deployment by",
                         "name": "namespace",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.NamespaceUpdateRequest"
-                        }
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the deployment",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "How the dependents are deleted (Orphan, Background or Foreground)",
+                        "name": "propagationPolicy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the deletion",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the deletion and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the deployment, refused with 412 when the deployment changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated the namespace",
+                        "description": "Success message, or the diff of the dry run",
                         "schema": {
-                            "$ref": "#/definitions/controller.SuccessResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad request or invalid data",
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The deployment changed since it was read",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
-                        "description": "Interval error",
+                        "description": "Bad request or error message",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new namespace in the Kubernetes cluster.",
+            "patch": {
+                "description": "Patches any field of a deployment, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.\nServer-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.\nWith diff, nothing is changed and the differences between the live deployment and the result are returned.",
                 "consumes": [
-                    "application/json"
+                    "application/merge-patch+json",
+                    "application/strategic-merge-patch+json",
+                    "application/apply-patch+yaml"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespaces"
+                    "deployments"
                 ],
-                "summary": "Create a new namespace",
+                "summary": "Patch a deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -708,72 +1083,117 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Namespace request body",
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the deployment",
                         "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the deployment",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the actor making the changes",
+                        "name": "fieldManager",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How unknown or duplicate fields are handled (Ignore, Warn or Strict)",
+                        "name": "fieldValidation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the patch",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the patch and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Take over the fields managed by others, server-side apply only",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the deployment, refused with 412 when the deployment changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "The patch",
+                        "name": "patch",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.NamespaceCreateRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully created namespace",
+                    "200": {
+                        "description": "The patched deployment, or the diff of the dry run",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the deployment"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad request or error message",
+                        "description": "Bad request or invalid patch",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
-                    "500": {
-                        "description": "Interval error",
+                    "404": {
+                        "description": "Not found",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/namespaces/{id}": {
-            "get": {
-                "description": "Retrieves a namespace from the Kubernetes cluster by its name or UID.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "namespaces"
-                ],
-                "summary": "Get a namespace by name or UID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "Bearer \u003cAdd access token here\u003e",
-                        "description": "Insert your access token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "Name or UID of the namespace",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Details of the requested namespace",
+                    "409": {
+                        "description": "Conflicting fields or update",
                         "schema": {
-                            "$ref": "#/definitions/controller.SuccessResponse"
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The deployment changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch format",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
@@ -781,11 +1201,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a namespace from the Kubernetes cluster by its name or UID.",
+            }
+        },
+        "/deployments/{id}/events": {
+            "get": {
+                "description": "Retrieves the events reported by Kubernetes for a deployment, newest first within each page. These are cluster events such as scaling failures, not the audit log.",
                 "consumes": [
                     "application/json"
                 ],
@@ -793,9 +1221,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "namespaces"
+                    "deployments"
                 ],
-                "summary": "Delete a namespace by name or UID",
+                "summary": "List cluster events of a deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -807,17 +1235,47 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Name of the Namespace",
-                        "name": "name",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the deployment",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the deployment",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type to filter by (Normal or Warning)",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "List of cluster events",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
@@ -825,13 +1283,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
             }
         },
-        "/pods": {
+        "/events": {
             "get": {
-                "description": "Retrieves a list of pods from the Kubernetes cluster. You can filter results by namespace or paginate the response using the limit and continue parameters.",
+                "description": "Retrieves a list of events from the database.",
                 "consumes": [
                     "application/json"
                 ],
@@ -839,9 +1303,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pods"
+                    "events"
                 ],
-                "summary": "List pods",
+                "summary": "List events",
                 "parameters": [
                     {
                         "type": "string",
@@ -853,26 +1317,38 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Maximum number of pods to retrieve",
-                        "name": "limit",
+                        "description": "kind to filter events by",
+                        "name": "kind",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Pagination token for fetching more pods",
-                        "name": "continue",
+                        "description": "event kind to filter events by",
+                        "name": "event_kind",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter pods by",
-                        "name": "namespace",
+                        "description": "creation time to filter events by",
+                        "name": "creation_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owner id to filter events by",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owner username to filter events by",
+                        "name": "owner_username",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of pods",
+                        "description": "List of events",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
@@ -884,9 +1360,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new pod in the Kubernetes cluster.",
+            }
+        },
+        "/events/verify": {
+            "get": {
+                "description": "Walks the event hash chain, starting from the last archive anchor, and reports the first broken link.",
                 "consumes": [
                     "application/json"
                 ],
@@ -894,9 +1372,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pods"
+                    "events"
                 ],
-                "summary": "Create a new pod",
+                "summary": "Verify the event hash chain",
                 "parameters": [
                     {
                         "type": "string",
@@ -905,30 +1383,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "Pod request body",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.PodsCreateRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully created the pod",
+                    "200": {
+                        "description": "Verification report of the event chain",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request or invalid data",
-                        "schema": {
-                            "$ref": "#/definitions/controller.FailureResponse"
-                        }
-                    },
                     "500": {
                         "description": "Interval error",
                         "schema": {
@@ -938,9 +1401,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pods/{id}": {
+        "/events/{id}": {
             "get": {
-                "description": "Retrieves a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.",
+                "description": "Retrieves a event from Database by its ID, optionally filtered by namespace.",
                 "consumes": [
                     "application/json"
                 ],
@@ -948,9 +1411,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pods"
+                    "events"
                 ],
-                "summary": "Get a pod by name or UID",
+                "summary": "Get a event by ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -962,13 +1425,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter the pod by",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Name or UID of the pod",
+                        "description": "ID of the event",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -976,11 +1433,17 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Details of the requested pod",
+                        "description": "Details of the requested event",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
                     "500": {
                         "description": "Interval error",
                         "schema": {
@@ -988,9 +1451,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update specific fields of an existing pod in the Kubernetes cluster. The following fields are changeable:\n- containers.image\n- initContainers.image\n- tolerations (only additions)\n- activeDeadlineSeconds\n- terminationGracePeriodSeconds",
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Reports the process is alive, without checking its dependencies.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "The process is alive",
+                        "schema": {
+                            "$ref": "#/definitions/model.HealthReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespaces": {
+            "get": {
+                "description": "Retrieves a list of namespaces from the Kubernetes cluster.",
                 "consumes": [
                     "application/json"
                 ],
@@ -998,9 +1483,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pods"
+                    "namespaces"
                 ],
-                "summary": "Update an existing pod",
+                "summary": "List namespaces",
                 "parameters": [
                     {
                         "type": "string",
@@ -1011,51 +1496,47 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Pod update request body",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.PodsUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter the pod by",
-                        "name": "namespace",
+                        "description": "Maximum number of namespaces to retrieve",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Name or UID of the pod",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Pagination token for fetching more namespaces",
+                        "name": "continue",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pod successfully updated",
+                        "description": "List of namespaces",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request or invalid input data",
+                    "500": {
+                        "description": "Bad request or error message",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
-                    "500": {
-                        "description": "Interval error",
+                    "504": {
+                        "description": "The API server timed out",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.",
+            "put": {
+                "description": "Updates an existing namespace in the Kubernetes cluster.\nWith diff, nothing is changed and the differences between the live namespace and the result are returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1063,9 +1544,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pods"
+                    "namespaces"
                 ],
-                "summary": "Delete a pod by name or UID",
+                "summary": "Update an existing namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1077,47 +1558,89 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Namespace to filter the pod by",
-                        "name": "namespace",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Name or UID of the pod",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "true or All to only run the update",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the update and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Namespace request body",
+                        "name": "namespace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.NamespaceUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Successfully updated the namespace, or the diff of the dry run",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
-                    "500": {
-                        "description": "Interval error",
+                    "400": {
+                        "description": "Bad request or invalid data",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/users": {
-            "get": {
-                "description": "Retrieves a filtered and paginated list of users from the database based on query parameters.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new namespace in the Kubernetes cluster.\nWith diff, nothing is created and the namespace computed by the API server is returned as a diff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "namespaces"
                 ],
-                "summary": "List all users",
+                "summary": "Create a new namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1129,62 +1652,104 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Limit the number of users returned",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Number of users to skip for pagination",
-                        "name": "skip",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter users by username",
-                        "name": "username",
+                        "description": "true or All to only run the creation",
+                        "name": "dryRun",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter users by email",
-                        "name": "email",
+                        "type": "boolean",
+                        "description": "Dry run the creation and return the diff",
+                        "name": "diff",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter users by role ID",
-                        "name": "role_id",
-                        "in": "query"
+                        "description": "Namespace request body",
+                        "name": "namespace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.NamespaceCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response containing the list of users",
+                        "description": "Diff of the dry run",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.Diff"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "201": {
+                        "description": "Successfully created namespace",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad request or error message",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
                     "500": {
                         "description": "Interval error",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
-            },
-            "post": {
-                "description": "This endpoint creates a new user by providing username, email, password, and role ID.",
+            }
+        },
+        "/namespaces/{id}": {
+            "get": {
+                "description": "Retrieves a namespace from the Kubernetes cluster by its name or UID.\nWith export, the native namespace is returned as a manifest to apply again, without its status and the fields set by the cluster.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "users"
+                    "namespaces"
                 ],
-                "summary": "CreateUser creates a new user",
+                "summary": "Get a namespace by name or UID",
                 "parameters": [
                     {
                         "type": "string",
@@ -1195,24 +1760,46 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "User creation input",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UserRequest"
-                        }
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the namespace",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the namespace as a manifest to apply again",
+                        "name": "export",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the export, yaml or json, yaml when empty",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "user username",
+                    "200": {
+                        "description": "Details of the requested namespace",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Error message including details on failure",
+                        "description": "Invalid export parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
@@ -1222,13 +1809,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/users/{id}": {
-            "get": {
-                "description": "Fetches a user by their unique ID from the database.",
+            },
+            "delete": {
+                "description": "Deletes a namespace from the Kubernetes cluster by its name or UID.\nWith diff, nothing is deleted and the live namespace is returned as a diff.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1236,9 +1827,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "namespaces"
                 ],
-                "summary": "Retrieve user by ID",
+                "summary": "Delete a namespace by name or UID",
                 "parameters": [
                     {
                         "type": "string",
@@ -1250,39 +1841,77 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the Namespace",
+                        "name": "name",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "How the dependents are deleted (Orphan, Background or Foreground)",
+                        "name": "propagationPolicy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the deletion",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the deletion and return the diff",
+                        "name": "diff",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response containing the user information",
+                        "description": "Success message, or the diff of the dry run",
                         "schema": {
-                            "$ref": "#/definitions/controller.SuccessResponse"
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "This endpoint updates a user by providing username, email, password, and role ID.",
+            "patch": {
+                "description": "Patches any field of a namespace, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.\nServer-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.\nWith diff, nothing is changed and the differences between the live namespace and the result are returned.",
                 "consumes": [
-                    "application/json"
+                    "application/merge-patch+json",
+                    "application/strategic-merge-patch+json",
+                    "application/apply-patch+yaml"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "namespaces"
                 ],
-                "summary": "UpdateUser updates an existing user",
+                "summary": "Patch a namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1293,24 +1922,109 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "User update input",
-                        "name": "body",
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the namespace",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the actor making the changes",
+                        "name": "fieldManager",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How unknown or duplicate fields are handled (Ignore, Warn or Strict)",
+                        "name": "fieldValidation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the patch",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the patch and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Take over the fields managed by others, server-side apply only",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the namespace, refused with 412 when the namespace changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "The patch",
+                        "name": "patch",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UserRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "user username",
+                        "description": "The patched namespace",
                         "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the namespace"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Error message including details on failure",
+                        "description": "Bad request or invalid patch",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting fields or update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The namespace changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch format",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
@@ -1320,11 +2034,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "This endpoint deletes a user by providing user id.",
+            }
+        },
+        "/namespaces/{id}/cluster-events": {
+            "get": {
+                "description": "Retrieves every event reported by Kubernetes in a namespace, newest first within each page. These are cluster events, not the audit log.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1332,9 +2054,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "namespaces"
                 ],
-                "summary": "DeleteUser deletes an existing user",
+                "summary": "List cluster events of a namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1343,12 +2065,289 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "user username",
-                        "schema": {
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the namespace",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type to filter by (Normal or Warning)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum number of events to retrieve",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination token for fetching more events",
+                        "name": "continue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of cluster events",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespaces/{id}/export": {
+            "get": {
+                "description": "Exports the namespace and every supported object in it as manifests to apply again, without their status and the fields set by the cluster.\nThe objects the cluster creates by itself, like the pods of a deployment, are left out, and so are the secrets unless includeSecrets is set.\nThe manifests are returned as multi-document YAML, or as a tar.gz archive holding a file for each object.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/yaml",
+                    "application/gzip"
+                ],
+                "tags": [
+                    "namespaces"
+                ],
+                "summary": "Export a namespace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the namespace",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the export, yaml or tar.gz, yaml when empty",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Export the secrets too",
+                        "name": "includeSecrets",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The manifests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/namespaces/{id}/metrics": {
+            "get": {
+                "description": "Retrieves the CPU and memory used by a namespace and each of its pods, most CPU using pod first, as reported by metrics-server, compared with their requests and limits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "namespaces"
+                ],
+                "summary": "Get the resource usage of a namespace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the namespace",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resource usage of the namespace",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.NamespaceMetrics"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "The metrics API is not available",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes": {
+            "get": {
+                "description": "Retrieves a list of nodes from the Kubernetes cluster, with their capacity, taints and the number of pods scheduled on them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nodes"
+                ],
+                "summary": "List nodes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector to filter the nodes by",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum number of nodes to retrieve",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination token for fetching more nodes",
+                        "name": "continue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of nodes",
+                        "schema": {
                             "$ref": "#/definitions/controller.SuccessResponse"
                         }
                     },
@@ -1357,899 +2356,3995 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.FailureResponse"
                         }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{id}": {
+            "get": {
+                "description": "Retrieves a node from the Kubernetes cluster by its name or UID, with its capacity, allocatable resources, conditions, taints and the pods scheduled on it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nodes"
+                ],
+                "summary": "Get a node by name or UID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the node",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Node details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.Node"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{id}/cordon": {
+            "post": {
+                "description": "Marks a node unschedulable, no new pod is scheduled on it and the pods running on it are left alone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nodes"
+                ],
+                "summary": "Cordon a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the node",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the cordon",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully cordoned the node",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{id}/drain": {
+            "post": {
+                "description": "Cordons a node and evicts its pods through the eviction API, which respects the PodDisruptionBudgets. The pods of DaemonSets and the static pods are left on the node.\nThe evictions refused by a PodDisruptionBudget are retried every 5 seconds until the timeout. The pods no controller recreates are lost once evicted, the drain refuses to start with 400 unless force is set.\nThe progress is streamed as newline delimited JSON, one model.DrainProgress per line, ending with a done step, or a failed step without pod when the drain failed or timed out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "nodes"
+                ],
+                "summary": "Drain a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the node",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Duration the drain is bounded by, like 90s or 10m, 5m when empty",
+                        "name": "timeout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Termination grace period of the evicted pods, the one of each pod when empty",
+                        "name": "gracePeriodSeconds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Evict the pods no controller recreates too",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the cordon and the evictions",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Progress of the drain, one per line",
+                        "schema": {
+                            "$ref": "#/definitions/model.DrainProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, invalid parameters or pods not managed by a controller",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodes/{id}/uncordon": {
+            "post": {
+                "description": "Marks a cordoned or drained node schedulable again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nodes"
+                ],
+                "summary": "Uncordon a node",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the node",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the uncordon",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully uncordoned the node",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods": {
+            "get": {
+                "description": "Retrieves a list of pods from the Kubernetes cluster. You can filter results by namespace or paginate the response using the limit and continue parameters. Each pod comes with a summary of its ready containers, restarts, age and status, like kubectl get pods.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "List pods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum number of pods to retrieve",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination token for fetching more pods",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter pods by",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort every pod from the most using cpu or memory, as reported by metrics-server, can't be paginated",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of pods",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sort, or sort with limit or continue",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "The metrics API is not available",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new pod in the Kubernetes cluster.\nThe config maps, secrets and keys referenced by the environment of its containers but missing from the namespace are returned as warnings.\nWith diff, nothing is created and the pod computed by the API server is returned as a diff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Create a new pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the creation",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the creation and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Pod request body",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PodsCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Diff of the dry run",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.Diff"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "201": {
+                        "description": "Successfully created the pod",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid data",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Without confirm, nothing is deleted and the pods matched by the label selector are returned with a confirmation token.\nWith the token as confirm, the pods are deleted one by one with an event recorded for each, as long as the same pods still match, 412 otherwise. With evict, the pods are evicted instead, a pod a PodDisruptionBudget protects fails with its error.\nThe pods failing to delete are reported with their error, the others are still deleted. When an event can't be recorded the deletion stops, the pods left are reported as failed with its error.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Delete the pods matched by a label selector",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pods, default when empty",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector of the pods to delete",
+                        "name": "labelSelector",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirmation token of the preview, the pods are only previewed when empty",
+                        "name": "confirm",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Evict the pods through the eviction API, which respects the PodDisruptionBudgets, instead of deleting them",
+                        "name": "evict",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How the dependents are deleted (Orphan, Background or Foreground)",
+                        "name": "propagationPolicy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the deletions",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The preview with its confirmation token, or the result of each deletion",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid label selector, or invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The pods matched changed since the preview",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{id}": {
+            "get": {
+                "description": "Retrieves a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.\nWith export, the native pod is returned as a manifest to apply again, without its status and the fields set by the cluster.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Get a pod by name or UID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter the pod by",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the pod as a manifest to apply again",
+                        "name": "export",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the export, yaml or json, yaml when empty",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Details of the requested pod",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the pod"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export parameters",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update specific fields of an existing pod in the Kubernetes cluster. The following fields are changeable:\n- containers.image\n- initContainers.image\n- tolerations (only additions)\n- activeDeadlineSeconds\n- terminationGracePeriodSeconds\nWith diff, nothing is changed and the differences between the live pod and the result are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Update an existing pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Pod update request body",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PodsUpdateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter the pod by",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the update",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the update and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pod, refused with 412 when the pod changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod successfully updated, or the diff of the dry run",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the pod"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The pod changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a pod from the Kubernetes cluster by its name or UID, optionally filtered by namespace.\nWith diff, nothing is deleted and the live pod is returned as a diff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Delete a pod by name or UID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace to filter the pod by",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "How the dependents are deleted (Orphan, Background or Foreground)",
+                        "name": "propagationPolicy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the deletion",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the deletion and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pod, refused with 412 when the pod changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message, or the diff of the dry run",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The pod changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Patches any field of a pod, with a JSON merge patch, a strategic merge patch or a server-side apply configuration chosen by the Content-Type.\nServer-side apply records the changed fields under fieldManager, kubernetes-api when empty, and force takes over the fields of other managers.\nWith diff, nothing is changed and the differences between the live pod and the result are returned.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/strategic-merge-patch+json",
+                    "application/apply-patch+yaml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Patch a pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the actor making the changes",
+                        "name": "fieldManager",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How unknown or duplicate fields are handled (Ignore, Warn or Strict)",
+                        "name": "fieldValidation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "true or All to only run the patch",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Dry run the patch and return the diff",
+                        "name": "diff",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Take over the fields managed by others, server-side apply only",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pod, refused with 412 when the pod changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "The patch",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The patched pod, or the diff of the dry run",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "resourceVersion of the pod"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or invalid patch",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting fields or update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "The pod changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch format",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{id}/events": {
+            "get": {
+                "description": "Retrieves the events reported by Kubernetes for a pod, newest first within each page. These are cluster events such as image pull failures, not the audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "List cluster events of a pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type to filter by (Normal or Warning)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of cluster events",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pods/{id}/metrics": {
+            "get": {
+                "description": "Retrieves the CPU and memory used by a pod and each of its containers, as reported by metrics-server, compared with their requests and limits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pods"
+                ],
+                "summary": "Get the resource usage of a pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster to run the request against, the default cluster when empty",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the pod",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name or UID of the pod",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resource usage of the pod",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PodMetrics"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not found, or no metrics reported yet",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "The metrics API is not available",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "The API server timed out",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the database and the API server of the default cluster, with the result of each check. Fails while the API shuts down.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "The API is ready to serve requests",
+                        "schema": {
+                            "$ref": "#/definitions/model.HealthReport"
+                        }
+                    },
+                    "503": {
+                        "description": "A check failed or the API is shutting down",
+                        "schema": {
+                            "$ref": "#/definitions/model.HealthReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Retrieves a filtered and paginated list of users from the database based on query parameters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List all users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Limit the number of users returned",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of users to skip for pagination",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter users by username",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter users by email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter users by role ID",
+                        "name": "role_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response containing the list of users",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "This endpoint creates a new user by providing username, email, password, and role ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "CreateUser creates a new user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "User creation input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "user username",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message including details on failure",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Fetches a user by their unique ID from the database.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Retrieve user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response containing the user information",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "This endpoint updates a user by providing username, email, password, and role ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "UpdateUser updates an existing user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "User update input",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "user username",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error message including details on failure",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflicting update",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Rejected by the validation",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "This endpoint deletes a user by providing user id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "DeleteUser deletes an existing user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cAdd access token here\u003e",
+                        "description": "Insert your access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "user username",
+                        "schema": {
+                            "$ref": "#/definitions/controller.SuccessResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Interval error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.FailureResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "basic,oauth2"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.FailureResponse": {
+            "type": "object",
+            "properties": {
+                "causes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/uc.ErrorCause"
+                    }
+                },
+                "code": {
+                    "$ref": "#/definitions/uc.ErrorCode"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "trace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Affinity": {
+            "type": "object",
+            "properties": {
+                "nodeAffinity": {
+                    "description": "Describes node affinity scheduling rules for the pod.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NodeAffinity"
+                        }
+                    ]
+                },
+                "podAffinity": {
+                    "description": "Describes pod affinity scheduling rules, like co-locating this pod in the same zone as some other pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.PodAffinity"
+                        }
+                    ]
+                },
+                "podAntiAffinity": {
+                    "description": "Describes pod anti-affinity scheduling rules, like avoiding this pod on the nodes of some other pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.PodAntiAffinity"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.ApplyResult": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.ApplyStatus"
+                }
+            }
+        },
+        "model.ApplyResultList": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ApplyResult"
+                    }
+                }
+            }
+        },
+        "model.ApplyStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "configured",
+                "unchanged",
+                "pruned",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ApplyStatusCreated",
+                "ApplyStatusConfigured",
+                "ApplyStatusUnchanged",
+                "ApplyStatusPruned",
+                "ApplyStatusFailed"
+            ]
+        },
+        "model.Capabilities": {
+            "type": "object",
+            "properties": {
+                "add": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "drop": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.ClusterEventWarning": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "lastTimestamp": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ClusterRequest": {
+            "type": "object",
+            "properties": {
+                "context": {
+                    "type": "string"
+                },
+                "kubeconfig": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ConfigMapEnvSource": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "description": "Optional lets the container start when the config map is missing.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ConfigMapKeySelector": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "description": "Optional lets the container start when the config map or its key is missing.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ConfigMapProjection": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.KeyToPath"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ConfigMapVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.KeyToPath"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.Container": {
+            "type": "object",
+            "required": [
+                "image",
+                "name"
+            ],
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EnvVar"
+                    }
+                },
+                "envFrom": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EnvFromSource"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imagePullPolicy": {
+                    "type": "string",
+                    "enum": [
+                        "Always",
+                        "Never",
+                        "IfNotPresent"
+                    ]
+                },
+                "livenessProbe": {
+                    "$ref": "#/definitions/model.Probe"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContainerPort"
+                    }
+                },
+                "readinessProbe": {
+                    "$ref": "#/definitions/model.Probe"
+                },
+                "resources": {
+                    "$ref": "#/definitions/model.ResourceRequirements"
+                },
+                "securityContext": {
+                    "$ref": "#/definitions/model.SecurityContext"
+                },
+                "startupProbe": {
+                    "$ref": "#/definitions/model.Probe"
+                },
+                "stdin": {
+                    "type": "boolean"
+                },
+                "stdinOnce": {
+                    "type": "boolean"
+                },
+                "terminationMessagePath": {
+                    "type": "string"
+                },
+                "tty": {
+                    "type": "boolean"
+                },
+                "volumeMounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.VolumeMount"
+                    }
+                },
+                "workingDir": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerMetrics": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
+                "memory": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerPort": {
+            "type": "object",
+            "properties": {
+                "containerPort": {
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "hostIP": {
+                    "type": "string"
+                },
+                "hostPort": {
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string"
+                },
+                "protocol": {
+                    "type": "string",
+                    "enum": [
+                        "TCP",
+                        "UDP",
+                        "SCTP"
+                    ]
+                }
+            }
+        },
+        "model.ContainerRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "cannot changable",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerState": {
+            "type": "object",
+            "properties": {
+                "running": {
+                    "$ref": "#/definitions/model.ContainerStateRunning"
+                },
+                "terminated": {
+                    "$ref": "#/definitions/model.ContainerStateTerminated"
+                },
+                "waiting": {
+                    "$ref": "#/definitions/model.ContainerStateWaiting"
+                }
+            }
+        },
+        "model.ContainerStateRunning": {
+            "type": "object",
+            "properties": {
+                "startedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerStateTerminated": {
+            "type": "object",
+            "properties": {
+                "containerID": {
+                    "type": "string"
+                },
+                "exitCode": {
+                    "type": "integer"
+                },
+                "finishedAt": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "signal": {
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerStateWaiting": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContainerStatus": {
+            "type": "object",
+            "properties": {
+                "containerID": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageID": {
+                    "type": "string"
+                },
+                "lastState": {
+                    "description": "LastTerminationState is the last termination of the container, like an OOMKilled reason\nfor a container restarted after running out of memory.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ContainerState"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "restartCount": {
+                    "type": "integer"
+                },
+                "started": {
+                    "type": "boolean"
+                },
+                "state": {
+                    "$ref": "#/definitions/model.ContainerState"
+                }
+            }
+        },
+        "model.CreateOptions": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "dryRun": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fieldManager": {
+                    "type": "string"
+                },
+                "fieldValidation": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Deployment": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "description": "Standard object's metadata.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ObjectMeta"
+                        }
+                    ]
+                },
+                "spec": {
+                    "description": "Specification of the desired behavior of the Deployment.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.DeploymentSpec"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Most recently observed status of the Deployment.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.DeploymentStatus"
+                        }
+                    ]
+                },
+                "warnings": {
+                    "description": "Warnings are the latest warning reasons reported by the cluster for the deployment.\nThey come from Kubernetes events, not from the audit log. On creation, they list the\nconfig maps, secrets and keys referenced by the deployment but missing from its namespace.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ClusterEventWarning"
+                    }
+                }
+            }
+        },
+        "model.DeploymentCondition": {
+            "type": "object",
+            "properties": {
+                "lastTransitionTime": {
+                    "description": "Last time the condition transitioned from one status to another.",
+                    "type": "string"
+                },
+                "lastUpdateTime": {
+                    "description": "The last time this condition was updated.",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "A human readable message indicating details about the transition.",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "The reason for the condition's last transition.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the condition, one of True, False, Unknown.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type of deployment condition.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.DeploymentConditionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.DeploymentConditionType": {
+            "type": "string",
+            "enum": [
+                "Available",
+                "Progressing",
+                "ReplicaFailure"
+            ],
+            "x-enum-varnames": [
+                "DeploymentAvailable",
+                "DeploymentProgressing",
+                "DeploymentReplicaFailure"
+            ]
+        },
+        "model.DeploymentCreateRequest": {
+            "type": "object",
+            "properties": {
+                "deployment": {
+                    "$ref": "#/definitions/model.Deployment"
+                },
+                "opts": {
+                    "$ref": "#/definitions/model.CreateOptions"
+                }
+            }
+        },
+        "model.DeploymentObjectMetaUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.DeploymentSpec": {
+            "type": "object",
+            "properties": {
+                "minReadySeconds": {
+                    "description": "Minimum number of seconds for which a newly created pod should be ready\nwithout any of its container crashing, for it to be considered available.\nDefaults to 0 (pod will be considered available as soon as it is ready)\n+optional",
+                    "type": "integer"
+                },
+                "paused": {
+                    "description": "Indicates that the deployment is paused.\n+optional",
+                    "type": "boolean"
+                },
+                "progressDeadlineSeconds": {
+                    "description": "The maximum time in seconds for a deployment to make progress before it\nis considered to be failed. The deployment controller will continue to\nprocess failed deployments and a condition with a ProgressDeadlineExceeded\nreason will be surfaced in the deployment status. Note that progress will\nnot be estimated during the time a deployment is paused. Defaults to 600s.",
+                    "type": "integer"
+                },
+                "replicas": {
+                    "description": "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
+                    "type": "integer"
+                },
+                "revisionHistoryLimit": {
+                    "description": "The number of old ReplicaSets to retain to allow rollback.\nThis is a pointer to distinguish between explicit zero and not specified.\nDefaults to 10.\n+optional",
+                    "type": "integer"
+                },
+                "selector": {
+                    "description": "Label selector for pods. Existing ReplicaSets whose pods are\nselected by this will be the ones affected by this deployment.\nIt must match the pod template's labels.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.LabelSelector"
+                        }
+                    ]
+                },
+                "strategy": {
+                    "description": "The deployment strategy to use to replace existing pods with new ones.\n+optional\ns",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.DeploymentStrategy"
+                        }
+                    ]
+                },
+                "template": {
+                    "description": "Template describes the pods that will be created.\nThe only allowed template.spec.restartPolicy value is \"Always\".",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.PodTemplateSpec"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.DeploymentSpecUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "minReadySeconds": {
+                    "type": "integer"
+                },
+                "progressDeadlineSeconds": {
+                    "type": "integer"
+                },
+                "replicas": {
+                    "type": "integer"
+                },
+                "strategy": {
+                    "$ref": "#/definitions/model.DeploymentStrategy"
+                },
+                "template": {
+                    "$ref": "#/definitions/model.PodTemplateSpec"
+                }
+            }
+        },
+        "model.DeploymentStatus": {
+            "type": "object",
+            "properties": {
+                "availableReplicas": {
+                    "description": "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment.\n+optional",
+                    "type": "integer"
+                },
+                "collisionCount": {
+                    "description": "Count of hash collisions for the Deployment. The Deployment controller uses this\nfield as a collision avoidance mechanism when it needs to create the name for the\nnewest ReplicaSet.\n+optional",
+                    "type": "integer"
+                },
+                "conditions": {
+                    "description": "Represents the latest available observations of a deployment's current state.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DeploymentCondition"
+                    }
+                },
+                "observedGeneration": {
+                    "description": "The generation observed by the deployment controller.\n+optional",
+                    "type": "integer"
+                },
+                "readyReplicas": {
+                    "description": "readyReplicas is the number of pods targeted by this Deployment with a Ready Condition.\n+optional",
+                    "type": "integer"
+                },
+                "replicas": {
+                    "description": "Total number of non-terminated pods targeted by this deployment (their labels match the selector).\n+optional",
+                    "type": "integer"
+                },
+                "unavailableReplicas": {
+                    "description": "Total number of unavailable pods targeted by this deployment. This is the total number of\npods that are still required for the deployment to have 100% available capacity. They may\neither be pods that are running but not yet available or pods that still have not been created.\n+optional",
+                    "type": "integer"
+                },
+                "updatedReplicas": {
+                    "description": "Total number of non-terminated pods targeted by this deployment that have the desired template spec.\n+optional",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.DeploymentStrategy": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "description": "Rolling update config params. Present only if DeploymentStrategyType =\nRollingUpdate.\n---\nTODO: Update this to follow our convention for oneOf, whatever we decide it\nto be.\n+optional\nRollingUpdate *RollingUpdateDeployment ` + "`" + `json:\"rollingUpdate,omitempty\"` + "`" + `\nType of deployment. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.DeploymentStrategyType"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.DeploymentStrategyType": {
+            "type": "string",
+            "enum": [
+                "Recreate",
+                "RollingUpdate"
+            ],
+            "x-enum-varnames": [
+                "RecreateDeploymentStrategyType",
+                "RollingUpdateDeploymentStrategyType"
+            ]
+        },
+        "model.DeploymentUpdate": {
+            "type": "object",
+            "properties": {
+                "metadata": {
+                    "$ref": "#/definitions/model.DeploymentObjectMetaUpdateRequest"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.DeploymentSpecUpdateRequest"
+                }
+            }
+        },
+        "model.DeploymentUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "deployment": {
+                    "$ref": "#/definitions/model.DeploymentUpdate"
+                },
+                "opts": {
+                    "$ref": "#/definitions/model.UpdateOptions"
+                }
+            }
+        },
+        "model.Diff": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FieldChange"
+                    }
+                },
+                "live": {},
+                "result": {},
+                "unified": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DiffOperation": {
+            "type": "string",
+            "enum": [
+                "add",
+                "remove",
+                "replace"
+            ],
+            "x-enum-varnames": [
+                "DiffOperationAdd",
+                "DiffOperationRemove",
+                "DiffOperationReplace"
+            ]
+        },
+        "model.DownwardAPIProjection": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DownwardAPIVolumeFile"
+                    }
+                }
+            }
+        },
+        "model.DownwardAPIVolumeFile": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "fieldRef": {
+                    "$ref": "#/definitions/model.ObjectFieldSelector"
+                },
+                "mode": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "resourceFieldRef": {
+                    "$ref": "#/definitions/model.ResourceFieldSelector"
+                }
+            }
+        },
+        "model.DownwardAPIVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DownwardAPIVolumeFile"
+                    }
+                }
+            }
+        },
+        "model.DrainProgress": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pod": {
+                    "type": "string"
+                },
+                "step": {
+                    "$ref": "#/definitions/model.DrainStep"
+                },
+                "time": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DrainStep": {
+            "type": "string",
+            "enum": [
+                "cordoned",
+                "skipped",
+                "evicting",
+                "blocked",
+                "evicted",
+                "failed",
+                "done"
+            ],
+            "x-enum-varnames": [
+                "DrainStepCordoned",
+                "DrainStepSkipped",
+                "DrainStepEvicting",
+                "DrainStepBlocked",
+                "DrainStepEvicted",
+                "DrainStepFailed",
+                "DrainStepDone"
+            ]
+        },
+        "model.EmptyDirVolumeSource": {
+            "type": "object",
+            "properties": {
+                "medium": {
+                    "type": "string",
+                    "enum": [
+                        "Memory"
+                    ]
+                },
+                "sizeLimit": {
+                    "description": "Total amount of local storage required, like 1Gi.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.EnvFromSource": {
+            "type": "object",
+            "properties": {
+                "configMapRef": {
+                    "$ref": "#/definitions/model.ConfigMapEnvSource"
+                },
+                "prefix": {
+                    "description": "Prefix prepended to every key, which must then be valid environment variable names.",
+                    "type": "string"
+                },
+                "secretRef": {
+                    "$ref": "#/definitions/model.SecretEnvSource"
+                }
+            }
+        },
+        "model.EnvVar": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "valueFrom": {
+                    "description": "ValueFrom sources the value from a config map, a secret, a field of the pod or a resource of a container.\nIt cannot be used along Value.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.EnvVarSource"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.EnvVarSource": {
+            "type": "object",
+            "properties": {
+                "configMapKeyRef": {
+                    "description": "Selects a key of a config map in the namespace of the pod.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ConfigMapKeySelector"
+                        }
+                    ]
+                },
+                "fieldRef": {
+                    "description": "Selects a field of the pod, like metadata.name, metadata.namespace or status.podIP.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ObjectFieldSelector"
+                        }
+                    ]
+                },
+                "resourceFieldRef": {
+                    "description": "Selects a resource of the container, like limits.cpu or requests.memory.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ResourceFieldSelector"
+                        }
+                    ]
+                },
+                "secretKeyRef": {
+                    "description": "Selects a key of a secret in the namespace of the pod.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.SecretKeySelector"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.ExecAction": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.FieldChange": {
+            "type": "object",
+            "properties": {
+                "from": {},
+                "op": {
+                    "$ref": "#/definitions/model.DiffOperation"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "to": {}
+            }
+        },
+        "model.FinalizerName": {
+            "type": "string",
+            "enum": [
+                "kubernetes"
+            ],
+            "x-enum-varnames": [
+                "FinalizerKubernetes"
+            ]
+        },
+        "model.GRPCAction": {
+            "type": "object",
+            "properties": {
+                "port": {
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "service": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HTTPGetAction": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "httpHeaders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.HTTPHeader"
+                    }
+                },
+                "path": {
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Name or number of the port to access on the container.",
+                    "type": "string"
+                },
+                "scheme": {
+                    "type": "string",
+                    "enum": [
+                        "HTTP",
+                        "HTTPS"
+                    ]
+                }
+            }
+        },
+        "model.HTTPHeader": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HealthCheck": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "healthy": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HealthReport": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.HealthCheck"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.HostPathVolumeSource": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "path": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KeyToPath": {
+            "type": "object",
+            "required": [
+                "key",
+                "path"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "Mode bits of the file, like 0644, defaulting to the mode of the volume.",
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LabelSelector": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.\n+optional\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelSelectorRequirement"
+                    }
+                },
+                "matchLabels": {
+                    "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels\nmap is equivalent to an element of matchExpressions, whose key field is \"key\", the\noperator is \"In\", and the values array contains only \"value\". The requirements are ANDed.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.LabelSelectorOperator": {
+            "type": "string",
+            "enum": [
+                "In",
+                "NotIn",
+                "Exists",
+                "DoesNotExist"
+            ],
+            "x-enum-varnames": [
+                "LabelSelectorOpIn",
+                "LabelSelectorOpNotIn",
+                "LabelSelectorOpExists",
+                "LabelSelectorOpDoesNotExist"
+            ]
+        },
+        "model.LabelSelectorRequirement": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "description": "key is the label key that the selector applies to.",
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "operator represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists and DoesNotExist.",
+                    "enum": [
+                        "In",
+                        "NotIn",
+                        "Exists",
+                        "DoesNotExist"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.LabelSelectorOperator"
+                        }
+                    ]
+                },
+                "values": {
+                    "description": "values is an array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. This array is replaced during a strategic\nmerge patch.\n+optional\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.LocalObjectReference": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Login": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Namespace": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.ObjectMeta"
+                },
+                "spec": {
+                    "description": "Spec defines the behavior of the Namespace.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NamespaceSpec"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status describes the current status of a Namespace.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NamespaceStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.NamespaceCondition": {
+            "type": "object",
+            "properties": {
+                "lastTransitionTime": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the condition, one of True, False, Unknown.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type of namespace controller condition.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NamespaceConditionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.NamespaceConditionType": {
+            "type": "string",
+            "enum": [
+                "NamespaceDeletionDiscoveryFailure",
+                "NamespaceDeletionContentFailure",
+                "NamespaceDeletionGroupVersionParsingFailure",
+                "NamespaceContentRemaining",
+                "NamespaceFinalizersRemaining"
+            ],
+            "x-enum-varnames": [
+                "NamespaceDeletionDiscoveryFailure",
+                "NamespaceDeletionContentFailure",
+                "NamespaceDeletionGVParsingFailure",
+                "NamespaceContentRemaining",
+                "NamespaceFinalizersRemaining"
+            ]
+        },
+        "model.NamespaceCreateRequest": {
+            "type": "object",
+            "properties": {
+                "namespace": {
+                    "$ref": "#/definitions/model.Namespace"
+                },
+                "opts": {
+                    "$ref": "#/definitions/model.CreateOptions"
+                }
+            }
+        },
+        "model.NamespaceMetrics": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
+                "memory": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PodMetrics"
+                    }
+                }
+            }
+        },
+        "model.NamespaceObjectMetaUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.NamespacePhase": {
+            "type": "string",
+            "enum": [
+                "Active",
+                "Terminating"
+            ],
+            "x-enum-varnames": [
+                "NamespaceActive",
+                "NamespaceTerminating"
+            ]
+        },
+        "model.NamespaceSpec": {
+            "type": "object",
+            "properties": {
+                "finalizers": {
+                    "description": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FinalizerName"
+                    }
+                }
+            }
+        },
+        "model.NamespaceStatus": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "description": "Represents the latest available observations of a namespace's current state.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NamespaceCondition"
+                    }
+                },
+                "phase": {
+                    "description": "Phase is the current lifecycle phase of the namespace.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NamespacePhase"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.NamespaceUpdate": {
+            "type": "object",
+            "properties": {
+                "metadata": {
+                    "$ref": "#/definitions/model.NamespaceObjectMetaUpdateRequest"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.NamespaceSpec"
+                }
+            }
+        },
+        "model.NamespaceUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "namespace": {
+                    "$ref": "#/definitions/model.NamespaceUpdate"
+                },
+                "opts": {
+                    "$ref": "#/definitions/model.UpdateOptions"
+                }
+            }
+        },
+        "model.Node": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.ObjectMeta"
+                },
+                "pods": {
+                    "description": "Pods are the pods scheduled on the node, in every namespace.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NodePod"
+                    }
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.NodeSpec"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.NodeStatus"
+                }
+            }
+        },
+        "model.NodeAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NodeAffinity": {
+            "type": "object",
+            "properties": {
+                "preferredDuringSchedulingIgnoredDuringExecution": {
+                    "description": "The scheduler prefers the nodes meeting these requirements, by the sum of their weights.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PreferredSchedulingTerm"
                     }
+                },
+                "requiredDuringSchedulingIgnoredDuringExecution": {
+                    "description": "The pod is not scheduled onto a node not meeting these requirements.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.NodeSelector"
+                        }
+                    ]
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.AuthResponse": {
+        },
+        "model.NodeCondition": {
             "type": "object",
             "properties": {
+                "lastHeartbeatTime": {
+                    "type": "string"
+                },
+                "lastTransitionTime": {
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 },
-                "token": {
+                "reason": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string",
-                    "example": "basic,oauth2"
+                "status": {
+                    "type": "string"
                 },
-                "username": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "controller.FailureResponse": {
+        "model.NodePod": {
             "type": "object",
             "properties": {
-                "error": {
+                "name": {
                     "type": "string"
                 },
-                "message": {
+                "namespace": {
                     "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "summary": {
+                    "$ref": "#/definitions/model.PodSummary"
                 }
             }
         },
-        "controller.SuccessResponse": {
+        "model.NodeSelector": {
             "type": "object",
             "properties": {
-                "data": {},
-                "message": {
-                    "type": "string"
+                "nodeSelectorTerms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NodeSelectorTerm"
+                    }
                 }
             }
         },
-        "model.Container": {
+        "model.NodeSelectorRequirement": {
             "type": "object",
+            "required": [
+                "key"
+            ],
             "properties": {
-                "args": {
+                "key": {
+                    "type": "string"
+                },
+                "operator": {
+                    "type": "string",
+                    "enum": [
+                        "In",
+                        "NotIn",
+                        "Exists",
+                        "DoesNotExist",
+                        "Gt",
+                        "Lt"
+                    ]
+                },
+                "values": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
-                },
-                "command": {
+                }
+            }
+        },
+        "model.NodeSelectorTerm": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.NodeSelectorRequirement"
                     }
                 },
-                "env": {
+                "matchFields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.EnvVar"
+                        "$ref": "#/definitions/model.NodeSelectorRequirement"
                     }
-                },
-                "image": {
+                }
+            }
+        },
+        "model.NodeSpec": {
+            "type": "object",
+            "properties": {
+                "podCIDR": {
                     "type": "string"
                 },
-                "name": {
+                "providerID": {
                     "type": "string"
                 },
-                "ports": {
+                "taints": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.ContainerPort"
+                        "$ref": "#/definitions/model.Taint"
                     }
                 },
-                "stdin": {
+                "unschedulable": {
+                    "description": "Unschedulable is set on cordoned nodes, no new pod is scheduled on them.",
                     "type": "boolean"
+                }
+            }
+        },
+        "model.NodeStatus": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NodeAddress"
+                    }
                 },
-                "stdinOnce": {
-                    "type": "boolean"
+                "allocatable": {
+                    "$ref": "#/definitions/model.ResourceList"
                 },
-                "terminationMessagePath": {
-                    "type": "string"
+                "capacity": {
+                    "description": "Capacity is the total of the resources of the node, Allocatable what is left of it for the pods.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ResourceList"
+                        }
+                    ]
                 },
-                "tty": {
-                    "type": "boolean"
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NodeCondition"
+                    }
                 },
-                "workingDir": {
-                    "type": "string"
+                "nodeInfo": {
+                    "$ref": "#/definitions/model.NodeSystemInfo"
                 }
             }
         },
-        "model.ContainerPort": {
+        "model.NodeSystemInfo": {
             "type": "object",
             "properties": {
-                "containerPort": {
-                    "type": "integer"
+                "architecture": {
+                    "type": "string"
                 },
-                "hostIP": {
+                "containerRuntimeVersion": {
                     "type": "string"
                 },
-                "hostPort": {
-                    "type": "integer"
+                "kernelVersion": {
+                    "type": "string"
                 },
-                "name": {
+                "kubeletVersion": {
                     "type": "string"
                 },
-                "protocol": {
+                "operatingSystem": {
+                    "type": "string"
+                },
+                "osImage": {
                     "type": "string"
                 }
             }
         },
-        "model.ContainerRequest": {
+        "model.ObjectFieldSelector": {
             "type": "object",
+            "required": [
+                "fieldPath"
+            ],
             "properties": {
-                "image": {
+                "apiVersion": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "cannot changable",
+                "fieldPath": {
                     "type": "string"
                 }
             }
         },
-        "model.CreateOptions": {
+        "model.ObjectMeta": {
             "type": "object",
             "properties": {
-                "apiVersion": {
-                    "type": "string"
-                },
-                "dryRun": {
-                    "type": "array",
-                    "items": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "fieldManager": {
+                "creationTimestamp": {
                     "type": "string"
                 },
-                "fieldValidation": {
-                    "type": "string"
+                "deletionGracePeriodSeconds": {
+                    "type": "integer"
                 },
-                "kind": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.Deployment": {
-            "type": "object",
-            "properties": {
-                "apiVersion": {
+                "deletionTimestamp": {
                     "type": "string"
                 },
-                "kind": {
+                "finalizers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generateName": {
                     "type": "string"
                 },
-                "metadata": {
-                    "description": "Standard object's metadata.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.ObjectMeta"
-                        }
-                    ]
+                "generation": {
+                    "type": "integer"
                 },
-                "spec": {
-                    "description": "Specification of the desired behavior of the Deployment.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.DeploymentSpec"
-                        }
-                    ]
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "status": {
-                    "description": "Most recently observed status of the Deployment.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.DeploymentStatus"
-                        }
-                    ]
-                }
-            }
-        },
-        "model.DeploymentCondition": {
-            "type": "object",
-            "properties": {
-                "lastTransitionTime": {
-                    "description": "Last time the condition transitioned from one status to another.",
+                "name": {
                     "type": "string"
                 },
-                "lastUpdateTime": {
-                    "description": "The last time this condition was updated.",
+                "namespace": {
                     "type": "string"
                 },
-                "message": {
-                    "description": "A human readable message indicating details about the transition.",
-                    "type": "string"
+                "ownerReferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OwnerReference"
+                    }
                 },
-                "reason": {
-                    "description": "The reason for the condition's last transition.",
+                "resourceVersion": {
                     "type": "string"
                 },
-                "status": {
-                    "description": "Status of the condition, one of True, False, Unknown.",
+                "uid": {
                     "type": "string"
-                },
-                "type": {
-                    "description": "Type of deployment condition.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.DeploymentConditionType"
-                        }
-                    ]
                 }
             }
         },
-        "model.DeploymentConditionType": {
-            "type": "string",
-            "enum": [
-                "Available",
-                "Progressing",
-                "ReplicaFailure"
-            ],
-            "x-enum-varnames": [
-                "DeploymentAvailable",
-                "DeploymentProgressing",
-                "DeploymentReplicaFailure"
-            ]
-        },
-        "model.DeploymentCreateRequest": {
+        "model.OwnerReference": {
             "type": "object",
             "properties": {
-                "deployment": {
-                    "$ref": "#/definitions/model.Deployment"
+                "apiVersion": {
+                    "type": "string"
+                },
+                "blockOwnerDeletion": {
+                    "type": "boolean"
+                },
+                "controller": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
                 },
-                "opts": {
-                    "$ref": "#/definitions/model.CreateOptions"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "model.DeploymentObjectMetaUpdateRequest": {
+        "model.PersistentVolumeClaimVolumeSource": {
             "type": "object",
+            "required": [
+                "claimName"
+            ],
             "properties": {
-                "annotations": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "claimName": {
+                    "type": "string"
                 },
-                "labels": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "readOnly": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.DeploymentSpec": {
+        "model.Pod": {
             "type": "object",
             "properties": {
-                "minReadySeconds": {
-                    "description": "Minimum number of seconds for which a newly created pod should be ready\nwithout any of its container crashing, for it to be considered available.\nDefaults to 0 (pod will be considered available as soon as it is ready)\n+optional",
-                    "type": "integer"
-                },
-                "paused": {
-                    "description": "Indicates that the deployment is paused.\n+optional",
-                    "type": "boolean"
-                },
-                "progressDeadlineSeconds": {
-                    "description": "The maximum time in seconds for a deployment to make progress before it\nis considered to be failed. The deployment controller will continue to\nprocess failed deployments and a condition with a ProgressDeadlineExceeded\nreason will be surfaced in the deployment status. Note that progress will\nnot be estimated during the time a deployment is paused. Defaults to 600s.",
-                    "type": "integer"
+                "apiVersion": {
+                    "type": "string"
                 },
-                "replicas": {
-                    "description": "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
-                    "type": "integer"
+                "kind": {
+                    "type": "string"
                 },
-                "revisionHistoryLimit": {
-                    "description": "The number of old ReplicaSets to retain to allow rollback.\nThis is a pointer to distinguish between explicit zero and not specified.\nDefaults to 10.\n+optional",
-                    "type": "integer"
+                "metadata": {
+                    "$ref": "#/definitions/model.ObjectMeta"
                 },
-                "selector": {
-                    "description": "Label selector for pods. Existing ReplicaSets whose pods are\nselected by this will be the ones affected by this deployment.\nIt must match the pod template's labels.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.LabelSelector"
-                        }
-                    ]
+                "spec": {
+                    "$ref": "#/definitions/model.PodSpec"
                 },
-                "strategy": {
-                    "description": "The deployment strategy to use to replace existing pods with new ones.\n+optional\ns",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.DeploymentStrategy"
-                        }
-                    ]
+                "status": {
+                    "$ref": "#/definitions/model.PodStatus"
                 },
-                "template": {
-                    "description": "Template describes the pods that will be created.\nThe only allowed template.spec.restartPolicy value is \"Always\".",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.PodTemplateSpec"
-                        }
-                    ]
+                "warnings": {
+                    "description": "Warnings are the latest warning reasons reported by the cluster for the pod.\nThey come from Kubernetes events, not from the audit log. On creation, they list the\nconfig maps, secrets and keys referenced by the pod but missing from its namespace.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ClusterEventWarning"
+                    }
                 }
             }
         },
-        "model.DeploymentSpecUpdateRequest": {
+        "model.PodAffinity": {
             "type": "object",
             "properties": {
-                "minReadySeconds": {
-                    "type": "integer"
-                },
-                "progressDeadlineSeconds": {
-                    "type": "integer"
-                },
-                "replicas": {
-                    "type": "integer"
-                },
-                "strategy": {
-                    "$ref": "#/definitions/model.DeploymentStrategy"
+                "preferredDuringSchedulingIgnoredDuringExecution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WeightedPodAffinityTerm"
+                    }
                 },
-                "template": {
-                    "$ref": "#/definitions/model.PodTemplateSpec"
+                "requiredDuringSchedulingIgnoredDuringExecution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PodAffinityTerm"
+                    }
                 }
             }
         },
-        "model.DeploymentStatus": {
+        "model.PodAffinityTerm": {
             "type": "object",
+            "required": [
+                "topologyKey"
+            ],
             "properties": {
-                "availableReplicas": {
-                    "description": "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment.\n+optional",
-                    "type": "integer"
+                "labelSelector": {
+                    "$ref": "#/definitions/model.LabelSelector"
                 },
-                "collisionCount": {
-                    "description": "Count of hash collisions for the Deployment. The Deployment controller uses this\nfield as a collision avoidance mechanism when it needs to create the name for the\nnewest ReplicaSet.\n+optional",
-                    "type": "integer"
+                "namespaceSelector": {
+                    "$ref": "#/definitions/model.LabelSelector"
                 },
-                "conditions": {
-                    "description": "Represents the latest available observations of a deployment's current state.",
+                "namespaces": {
+                    "description": "The namespaces of the selected pods, the namespace of this pod when empty.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.DeploymentCondition"
+                        "type": "string"
                     }
                 },
-                "observedGeneration": {
-                    "description": "The generation observed by the deployment controller.\n+optional",
-                    "type": "integer"
-                },
-                "readyReplicas": {
-                    "description": "readyReplicas is the number of pods targeted by this Deployment with a Ready Condition.\n+optional",
-                    "type": "integer"
-                },
-                "replicas": {
-                    "description": "Total number of non-terminated pods targeted by this deployment (their labels match the selector).\n+optional",
-                    "type": "integer"
-                },
-                "unavailableReplicas": {
-                    "description": "Total number of unavailable pods targeted by this deployment. This is the total number of\npods that are still required for the deployment to have 100% available capacity. They may\neither be pods that are running but not yet available or pods that still have not been created.\n+optional",
-                    "type": "integer"
-                },
-                "updatedReplicas": {
-                    "description": "Total number of non-terminated pods targeted by this deployment that have the desired template spec.\n+optional",
-                    "type": "integer"
+                "topologyKey": {
+                    "description": "The label of the nodes defining the topology domain, like topology.kubernetes.io/zone.",
+                    "type": "string"
                 }
             }
         },
-        "model.DeploymentStrategy": {
+        "model.PodAntiAffinity": {
             "type": "object",
             "properties": {
-                "type": {
-                    "description": "Rolling update config params. Present only if DeploymentStrategyType =\nRollingUpdate.\n---\nTODO: Update this to follow our convention for oneOf, whatever we decide it\nto be.\n+optional\nRollingUpdate *RollingUpdateDeployment ` + "`" + `json:\"rollingUpdate,omitempty\"` + "`" + `\nType of deployment. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.DeploymentStrategyType"
-                        }
-                    ]
+                "preferredDuringSchedulingIgnoredDuringExecution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WeightedPodAffinityTerm"
+                    }
+                },
+                "requiredDuringSchedulingIgnoredDuringExecution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PodAffinityTerm"
+                    }
                 }
             }
         },
-        "model.DeploymentStrategyType": {
-            "type": "string",
-            "enum": [
-                "Recreate",
-                "RollingUpdate"
-            ],
-            "x-enum-varnames": [
-                "RecreateDeploymentStrategyType",
-                "RollingUpdateDeploymentStrategyType"
-            ]
-        },
-        "model.DeploymentUpdate": {
+        "model.PodCondition": {
             "type": "object",
             "properties": {
-                "metadata": {
-                    "$ref": "#/definitions/model.DeploymentObjectMetaUpdateRequest"
+                "lastProbeTime": {
+                    "type": "string"
                 },
-                "spec": {
-                    "$ref": "#/definitions/model.DeploymentSpecUpdateRequest"
+                "lastTransitionTime": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "model.DeploymentUpdateRequest": {
+        "model.PodIP": {
             "type": "object",
             "properties": {
-                "deployment": {
-                    "$ref": "#/definitions/model.DeploymentUpdate"
-                },
-                "opts": {
-                    "$ref": "#/definitions/model.UpdateOptions"
+                "ip": {
+                    "type": "string"
                 }
             }
         },
-        "model.EnvVar": {
+        "model.PodMetrics": {
             "type": "object",
             "properties": {
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContainerMetrics"
+                    }
+                },
+                "cpu": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
+                "memory": {
+                    "$ref": "#/definitions/model.ResourceUsage"
+                },
                 "name": {
                     "type": "string"
                 },
-                "value": {
+                "namespace": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "Timestamp is the end of the Window the usage was averaged over.",
+                    "type": "string"
+                },
+                "window": {
                     "type": "string"
                 }
             }
         },
-        "model.FinalizerName": {
-            "type": "string",
-            "enum": [
-                "kubernetes"
-            ],
-            "x-enum-varnames": [
-                "FinalizerKubernetes"
-            ]
-        },
-        "model.LabelSelector": {
+        "model.PodSecurityContext": {
             "type": "object",
             "properties": {
-                "matchExpressions": {
-                    "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.\n+optional\n+listType=atomic",
+                "fsGroup": {
+                    "description": "A group owning the volumes of the pod, added to the groups of every container.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "runAsGroup": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "seccompProfile": {
+                    "$ref": "#/definitions/model.SeccompProfile"
+                },
+                "supplementalGroups": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.LabelSelectorRequirement"
+                        "type": "integer"
                     }
                 },
-                "matchLabels": {
-                    "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels\nmap is equivalent to an element of matchExpressions, whose key field is \"key\", the\noperator is \"In\", and the values array contains only \"value\". The requirements are ANDed.\n+optional",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                "sysctls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Sysctl"
                     }
                 }
             }
         },
-        "model.LabelSelectorOperator": {
-            "type": "string",
-            "enum": [
-                "In",
-                "NotIn",
-                "Exists",
-                "DoesNotExist"
-            ],
-            "x-enum-varnames": [
-                "LabelSelectorOpIn",
-                "LabelSelectorOpNotIn",
-                "LabelSelectorOpExists",
-                "LabelSelectorOpDoesNotExist"
-            ]
-        },
-        "model.LabelSelectorRequirement": {
+        "model.PodSpec": {
             "type": "object",
             "properties": {
-                "key": {
-                    "description": "key is the label key that the selector applies to.",
+                "activeDeadlineSeconds": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "affinity": {
+                    "$ref": "#/definitions/model.Affinity"
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Container"
+                    }
+                },
+                "imagePullSecrets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LocalObjectReference"
+                    }
+                },
+                "initContainers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Container"
+                    }
+                },
+                "nodeName": {
                     "type": "string"
                 },
-                "operator": {
-                    "description": "operator represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists and DoesNotExist.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.LabelSelectorOperator"
-                        }
+                "nodeSelector": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "restartPolicy": {
+                    "type": "string",
+                    "enum": [
+                        "Always",
+                        "OnFailure",
+                        "Never"
                     ]
                 },
-                "values": {
-                    "description": "values is an array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. This array is replaced during a strategic\nmerge patch.\n+optional\n+listType=atomic",
+                "securityContext": {
+                    "$ref": "#/definitions/model.PodSecurityContext"
+                },
+                "serviceAccountName": {
+                    "type": "string"
+                },
+                "terminationGracePeriodSeconds": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "tolerations": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.Toleration"
+                    }
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Volume"
                     }
                 }
             }
         },
-        "model.Login": {
+        "model.PodStatus": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PodCondition"
+                    }
+                },
+                "containerStatuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContainerStatus"
+                    }
+                },
+                "hostIP": {
                     "type": "string"
                 },
-                "username": {
+                "initContainerStatuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContainerStatus"
+                    }
+                },
+                "message": {
                     "type": "string"
-                }
-            }
-        },
-        "model.Namespace": {
-            "type": "object",
-            "properties": {
-                "apiVersion": {
+                },
+                "nominatedNodeName": {
                     "type": "string"
                 },
-                "kind": {
+                "phase": {
                     "type": "string"
                 },
-                "metadata": {
-                    "$ref": "#/definitions/model.ObjectMeta"
+                "podIP": {
+                    "type": "string"
                 },
-                "spec": {
-                    "description": "Spec defines the behavior of the Namespace.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.NamespaceSpec"
-                        }
-                    ]
+                "podIPs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PodIP"
+                    }
                 },
-                "status": {
-                    "description": "Status describes the current status of a Namespace.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.NamespaceStatus"
-                        }
-                    ]
+                "qosClass": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "StartTime is when the pod was acknowledged by the kubelet, before its images were pulled.",
+                    "type": "string"
                 }
             }
         },
-        "model.NamespaceCondition": {
+        "model.PodSummary": {
             "type": "object",
             "properties": {
-                "lastTransitionTime": {
-                    "description": "+optional",
+                "age": {
+                    "description": "Age is the time since the pod was created, like 5m or 3d4h.",
                     "type": "string"
                 },
-                "message": {
-                    "description": "+optional",
+                "ready": {
+                    "description": "Ready is the number of ready containers over the number of containers, like 1/2.",
                     "type": "string"
                 },
-                "reason": {
-                    "description": "+optional",
-                    "type": "string"
+                "restarts": {
+                    "description": "Restarts is the number of restarts of the containers of the pod.",
+                    "type": "integer"
                 },
                 "status": {
-                    "description": "Status of the condition, one of True, False, Unknown.",
+                    "description": "Status is the phase of the pod, or the reason a container is not running, like CrashLoopBackOff.",
                     "type": "string"
-                },
-                "type": {
-                    "description": "Type of namespace controller condition.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.NamespaceConditionType"
-                        }
-                    ]
                 }
             }
         },
-        "model.NamespaceConditionType": {
-            "type": "string",
-            "enum": [
-                "NamespaceDeletionDiscoveryFailure",
-                "NamespaceDeletionContentFailure",
-                "NamespaceDeletionGroupVersionParsingFailure",
-                "NamespaceContentRemaining",
-                "NamespaceFinalizersRemaining"
-            ],
-            "x-enum-varnames": [
-                "NamespaceDeletionDiscoveryFailure",
-                "NamespaceDeletionContentFailure",
-                "NamespaceDeletionGVParsingFailure",
-                "NamespaceContentRemaining",
-                "NamespaceFinalizersRemaining"
-            ]
-        },
-        "model.NamespaceCreateRequest": {
+        "model.PodTemplateSpec": {
             "type": "object",
             "properties": {
-                "namespace": {
-                    "$ref": "#/definitions/model.Namespace"
+                "metadata": {
+                    "$ref": "#/definitions/model.ObjectMeta"
                 },
-                "opts": {
-                    "$ref": "#/definitions/model.CreateOptions"
+                "spec": {
+                    "$ref": "#/definitions/model.PodSpec"
                 }
             }
         },
-        "model.NamespaceObjectMetaUpdateRequest": {
+        "model.PodUpdate": {
             "type": "object",
             "properties": {
-                "annotations": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "labels": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "spec": {
+                    "$ref": "#/definitions/model.SpecRequest"
                 }
             }
         },
-        "model.NamespacePhase": {
-            "type": "string",
-            "enum": [
-                "Active",
-                "Terminating"
-            ],
-            "x-enum-varnames": [
-                "NamespaceActive",
-                "NamespaceTerminating"
-            ]
-        },
-        "model.NamespaceSpec": {
+        "model.PodsCreateRequest": {
             "type": "object",
             "properties": {
-                "finalizers": {
-                    "description": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.\n+optional",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.FinalizerName"
-                    }
+                "opts": {
+                    "$ref": "#/definitions/model.CreateOptions"
+                },
+                "pod": {
+                    "$ref": "#/definitions/model.Pod"
                 }
             }
         },
-        "model.NamespaceStatus": {
+        "model.PodsUpdateRequest": {
             "type": "object",
             "properties": {
-                "conditions": {
-                    "description": "Represents the latest available observations of a namespace's current state.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.NamespaceCondition"
-                    }
+                "opts": {
+                    "$ref": "#/definitions/model.UpdateOptions"
                 },
-                "phase": {
-                    "description": "Phase is the current lifecycle phase of the namespace.\n+optional",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.NamespacePhase"
-                        }
-                    ]
+                "pod": {
+                    "$ref": "#/definitions/model.PodUpdate"
                 }
             }
         },
-        "model.NamespaceUpdate": {
+        "model.Preconditions": {
             "type": "object",
             "properties": {
-                "metadata": {
-                    "$ref": "#/definitions/model.NamespaceObjectMetaUpdateRequest"
+                "resourceVersion": {
+                    "description": "Specifies the target ResourceVersion\n+optional",
+                    "type": "string"
                 },
-                "spec": {
-                    "$ref": "#/definitions/model.NamespaceSpec"
+                "uid": {
+                    "description": "Specifies the target UID.\n+optional",
+                    "type": "string"
                 }
             }
         },
-        "model.NamespaceUpdateRequest": {
+        "model.PreferredSchedulingTerm": {
             "type": "object",
             "properties": {
-                "namespace": {
-                    "$ref": "#/definitions/model.NamespaceUpdate"
+                "preference": {
+                    "$ref": "#/definitions/model.NodeSelectorTerm"
                 },
-                "opts": {
-                    "$ref": "#/definitions/model.UpdateOptions"
+                "weight": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
                 }
             }
         },
-        "model.ObjectMeta": {
+        "model.Probe": {
             "type": "object",
             "properties": {
-                "annotations": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "exec": {
+                    "$ref": "#/definitions/model.ExecAction"
                 },
-                "creationTimestamp": {
-                    "type": "string"
+                "failureThreshold": {
+                    "description": "Minimum consecutive failures for the probe to be considered failed after having succeeded.",
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "deletionGracePeriodSeconds": {
-                    "type": "integer"
+                "grpc": {
+                    "$ref": "#/definitions/model.GRPCAction"
                 },
-                "deletionTimestamp": {
-                    "type": "string"
+                "httpGet": {
+                    "$ref": "#/definitions/model.HTTPGetAction"
                 },
-                "finalizers": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "initialDelaySeconds": {
+                    "description": "Number of seconds after the container has started before the probe is initiated.",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "generateName": {
-                    "type": "string"
+                "periodSeconds": {
+                    "description": "How often (in seconds) to perform the probe. Default to 10 seconds.",
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "generation": {
-                    "type": "integer"
+                "successThreshold": {
+                    "description": "Minimum consecutive successes for the probe to be considered successful after having failed.",
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "labels": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "tcpSocket": {
+                    "$ref": "#/definitions/model.TCPSocketAction"
                 },
-                "name": {
-                    "type": "string"
+                "terminationGracePeriodSeconds": {
+                    "description": "Duration in seconds the pod needs to terminate gracefully upon probe failure.",
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "namespace": {
-                    "type": "string"
+                "timeoutSeconds": {
+                    "description": "Number of seconds after which the probe times out. Defaults to 1 second.",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "model.ProjectedVolumeSource": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "type": "integer"
                 },
-                "ownerReferences": {
+                "sources": {
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.OwnerReference"
-                    }
-                },
-                "resourceVersion": {
-                    "type": "string"
-                },
-                "uid": {
-                    "type": "string"
+                    "items": {
+                        "$ref": "#/definitions/model.VolumeProjection"
+                    }
                 }
             }
         },
-        "model.OwnerReference": {
+        "model.ResourceFieldSelector": {
             "type": "object",
+            "required": [
+                "resource"
+            ],
             "properties": {
-                "apiVersion": {
+                "containerName": {
                     "type": "string"
                 },
-                "blockOwnerDeletion": {
-                    "type": "boolean"
-                },
-                "controller": {
-                    "type": "boolean"
-                },
-                "kind": {
+                "divisor": {
+                    "description": "Output format of the exposed resources, like 1Mi, defaults to 1.",
                     "type": "string"
                 },
-                "name": {
+                "resource": {
                     "type": "string"
                 }
             }
         },
-        "model.Pod": {
+        "model.ResourceList": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "string"
+            }
+        },
+        "model.ResourceRequirements": {
             "type": "object",
             "properties": {
-                "apiVersion": {
-                    "type": "string"
-                },
-                "kind": {
-                    "type": "string"
-                },
-                "metadata": {
-                    "$ref": "#/definitions/model.ObjectMeta"
-                },
-                "spec": {
-                    "$ref": "#/definitions/model.PodSpec"
+                "limits": {
+                    "description": "Limits describes the maximum amount of compute resources allowed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ResourceList"
+                        }
+                    ]
                 },
-                "status": {
-                    "$ref": "#/definitions/model.PodStatus"
+                "requests": {
+                    "description": "Requests describes the minimum amount of compute resources required.\nIt defaults to the limits when omitted.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ResourceList"
+                        }
+                    ]
                 }
             }
         },
-        "model.PodCondition": {
+        "model.ResourceUsage": {
             "type": "object",
             "properties": {
-                "lastProbeTime": {
-                    "type": "string"
-                },
-                "lastTransitionTime": {
+                "limit": {
                     "type": "string"
                 },
-                "message": {
-                    "type": "string"
+                "limitPercent": {
+                    "type": "integer"
                 },
-                "reason": {
+                "request": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "requestPercent": {
+                    "description": "RequestPercent is the usage in percent of the request, above 100 when the request is exceeded.",
+                    "type": "integer"
                 },
-                "type": {
+                "usage": {
                     "type": "string"
                 }
             }
         },
-        "model.PodSpec": {
+        "model.SeccompProfile": {
             "type": "object",
             "properties": {
-                "activeDeadlineSeconds": {
-                    "type": "integer"
-                },
-                "containers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Container"
-                    }
-                },
-                "initContainers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Container"
-                    }
-                },
-                "terminationGracePeriodSeconds": {
-                    "type": "integer"
-                },
-                "tolerations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Toleration"
-                    }
+                "localhostProfile": {
+                    "description": "The profile defined in a file on the node, only with the Localhost type.",
+                    "type": "string"
                 },
-                "volumes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Volume"
-                    }
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "Unconfined",
+                        "RuntimeDefault",
+                        "Localhost"
+                    ]
                 }
             }
         },
-        "model.PodStatus": {
+        "model.SecretEnvSource": {
             "type": "object",
             "properties": {
-                "conditions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.PodCondition"
-                    }
-                },
-                "hostIP": {
-                    "type": "string"
-                },
-                "message": {
+                "name": {
                     "type": "string"
                 },
-                "nominatedNodeName": {
+                "optional": {
+                    "description": "Optional lets the container start when the secret is missing.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.SecretKeySelector": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
                     "type": "string"
                 },
-                "phase": {
+                "name": {
                     "type": "string"
                 },
-                "reason": {
-                    "type": "string"
+                "optional": {
+                    "description": "Optional lets the container start when the secret or its key is missing.",
+                    "type": "boolean"
                 }
             }
         },
-        "model.PodTemplateSpec": {
+        "model.SecretProjection": {
             "type": "object",
             "properties": {
-                "metadata": {
-                    "$ref": "#/definitions/model.ObjectMeta"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.KeyToPath"
+                    }
                 },
-                "spec": {
-                    "$ref": "#/definitions/model.PodSpec"
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
                 }
             }
         },
-        "model.PodUpdate": {
+        "model.SecretVolumeSource": {
             "type": "object",
             "properties": {
-                "spec": {
-                    "$ref": "#/definitions/model.SpecRequest"
+                "defaultMode": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.KeyToPath"
+                    }
+                },
+                "optional": {
+                    "type": "boolean"
+                },
+                "secretName": {
+                    "type": "string"
                 }
             }
         },
-        "model.PodsCreateRequest": {
+        "model.SecurityContext": {
             "type": "object",
             "properties": {
-                "opts": {
-                    "$ref": "#/definitions/model.CreateOptions"
+                "allowPrivilegeEscalation": {
+                    "type": "boolean"
                 },
-                "pod": {
-                    "$ref": "#/definitions/model.Pod"
+                "capabilities": {
+                    "$ref": "#/definitions/model.Capabilities"
+                },
+                "privileged": {
+                    "type": "boolean"
+                },
+                "readOnlyRootFilesystem": {
+                    "type": "boolean"
+                },
+                "runAsGroup": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "seccompProfile": {
+                    "$ref": "#/definitions/model.SeccompProfile"
                 }
             }
         },
-        "model.PodsUpdateRequest": {
+        "model.ServiceAccountTokenProjection": {
             "type": "object",
+            "required": [
+                "path"
+            ],
             "properties": {
-                "opts": {
-                    "$ref": "#/definitions/model.UpdateOptions"
+                "audience": {
+                    "type": "string"
                 },
-                "pod": {
-                    "$ref": "#/definitions/model.PodUpdate"
+                "expirationSeconds": {
+                    "type": "integer",
+                    "minimum": 600
+                },
+                "path": {
+                    "type": "string"
                 }
             }
         },
@@ -2257,7 +6352,8 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "activeDeadlineSeconds": {
-                    "type": "integer"
+                    "type": "integer",
+                    "minimum": 1
                 },
                 "containers": {
                     "type": "array",
@@ -2272,7 +6368,8 @@ const docTemplate = `{
                     }
                 },
                 "terminationGracePeriodSeconds": {
-                    "type": "integer"
+                    "type": "integer",
+                    "minimum": 0
                 },
                 "tolerations": {
                     "description": "allow it to be set to 1 if it was previously negative",
@@ -2283,6 +6380,46 @@ const docTemplate = `{
                 }
             }
         },
+        "model.Sysctl": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TCPSocketAction": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Name or number of the port to access on the container.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.Taint": {
+            "type": "object",
+            "properties": {
+                "effect": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "timeAdded": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Toleration": {
             "type": "object",
             "properties": {
@@ -2323,6 +6460,18 @@ const docTemplate = `{
                 },
                 "kind": {
                     "type": "string"
+                },
+                "preconditions": {
+                    "description": "Must be fulfilled before the update is carried out, set from the If-Match header.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Preconditions"
+                        }
+                    ]
+                },
+                "retryOnConflict": {
+                    "description": "Retry the update on the latest version of the object when it changed meanwhile.\nIgnored with preconditions, the caller asked for a given version then.\n+optional",
+                    "type": "boolean"
                 }
             }
         },
@@ -2351,11 +6500,127 @@ const docTemplate = `{
         },
         "model.Volume": {
             "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "configMap": {
+                    "$ref": "#/definitions/model.ConfigMapVolumeSource"
+                },
+                "downwardAPI": {
+                    "$ref": "#/definitions/model.DownwardAPIVolumeSource"
+                },
+                "emptyDir": {
+                    "$ref": "#/definitions/model.EmptyDirVolumeSource"
+                },
+                "hostPath": {
+                    "$ref": "#/definitions/model.HostPathVolumeSource"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "persistentVolumeClaim": {
+                    "$ref": "#/definitions/model.PersistentVolumeClaimVolumeSource"
+                },
+                "projected": {
+                    "$ref": "#/definitions/model.ProjectedVolumeSource"
+                },
+                "secret": {
+                    "$ref": "#/definitions/model.SecretVolumeSource"
+                }
+            }
+        },
+        "model.VolumeMount": {
+            "type": "object",
+            "required": [
+                "mountPath",
+                "name"
+            ],
             "properties": {
+                "mountPath": {
+                    "description": "Path within the container at which the volume should be mounted.",
+                    "type": "string"
+                },
                 "name": {
+                    "description": "Name must match the name of a volume of the pod.",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "subPath": {
+                    "description": "Path within the volume from which the container's volume should be mounted.\nDefaults to \"\" (volume's root).",
+                    "type": "string"
+                }
+            }
+        },
+        "model.VolumeProjection": {
+            "type": "object",
+            "properties": {
+                "configMap": {
+                    "$ref": "#/definitions/model.ConfigMapProjection"
+                },
+                "downwardAPI": {
+                    "$ref": "#/definitions/model.DownwardAPIProjection"
+                },
+                "secret": {
+                    "$ref": "#/definitions/model.SecretProjection"
+                },
+                "serviceAccountToken": {
+                    "$ref": "#/definitions/model.ServiceAccountTokenProjection"
+                }
+            }
+        },
+        "model.WeightedPodAffinityTerm": {
+            "type": "object",
+            "properties": {
+                "podAffinityTerm": {
+                    "$ref": "#/definitions/model.PodAffinityTerm"
+                },
+                "weight": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                }
+            }
+        },
+        "uc.ErrorCause": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
                     "type": "string"
                 }
             }
+        },
+        "uc.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "bad_request",
+                "not_found",
+                "already_exists",
+                "conflict",
+                "precondition_failed",
+                "forbidden",
+                "invalid",
+                "timeout",
+                "unavailable",
+                "internal"
+            ],
+            "x-enum-varnames": [
+                "CodeBadRequest",
+                "CodeNotFound",
+                "CodeAlreadyExists",
+                "CodeConflict",
+                "CodePreconditionFailed",
+                "CodeForbidden",
+                "CodeInvalid",
+                "CodeTimeout",
+                "CodeUnavailable",
+                "CodeInternal"
+            ]
         }
     }
 }`
@@ -1,40 +1,82 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/config"
 	"github.com/fleimkeipa/kubernetes-api/controller"
 	_ "github.com/fleimkeipa/kubernetes-api/docs" // which is the generated folder after swag init
+	"github.com/fleimkeipa/kubernetes-api/model"
 	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
 	"github.com/fleimkeipa/kubernetes-api/uc"
 	"github.com/fleimkeipa/kubernetes-api/util"
 
 	"github.com/go-pg/pg"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/spf13/viper"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"k8s.io/client-go/kubernetes"
 )
 
 func main() {
-	// Load environment configuration
-	if err := config.LoadEnv(); err != nil {
+	configPath := flag.String("config", os.Getenv(config.EnvPrefix+"_CONFIG"), "path of the YAML configuration file, ./config.yaml by default")
+	flag.Parse()
+
+	// Run the migrate subcommand instead of the API when asked to, it only needs the database settings
+	if flag.Arg(0) == "migrate" {
+		if _, err := config.LoadDatabase(*configPath); err != nil {
+			log.Fatalf("Error loading configuration: %v", err)
+		}
+
+		if err := runMigrate(flag.Args()[1:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// Load the configuration from the file and the environment
+	cfg, err := config.Load(*configPath)
+	if err != nil {
 		log.Fatalf("Error loading configuration: %v", err)
 	}
 
+	// Apply the reloadable settings when the configuration file changes
+	config.Watch()
+
 	// Start the application
-	serveApplication()
+	serveApplication(cfg)
 }
 
-func serveApplication() {
+func serveApplication(cfg *config.Config) {
+	// Cancelled on SIGINT or SIGTERM to shut down gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Create a new Echo instance
 	e := echo.New()
 
+	// The requests are cancelled when shutting down only, the streamed ones end with their error
+	requestsCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+	e.Server.BaseContext = func(net.Listener) context.Context {
+		return requestsCtx
+	}
+
 	// Configure Echo settings
 	configureEcho(e)
 
@@ -42,56 +84,121 @@ func serveApplication() {
 	configureCORS(e)
 
 	// Configure the logger
-	sugar := configureLogger(e)
+	sugar := configureLogger(e, cfg)
 	defer sugar.Sync() // Clean up logger at the end
 
-	// Initialize Kubernetes client
-	kubClient := initKubernetes()
+	// Limit the requests of each client
+	e.Use(util.RateLimiter())
+
+	// Export the traces to the collector of the configuration
+	shutdownTracing, err := pkg.InitTracing(ctx, cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer func() {
+		// flush the pending spans, without hanging on an unreachable collector
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdownTracing(ctx)
+	}()
+
+	// Initialize PostgreSQL client, nil when the data is kept in memory
+	dbClient := initDB(cfg)
+	if dbClient != nil {
+		defer dbClient.Close()
+	}
+
+	// Create the event and user stores
+	eventRepo, userRepo := initStores(dbClient, cfg)
 
-	// Initialize PostgreSQL client
-	dbClient := initDB()
-	defer dbClient.Close()
+	// Initialize the registry resolving the kubernetes client of each request
+	clusterRepo := initClusters(dbClient, cfg)
 
 	// Create Event handlers and related components
-	eventRepo := repositories.NewEventRepository(dbClient)
 	eventUC := uc.NewEventUC(eventRepo)
 	eventHandler := controller.NewEventHandler(eventUC)
 
+	// Start archiving events past the retention period
+	startEventRetention(ctx, eventRepo, cfg)
+
+	// Create Cluster handlers and related components
+	clusterUC := uc.NewClusterUC(clusterRepo, eventUC, cfg.Clusters.HealthTimeout)
+	clusterHandlers := controller.NewClusterHandler(clusterUC)
+
+	// Create cluster event repository shared by the kubernetes resources
+	clusterEventRepo := repositories.NewClusterEventRepository(clusterRepo)
+
+	// Create reference repository checking the config maps and secrets used by the pods
+	referenceRepo := repositories.NewReferenceRepository(clusterRepo)
+
+	// Create Export components shared by the kubernetes resources
+	manifestRepo := repositories.NewManifestRepository(clusterRepo)
+	exportUC := uc.NewExportUC(manifestRepo)
+
+	// Create Pod and Namespace repositories, shared with the metrics
+	podRepo := repositories.NewPodRepository(clusterRepo)
+	namespaceRepo := repositories.NewNamespaceRepository(clusterRepo)
+
+	// Create Metrics components reading the resource usage from metrics-server
+	metricsRepo := repositories.NewMetricsRepository(clusterRepo)
+	metricsUC := uc.NewMetricsUC(metricsRepo, podRepo, namespaceRepo)
+
 	// Create Pod handlers and related components
-	podRepo := repositories.NewPodRepository(kubClient)
-	podUC := uc.NewPodUC(podRepo, eventUC)
-	podHandlers := controller.NewPodHandler(podUC)
+	podUC := uc.NewPodUC(podRepo, clusterEventRepo, referenceRepo, eventUC)
+	podHandlers := controller.NewPodHandler(podUC, exportUC, metricsUC)
 
 	// Create Namespace handlers and related components
-	namespaceRepo := repositories.NewNamespaceRepository(kubClient)
-	namespaceUC := uc.NewNamespaceUC(namespaceRepo, eventUC)
-	namespaceHandlers := controller.NewNamespaceHandler(namespaceUC)
+	namespaceUC := uc.NewNamespaceUC(namespaceRepo, clusterEventRepo, eventUC)
+	namespaceHandlers := controller.NewNamespaceHandler(namespaceUC, exportUC, metricsUC)
 
 	// Create Deployment handlers and related components
-	deploymentRepo := repositories.NewDeploymentInterfaces(kubClient)
-	deploymentUC := uc.NewDeploymentUC(deploymentRepo, eventUC)
-	deploymentHandlers := controller.NewDeploymentHandler(deploymentUC)
+	deploymentRepo := repositories.NewDeploymentInterfaces(clusterRepo)
+	deploymentUC := uc.NewDeploymentUC(deploymentRepo, clusterEventRepo, referenceRepo, eventUC)
+	deploymentHandlers := controller.NewDeploymentHandler(deploymentUC, exportUC)
+
+	// Create Node handlers and related components, draining the nodes through the pods
+	nodeRepo := repositories.NewNodeRepository(clusterRepo)
+	nodeUC := uc.NewNodeUC(nodeRepo, podRepo, eventUC)
+	nodeHandlers := controller.NewNodeHandler(nodeUC)
+
+	// Create Apply handlers applying manifests of any kind
+	applyUC := uc.NewApplyUC(manifestRepo, eventUC)
+	applyHandlers := controller.NewApplyHandler(applyUC)
 
 	// Create user handlers and related components
-	userRepo := repositories.NewUserRepository(dbClient)
 	userUC := uc.NewUserUC(userRepo, eventUC)
 	userHandlers := controller.NewUserHandlers(userUC)
 
 	// Create Auth handlers and related components
 	authHandlers := controller.NewAuthHandlers(userUC)
 
-	// Define authentication routes and handlers
+	// Create Health handlers checking the dependencies the API needs
+	healthChecks := []interfaces.HealthCheckInterfaces{repositories.NewKubeAPIHealthRepository(clusterRepo)}
+	if dbClient != nil {
+		healthChecks = append(healthChecks, repositories.NewPSQLHealthRepository(dbClient))
+	}
+	healthUC := uc.NewHealthUC(cfg.APIService.ReadinessTimeout, healthChecks...)
+	healthHandlers := controller.NewHealthHandler(healthUC)
+
+	// Define health routes, left unauthenticated for the probes
+	e.GET("/healthz", healthHandlers.Healthz)
+	e.GET("/readyz", healthHandlers.Readyz)
+
+	// Expose the metrics, on their own server when metrics.port is set
+	metricsServer := startMetrics(e, cfg)
+
+	// Define authentication routes and handlers, counting the login attempts
 	authRoutes := e.Group("/auth")
-	authRoutes.POST("/login", authHandlers.Login)
+	authRoutes.POST("/login", authHandlers.Login, pkg.LoginMetrics("basic"))
 
 	oauthRoutes := authRoutes.Group("")
 	googleAuthHandler := controller.NewGoogleAuthHandler(userUC)
 	oauthRoutes.GET("/google_login", googleAuthHandler.GoogleLogin)
-	oauthRoutes.GET("/google_callback", googleAuthHandler.GoogleCallback)
+	oauthRoutes.GET("/google_callback", googleAuthHandler.GoogleCallback, pkg.LoginMetrics("google"))
 
 	githubAuthHandler := controller.NewGithubAuthHandler(userUC)
 	oauthRoutes.GET("/github_login", githubAuthHandler.GithubLogin)
-	oauthRoutes.GET("/github_callback", githubAuthHandler.GithubCallback)
+	oauthRoutes.GET("/github_callback", githubAuthHandler.GithubCallback, pkg.LoginMetrics("github"))
 
 	// Add JWT authentication and authorization middleware
 	restrictedRoutes := e.Group("")
@@ -106,37 +213,145 @@ func serveApplication() {
 	usersRoutes.PUT("/:id", userHandlers.UpdateUser)
 	usersRoutes.DELETE("/:id", userHandlers.DeleteUser)
 
+	// Define cluster routes
+	clustersRoutes := restrictedRoutes.Group("/clusters")
+	clustersRoutes.GET("", clusterHandlers.List)
+	clustersRoutes.POST("", clusterHandlers.Create)
+	clustersRoutes.DELETE("/:cluster", clusterHandlers.Delete)
+
+	// Define kubernetes resource routes, on the cluster selected by the query parameter
+	// or by the path when prefixed with /clusters/:cluster
+	resourceRoutes := restrictedRoutes.Group("", util.ClusterSelector)
+	registerResourceRoutes(resourceRoutes, podHandlers, namespaceHandlers, deploymentHandlers, nodeHandlers, applyHandlers)
+
+	clusterResourceRoutes := clustersRoutes.Group("/:cluster", util.ClusterSelector)
+	registerResourceRoutes(clusterResourceRoutes, podHandlers, namespaceHandlers, deploymentHandlers, nodeHandlers, applyHandlers)
+
+	// Define event routes
+	eventsRoutes := restrictedRoutes.Group("/events")
+	eventsRoutes.GET("", eventHandler.List)
+	eventsRoutes.GET("/verify", eventHandler.Verify)
+	eventsRoutes.GET("/:id", eventHandler.GetByID)
+
+	// Start the Echo application
+	go func() {
+		if err := e.Start(fmt.Sprintf(":%d", cfg.APIService.Port)); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+
+	shutdown(e, metricsServer, healthUC, cancelRequests, cfg.APIService)
+}
+
+// Serves the metrics on a server of their own returned to be shut down, or on the API to the
+// administrators only when metrics.port is 0
+func startMetrics(e *echo.Echo, cfg *config.Config) *http.Server {
+	if !cfg.Metrics.Enabled {
+		return nil
+	}
+
+	if cfg.Metrics.Port == 0 {
+		e.GET(cfg.Metrics.Path, echo.WrapHandler(pkg.MetricsHandler()), util.JWTAuth)
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(cfg.Metrics.Path, pkg.MetricsHandler())
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Metrics.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start metrics server: %v", err)
+		}
+	}()
+
+	return server
+}
+
+// Reports the API as not ready for the shutdown delay, then stops accepting connections and waits for
+// the in-flight requests up to the shutdown timeout. Requests still running are cancelled shortly before
+// it, so the streamed ones like the node drains end with their error, and then cut off.
+func shutdown(e *echo.Echo, metricsServer *http.Server, healthUC *uc.HealthUC, cancelRequests context.CancelFunc, cfg config.APIServiceConfig) {
+	log.Printf("Shutting down, reporting not ready for %s then draining requests for up to %s", cfg.ShutdownDelay, cfg.ShutdownTimeout)
+	healthUC.Drain()
+
+	// the load balancers keep routing to the API until the readiness probe fails
+	time.Sleep(cfg.ShutdownDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	cancelTimer := time.AfterFunc(cfg.ShutdownTimeout*9/10, cancelRequests)
+	defer cancelTimer.Stop()
+
+	if metricsServer != nil {
+		defer metricsServer.Shutdown(ctx)
+	}
+
+	if err := e.Shutdown(ctx); err != nil {
+		log.Printf("Failed to drain requests, closing the remaining connections: %v", err)
+		e.Close()
+		return
+	}
+
+	log.Println("Server stopped")
+}
+
+// Registers the pod, namespace, deployment and apply routes on the group
+func registerResourceRoutes(g *echo.Group, podHandlers *controller.PodHandler, namespaceHandlers *controller.NamespaceHandler, deploymentHandlers *controller.DeploymentHandler, nodeHandlers *controller.NodeHandler, applyHandlers *controller.ApplyHandler) {
 	// Define pod routes
-	podsRoutes := restrictedRoutes.Group("/pods")
+	podsRoutes := g.Group("/pods")
 	podsRoutes.GET("", podHandlers.List)
 	podsRoutes.GET("/:id", podHandlers.GetByNameOrUID)
+	podsRoutes.GET("/:id/events", podHandlers.ListEvents)
+	podsRoutes.GET("/:id/metrics", podHandlers.Metrics)
 	podsRoutes.POST("", podHandlers.Create)
 	podsRoutes.PUT("/:id", podHandlers.Update)
+	podsRoutes.PATCH("/:id", podHandlers.Patch)
+	podsRoutes.DELETE("", podHandlers.BulkDelete)
 	podsRoutes.DELETE("/:id", podHandlers.Delete)
 
 	// Define namespace routes
-	namespacesRoutes := restrictedRoutes.Group("/namespaces")
+	namespacesRoutes := g.Group("/namespaces")
 	namespacesRoutes.GET("", namespaceHandlers.List)
 	namespacesRoutes.GET("/:id", namespaceHandlers.GetByNameOrUID)
+	namespacesRoutes.GET("/:id/cluster-events", namespaceHandlers.ListClusterEvents)
+	namespacesRoutes.GET("/:id/export", namespaceHandlers.Export)
+	namespacesRoutes.GET("/:id/metrics", namespaceHandlers.Metrics)
 	namespacesRoutes.POST("", namespaceHandlers.Create)
 	namespacesRoutes.PUT("/:id", namespaceHandlers.Update)
+	namespacesRoutes.PATCH("/:id", namespaceHandlers.Patch)
 	namespacesRoutes.DELETE("/:name", namespaceHandlers.Delete)
 
 	// Define deployment routes
-	deploymentsRoutes := restrictedRoutes.Group("/deployments")
+	deploymentsRoutes := g.Group("/deployments")
 	deploymentsRoutes.GET("", deploymentHandlers.List)
 	deploymentsRoutes.GET("/:id", deploymentHandlers.GetByNameOrUID)
+	deploymentsRoutes.GET("/:id/events", deploymentHandlers.ListEvents)
 	deploymentsRoutes.POST("", deploymentHandlers.Create)
 	deploymentsRoutes.PUT("/:id", deploymentHandlers.Update)
+	deploymentsRoutes.PATCH("/:id", deploymentHandlers.Patch)
+	deploymentsRoutes.DELETE("", deploymentHandlers.BulkDelete)
 	deploymentsRoutes.DELETE("/:id", deploymentHandlers.Delete)
 
-	// Define event routes
-	eventsRoutes := restrictedRoutes.Group("/events")
-	eventsRoutes.GET("", eventHandler.List)
-	eventsRoutes.GET("/:id", eventHandler.GetByID)
+	// Define node routes
+	nodesRoutes := g.Group("/nodes")
+	nodesRoutes.GET("", nodeHandlers.List)
+	nodesRoutes.GET("/:id", nodeHandlers.GetByNameOrUID)
+	nodesRoutes.POST("/:id/cordon", nodeHandlers.Cordon)
+	nodesRoutes.POST("/:id/uncordon", nodeHandlers.Uncordon)
+	nodesRoutes.POST("/:id/drain", nodeHandlers.Drain)
 
-	// Start the Echo application
-	e.Logger.Fatal(e.Start(fmt.Sprintf(":%d", viper.GetInt("api_service.port"))))
+	// Define the route applying manifests
+	g.POST("/apply", applyHandlers.Apply)
 }
 
 // Configures the Echo instance
@@ -147,55 +362,148 @@ func configureEcho(e *echo.Echo) {
 	// Add Swagger documentation route
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
+	// Add the trace ID to the failure responses
+	e.JSONSerializer = controller.JSONSerializer{}
+
+	// Answer the errors of the handlers with the status of their use case error
+	e.HTTPErrorHandler = controller.HTTPErrorHandler
+
+	// Validate the request bodies, with the Kubernetes rules of the resources
+	e.Validator = controller.NewValidator()
+
+	// Add the request ID, honoring the X-Request-ID of the caller
+	e.Use(pkg.RequestID())
+
+	// Add tracing and metrics middlewares, ahead of Recover to count the recovered panics too
+	e.Use(pkg.TracingMiddleware)
+	e.Use(pkg.HTTPMetrics)
+
 	// Add Recover middleware
 	e.Use(middleware.Recover())
 }
 
-// Configures CORS settings
+// Configures CORS settings, the allowed origins follow configuration reloads
 func configureCORS(e *echo.Echo) {
 	corsConfig := middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{viper.GetString("ui_service.allow_origin")},
-		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowOriginFunc: func(origin string) (bool, error) {
+			return slices.Contains(config.Get().UIService.Origins(), origin), nil
+		},
+		AllowMethods:  []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, echo.HeaderXRequestID, "If-Match"},
+		ExposeHeaders: []string{echo.HeaderXRequestID, "ETag"},
 	})
 
 	e.Use(corsConfig)
 }
 
-// Configures the logger and adds it as middleware
-func configureLogger(e *echo.Echo) *zap.SugaredLogger {
-	logger, err := zap.NewProduction()
+// Configures the logger and adds it as middleware, the level follows configuration reloads
+func configureLogger(e *echo.Echo, cfg *config.Config) *zap.SugaredLogger {
+	level := zap.NewAtomicLevel()
+	setLogLevel(level, cfg)
+	config.OnReload(func(cfg *config.Config) {
+		setLogLevel(level, cfg)
+	})
+
+	logger, err := pkg.NewZapLogger(cfg.Log, level)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	e.Use(pkg.ZapLogger(logger))
 
-	sugar := logger.Sugar()
-	loggerHandler := controller.NewLogger(sugar)
-	e.Use(loggerHandler.LoggerMiddleware)
+	return logger.Sugar()
+}
+
+// Sets the level of the logger, validated with the configuration
+func setLogLevel(level zap.AtomicLevel, cfg *config.Config) {
+	if l, err := zapcore.ParseLevel(cfg.Log.Level); err == nil {
+		level.SetLevel(l)
+	}
+}
+
+// Starts the background job archiving events older than the retention period, until ctx is done
+func startEventRetention(ctx context.Context, eventRepo interfaces.EventInterfaces, cfg *config.Config) {
+	retention := cfg.Events.Retention
+	archiveRepo := repositories.NewEventArchiveRepository(retention.ArchiveDir)
 
-	return sugar
+	retentionUC := uc.NewEventRetentionUC(eventRepo, archiveRepo, model.EventRetentionOpts{
+		HotDays:   retention.HotDays,
+		BatchSize: retention.BatchSize,
+		Interval:  retention.Interval,
+	})
+
+	go retentionUC.Run(ctx)
 }
 
-// Initializes the Kubernetes client
-func initKubernetes() *kubernetes.Clientset {
+// Initializes the cluster registry with the clusters defined in the configuration.
+// The cluster the API runs against is registered under clusters.default.
+func initClusters(db *pg.DB, cfg *config.Config) *repositories.ClusterRepository {
+	defaultName := cfg.Clusters.Default
+
 	client, err := pkg.NewKubernetesClient()
 	if err != nil {
 		log.Fatalf("Failed to initialize Kubernetes client: %v", err)
 	}
 
-	log.Println("Kubernetes client initialized successfully")
-	return client
+	configured := map[string]kubernetes.Interface{
+		defaultName: client,
+	}
+
+	for _, v := range cfg.Clusters.Items {
+		client, err := pkg.NewKubernetesClientFromFile(v.Kubeconfig, v.Context)
+		if err != nil {
+			log.Fatalf("Failed to initialize Kubernetes client for cluster %s: %v", v.Name, err)
+		}
+
+		configured[v.Name] = client
+	}
+
+	log.Printf("Kubernetes clients initialized successfully for %d clusters", len(configured))
+	return repositories.NewClusterRepository(db, configured, defaultName, cfg.Clusters.EncryptionKey)
 }
 
-// Initializes the PostgreSQL client
-func initDB() *pg.DB {
-	db := pkg.NewPSQLClient()
-	if db == nil {
-		log.Fatal("Failed to initialize PostgreSQL client")
+// Initializes the PostgreSQL client, returns nil when database.driver is memory
+func initDB(cfg *config.Config) *pg.DB {
+	if cfg.Database.Driver == "memory" {
+		log.Println("Using the in-memory store, data will be lost on restart")
+		return nil
+	}
+
+	db, err := pkg.NewPSQLClient(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to initialize PostgreSQL client: %v", err)
 	}
 
 	log.Println("PostgreSQL client initialized successfully")
 	return db
 }
+
+// Creates the event and user stores on the database, or in memory when there is none
+func initStores(db *pg.DB, cfg *config.Config) (interfaces.EventInterfaces, interfaces.UserInterfaces) {
+	if db != nil {
+		return repositories.NewEventRepository(db), repositories.NewUserRepository(db)
+	}
+
+	userRepo := repositories.NewMemoryUserRepository()
+
+	// the in-memory store starts empty, seed the admin to be able to log in
+	if admin := cfg.Database.Memory.Admin; admin.Username != "" {
+		password, err := model.HashPassword(admin.Password)
+		if err != nil {
+			log.Fatalf("Failed to hash admin password: %v", err)
+		}
+
+		_, err = userRepo.Create(context.Background(), model.User{
+			CreatedAt: time.Now(),
+			Username:  admin.Username,
+			Email:     admin.Email,
+			Password:  password,
+			RoleID:    model.AdminRole,
+		})
+		if err != nil {
+			log.Fatalf("Failed to seed admin user: %v", err)
+		}
+	}
+
+	return repositories.NewMemoryEventRepository(), userRepo
+}
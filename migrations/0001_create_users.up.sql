@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS users (
+    id bigserial PRIMARY KEY,
+    username text,
+    email text,
+    password text,
+    role_id bigint,
+    created_at timestamptz,
+    deleted_at timestamptz
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS events (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    deleted_at timestamptz,
+    type text,
+    category text,
+    hash text,
+    prev_hash text,
+    owner jsonb
+);
+
+-- events tables created before the hash chain lack these columns
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS id bigserial,
+    ADD COLUMN IF NOT EXISTS hash text,
+    ADD COLUMN IF NOT EXISTS prev_hash text;
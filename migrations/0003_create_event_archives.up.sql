@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS event_archives (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    "from" timestamptz,
+    "to" timestamptz,
+    file_name text,
+    last_hash text,
+    first_event_id bigint,
+    last_event_id bigint,
+    count bigint
+);
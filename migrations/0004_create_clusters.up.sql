@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS clusters (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    deleted_at timestamptz,
+    name text NOT NULL,
+    kubeconfig text NOT NULL,
+    context text
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS clusters_name_key ON clusters (name) WHERE deleted_at IS NULL;
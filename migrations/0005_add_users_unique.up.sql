@@ -0,0 +1,4 @@
+-- 0001 created the users table without the unique constraints of the former model; tables created
+-- before the migrations already have them under these names.
+CREATE UNIQUE INDEX IF NOT EXISTS users_username_key ON users (username);
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key ON users (email);
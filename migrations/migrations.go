@@ -0,0 +1,12 @@
+// Package migrations embeds the numbered SQL migrations of the database schema.
+//
+// Each migration is a pair of files named <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Versions are applied in increasing order and
+// recorded in the schema_migrations table. Migrations must not be edited once
+// released, add a new one instead.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,49 @@
+package model
+
+// ApplyStatus tells what applying a manifest did to an object.
+type ApplyStatus string
+
+const (
+	ApplyStatusCreated    ApplyStatus = "created"
+	ApplyStatusConfigured ApplyStatus = "configured"
+	ApplyStatusUnchanged  ApplyStatus = "unchanged"
+	ApplyStatusPruned     ApplyStatus = "pruned"
+	ApplyStatusFailed     ApplyStatus = "failed"
+)
+
+// ApplyOptions may be provided when applying manifests.
+// Objects without a namespace go to Namespace, and the objects matching the Prune label selector
+// but missing from the manifests are deleted.
+type ApplyOptions struct {
+	Namespace    string   `json:"namespace,omitempty"`
+	FieldManager string   `json:"fieldManager,omitempty"`
+	Prune        string   `json:"prune,omitempty"`
+	DryRun       []string `json:"dryRun,omitempty"`
+	Force        bool     `json:"force,omitempty"`
+}
+
+// ApplyResult is the outcome of applying, or pruning, one object of the manifests.
+type ApplyResult struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Namespace  string      `json:"namespace,omitempty"`
+	Name       string      `json:"name"`
+	Status     ApplyStatus `json:"status"`
+	Error      string      `json:"error,omitempty"`
+}
+
+type ApplyResultList struct {
+	Items  []ApplyResult `json:"items"`
+	DryRun bool          `json:"dryRun"`
+}
+
+// Failed tells if an object could not be applied or pruned.
+func (rc *ApplyResultList) Failed() bool {
+	for _, v := range rc.Items {
+		if v.Status == ApplyStatusFailed {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package model
+
+// BulkAction is what a bulk deletion does to each of the matched objects.
+type BulkAction string
+
+const (
+	BulkActionDelete BulkAction = "delete"
+	// BulkActionEvict evicts the pods through the eviction API, which respects the PodDisruptionBudgets.
+	BulkActionEvict BulkAction = "evict"
+)
+
+// BulkDeleteOptions may be provided when deleting the objects matched by a label selector.
+type BulkDeleteOptions struct {
+	DeleteOptions `json:",inline"`
+	// LabelSelector selects the objects to delete, it can't be empty.
+	LabelSelector string     `json:"labelSelector"`
+	Action        BulkAction `json:"action"`
+	// ConfirmationToken is the token of the preview, the deletion only runs when the objects
+	// matched are still the ones previewed.
+	ConfirmationToken string `json:"confirmationToken,omitempty"`
+}
+
+// BulkDeletePreview lists the objects a bulk deletion would affect, nothing is deleted until
+// the deletion is confirmed with the token.
+type BulkDeletePreview struct {
+	Kind              string            `json:"kind"`
+	Namespace         string            `json:"namespace"`
+	LabelSelector     string            `json:"labelSelector"`
+	Action            BulkAction        `json:"action"`
+	Items             []ObjectReference `json:"items"`
+	ConfirmationToken string            `json:"confirmationToken"`
+}
+
+// BulkDeleteResult is the outcome of a confirmed bulk deletion, object by object.
+type BulkDeleteResult struct {
+	Action    BulkAction       `json:"action"`
+	Items     []BulkItemResult `json:"items"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+}
+
+// BulkItemResult is the outcome of the deletion of one object, Error is empty when it succeeded.
+type BulkItemResult struct {
+	ObjectReference `json:",inline"`
+	Error           string `json:"error,omitempty"`
+}
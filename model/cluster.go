@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// Sources a cluster can be registered from.
+const (
+	ClusterSourceConfig   = "config"
+	ClusterSourceDatabase = "database"
+)
+
+// Cluster is a Kubernetes cluster registered through the API.
+// The kubeconfig is stored encrypted and never returned.
+type Cluster struct {
+	CreatedAt  time.Time `json:"created_at"`
+	DeletedAt  time.Time `json:"deleted_at" pg:",soft_delete"`
+	Name       string    `json:"name" pg:",unique"`
+	Kubeconfig string    `json:"-"`
+	Context    string    `json:"context,omitempty"`
+	ID         int64     `json:"id" pg:",pk"`
+}
+
+type ClusterRequest struct {
+	Name       string `json:"name"`
+	Kubeconfig string `json:"kubeconfig"`
+	Context    string `json:"context"`
+}
+
+// ClusterStatus describes a known cluster and whether its API server answered the last health check.
+type ClusterStatus struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source"`
+	Version   string    `json:"version,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	Default   bool      `json:"default"`
+	Healthy   bool      `json:"healthy"`
+}
+
+type ClusterList struct {
+	Default  string          `json:"default"`
+	Clusters []ClusterStatus `json:"clusters"`
+}
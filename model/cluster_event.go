@@ -0,0 +1,91 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Types of cluster events reported by Kubernetes.
+const (
+	ClusterEventTypeNormal  = "Normal"
+	ClusterEventTypeWarning = "Warning"
+)
+
+// ClusterEvent is an event reported by the Kubernetes cluster (core/v1 Event).
+// It is unrelated to the audit Event recorded by this API.
+type ClusterEvent struct {
+	FirstTimestamp time.Time       `json:"firstTimestamp,omitempty"`
+	LastTimestamp  time.Time       `json:"lastTimestamp,omitempty"`
+	InvolvedObject ObjectReference `json:"involvedObject"`
+	Name           string          `json:"name"`
+	Namespace      string          `json:"namespace,omitempty"`
+	Type           string          `json:"type,omitempty"`
+	Reason         string          `json:"reason,omitempty"`
+	Message        string          `json:"message,omitempty"`
+	Source         string          `json:"source,omitempty"`
+	Count          int32           `json:"count,omitempty"`
+}
+
+// ClusterEventList is a list of cluster events, newest first.
+type ClusterEventList struct {
+	ListMeta `json:"metadata,omitempty"`
+	Items    []ClusterEvent `json:"items"`
+}
+
+// ObjectReference identifies the object a cluster event is about.
+type ObjectReference struct {
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	UID        string `json:"uid,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	FieldPath  string `json:"fieldPath,omitempty"`
+}
+
+// WarningReasonReferenceNotFound is the reason of the warnings about a config map, a secret or a key
+// referenced by a created pod but missing from its namespace. The API finds them before the cluster reports them.
+const WarningReasonReferenceNotFound = "ReferenceNotFound"
+
+// ClusterEventWarning summarizes a warning reason recently reported by the cluster for an object.
+type ClusterEventWarning struct {
+	LastTimestamp time.Time `json:"lastTimestamp,omitempty"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message,omitempty"`
+	Count         int32     `json:"count,omitempty"`
+}
+
+// LatestWarnings returns the most recent warning for each reason, newest first, at most limit of them.
+func (rc *ClusterEventList) LatestWarnings(limit int) []ClusterEventWarning {
+	latest := make(map[string]ClusterEventWarning)
+	for _, event := range rc.Items {
+		if event.Type != ClusterEventTypeWarning {
+			continue
+		}
+
+		if exist, ok := latest[event.Reason]; ok && !event.LastTimestamp.After(exist.LastTimestamp) {
+			continue
+		}
+
+		latest[event.Reason] = ClusterEventWarning{
+			LastTimestamp: event.LastTimestamp,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+		}
+	}
+
+	warnings := make([]ClusterEventWarning, 0, len(latest))
+	for _, v := range latest {
+		warnings = append(warnings, v)
+	}
+
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].LastTimestamp.After(warnings[j].LastTimestamp)
+	})
+
+	if len(warnings) > limit {
+		warnings = warnings[:limit]
+	}
+
+	return warnings
+}
@@ -1,7 +1,10 @@
 package model
 
 import (
+	"slices"
 	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type TypeMeta struct {
@@ -23,11 +26,11 @@ type ObjectMeta struct {
 	CreationTimestamp          time.Time         `json:"creationTimestamp,omitempty"`
 	DeletionTimestamp          *time.Time        `json:"deletionTimestamp,omitempty"`
 	DeletionGracePeriodSeconds *int64            `json:"deletionGracePeriodSeconds,omitempty"`
-	Labels                     map[string]string `json:"labels,omitempty"`
-	Annotations                map[string]string `json:"annotations,omitempty"`
+	Labels                     map[string]string `json:"labels,omitempty" binding:"omitempty,labels"`
+	Annotations                map[string]string `json:"annotations,omitempty" binding:"omitempty,annotations"`
 	Name                       string            `json:"name,omitempty"`
 	GenerateName               string            `json:"generateName,omitempty"`
-	Namespace                  string            `json:"namespace,omitempty"`
+	Namespace                  string            `json:"namespace,omitempty" binding:"omitempty,dns1123label"`
 	ResourceVersion            string            `json:"resourceVersion,omitempty"`
 	OwnerReferences            []OwnerReference  `json:"ownerReferences,omitempty"`
 	Finalizers                 []string          `json:"finalizers,omitempty"`
@@ -51,39 +54,358 @@ type OwnerReference struct {
 }
 
 type Container struct {
-	Name                   string          `json:"name"`
-	Image                  string          `json:"image,omitempty"`
-	WorkingDir             string          `json:"workingDir,omitempty"`
-	TerminationMessagePath string          `json:"terminationMessagePath,omitempty"`
-	Command                []string        `json:"command,omitempty"`
-	Args                   []string        `json:"args,omitempty"`
-	Ports                  []ContainerPort `json:"ports,omitempty"`
-	Env                    []EnvVar        `json:"env,omitempty"`
-	Stdin                  bool            `json:"stdin,omitempty"`
-	StdinOnce              bool            `json:"stdinOnce,omitempty"`
-	TTY                    bool            `json:"tty,omitempty"`
+	Name                   string               `json:"name" binding:"required,dns1123label"`
+	Image                  string               `json:"image,omitempty" binding:"required,image"`
+	ImagePullPolicy        PullPolicy           `json:"imagePullPolicy,omitempty" binding:"omitempty,oneof=Always Never IfNotPresent"`
+	WorkingDir             string               `json:"workingDir,omitempty"`
+	TerminationMessagePath string               `json:"terminationMessagePath,omitempty"`
+	Command                []string             `json:"command,omitempty"`
+	Args                   []string             `json:"args,omitempty"`
+	Ports                  []ContainerPort      `json:"ports,omitempty" binding:"dive"`
+	EnvFrom                []EnvFromSource      `json:"envFrom,omitempty" binding:"dive"`
+	Env                    []EnvVar             `json:"env,omitempty" binding:"dive"`
+	Resources              ResourceRequirements `json:"resources,omitempty"`
+	VolumeMounts           []VolumeMount        `json:"volumeMounts,omitempty" binding:"dive"`
+	LivenessProbe          *Probe               `json:"livenessProbe,omitempty"`
+	ReadinessProbe         *Probe               `json:"readinessProbe,omitempty"`
+	StartupProbe           *Probe               `json:"startupProbe,omitempty"`
+	SecurityContext        *SecurityContext     `json:"securityContext,omitempty"`
+	Stdin                  bool                 `json:"stdin,omitempty"`
+	StdinOnce              bool                 `json:"stdinOnce,omitempty"`
+	TTY                    bool                 `json:"tty,omitempty"`
 }
 
-type EnvVar struct {
+// PullPolicy describes a policy for if/when to pull a container image: Always, Never or IfNotPresent.
+type PullPolicy string
+
+// ResourceName is the name of a resource, like cpu, memory or ephemeral-storage.
+type ResourceName string
+
+// ResourceList maps the resources to their quantities, like 500m of cpu or 128Mi of memory.
+type ResourceList map[ResourceName]string
+
+// ResourceRequirements describes the compute resource requirements of a container.
+type ResourceRequirements struct {
+	// Limits describes the maximum amount of compute resources allowed.
+	Limits ResourceList `json:"limits,omitempty" binding:"omitempty,dive,quantity"`
+	// Requests describes the minimum amount of compute resources required.
+	// It defaults to the limits when omitted.
+	Requests ResourceList `json:"requests,omitempty" binding:"omitempty,dive,quantity"`
+}
+
+// VolumeMount describes the mounting of a volume of the pod within a container.
+type VolumeMount struct {
+	// Name must match the name of a volume of the pod.
+	Name string `json:"name" binding:"required,dns1123label"`
+	// Path within the container at which the volume should be mounted.
+	MountPath string `json:"mountPath" binding:"required"`
+	// Path within the volume from which the container's volume should be mounted.
+	// Defaults to "" (volume's root).
+	SubPath  string `json:"subPath,omitempty"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+}
+
+// Probe describes a health check to be performed against a container to determine whether it is
+// alive or ready to receive traffic.
+type Probe struct {
+	ProbeHandler `json:",inline"`
+	// Number of seconds after the container has started before the probe is initiated.
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty" binding:"omitempty,min=0"`
+	// Number of seconds after which the probe times out. Defaults to 1 second.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty" binding:"omitempty,min=1"`
+	// How often (in seconds) to perform the probe. Default to 10 seconds.
+	PeriodSeconds int32 `json:"periodSeconds,omitempty" binding:"omitempty,min=1"`
+	// Minimum consecutive successes for the probe to be considered successful after having failed.
+	SuccessThreshold int32 `json:"successThreshold,omitempty" binding:"omitempty,min=1"`
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
+	FailureThreshold int32 `json:"failureThreshold,omitempty" binding:"omitempty,min=1"`
+	// Duration in seconds the pod needs to terminate gracefully upon probe failure.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" binding:"omitempty,min=1"`
+}
+
+// ProbeHandler defines the action taken by a probe, exactly one of them must be specified.
+type ProbeHandler struct {
+	Exec      *ExecAction      `json:"exec,omitempty"`
+	HTTPGet   *HTTPGetAction   `json:"httpGet,omitempty"`
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+	GRPC      *GRPCAction      `json:"grpc,omitempty"`
+}
+
+// ExecAction describes a "run in container" action.
+type ExecAction struct {
+	Command []string `json:"command,omitempty"`
+}
+
+// URIScheme identifies the scheme used for connection to a host for Get actions, HTTP or HTTPS.
+type URIScheme string
+
+// HTTPGetAction describes an action based on HTTP Get requests.
+type HTTPGetAction struct {
+	// Name or number of the port to access on the container.
+	Port        intstr.IntOrString `json:"port" swaggertype:"string"`
+	Path        string             `json:"path,omitempty"`
+	Host        string             `json:"host,omitempty"`
+	Scheme      URIScheme          `json:"scheme,omitempty" binding:"omitempty,oneof=HTTP HTTPS"`
+	HTTPHeaders []HTTPHeader       `json:"httpHeaders,omitempty"`
+}
+
+// HTTPHeader describes a custom header to be used in HTTP probes.
+type HTTPHeader struct {
 	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// TCPSocketAction describes an action based on opening a socket.
+type TCPSocketAction struct {
+	// Name or number of the port to access on the container.
+	Port intstr.IntOrString `json:"port" swaggertype:"string"`
+	Host string             `json:"host,omitempty"`
+}
+
+// GRPCAction describes an action based on the gRPC health checking protocol.
+type GRPCAction struct {
+	Port    int32   `json:"port" binding:"min=1,max=65535"`
+	Service *string `json:"service,omitempty"`
+}
+
+// SecurityContext holds the security configuration applied to a container.
+// Its fields take precedence over the ones of the PodSecurityContext.
+type SecurityContext struct {
+	Capabilities             *Capabilities   `json:"capabilities,omitempty"`
+	Privileged               *bool           `json:"privileged,omitempty"`
+	RunAsUser                *int64          `json:"runAsUser,omitempty" binding:"omitempty,min=0"`
+	RunAsGroup               *int64          `json:"runAsGroup,omitempty" binding:"omitempty,min=0"`
+	RunAsNonRoot             *bool           `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem   *bool           `json:"readOnlyRootFilesystem,omitempty"`
+	AllowPrivilegeEscalation *bool           `json:"allowPrivilegeEscalation,omitempty"`
+	SeccompProfile           *SeccompProfile `json:"seccompProfile,omitempty"`
+}
+
+// Capability represents a POSIX capability, like NET_ADMIN.
+type Capability string
+
+// Capabilities adds and removes POSIX capabilities from running containers.
+type Capabilities struct {
+	Add  []Capability `json:"add,omitempty"`
+	Drop []Capability `json:"drop,omitempty"`
+}
+
+// SeccompProfileType defines the supported seccomp profile types: Unconfined, RuntimeDefault or Localhost.
+type SeccompProfileType string
+
+// SeccompProfile defines a pod or container's seccomp profile settings.
+type SeccompProfile struct {
+	Type SeccompProfileType `json:"type" binding:"oneof=Unconfined RuntimeDefault Localhost"`
+	// The profile defined in a file on the node, only with the Localhost type.
+	LocalhostProfile *string `json:"localhostProfile,omitempty"`
+}
+
+type EnvVar struct {
+	Name  string `json:"name" binding:"required,envvarname"`
 	Value string `json:"value,omitempty"`
+	// ValueFrom sources the value from a config map, a secret, a field of the pod or a resource of a container.
+	// It cannot be used along Value.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty" binding:"omitempty,excluded_with=Value"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar, exactly one of its members must be specified.
+type EnvVarSource struct {
+	// Selects a field of the pod, like metadata.name, metadata.namespace or status.podIP.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+	// Selects a resource of the container, like limits.cpu or requests.memory.
+	ResourceFieldRef *ResourceFieldSelector `json:"resourceFieldRef,omitempty"`
+	// Selects a key of a config map in the namespace of the pod.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// Selects a key of a secret in the namespace of the pod.
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a config map.
+type ConfigMapKeySelector struct {
+	LocalObjectReference `json:",inline"`
+	Key                  string `json:"key" binding:"required"`
+	// Optional lets the container start when the config map or its key is missing.
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	LocalObjectReference `json:",inline"`
+	Key                  string `json:"key" binding:"required"`
+	// Optional lets the container start when the secret or its key is missing.
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// EnvFromSource sets every key of a config map or a secret as an environment variable, exactly one
+// of ConfigMapRef or SecretRef must be specified.
+type EnvFromSource struct {
+	// Prefix prepended to every key, which must then be valid environment variable names.
+	Prefix       string              `json:"prefix,omitempty"`
+	ConfigMapRef *ConfigMapEnvSource `json:"configMapRef,omitempty"`
+	SecretRef    *SecretEnvSource    `json:"secretRef,omitempty"`
+}
+
+// ConfigMapEnvSource selects a config map to populate the environment variables with.
+type ConfigMapEnvSource struct {
+	LocalObjectReference `json:",inline"`
+	// Optional lets the container start when the config map is missing.
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// SecretEnvSource selects a secret to populate the environment variables with.
+type SecretEnvSource struct {
+	LocalObjectReference `json:",inline"`
+	// Optional lets the container start when the secret is missing.
+	Optional *bool `json:"optional,omitempty"`
 }
 
 type Protocol string
 
 type ContainerPort struct {
-	Name          string   `json:"name,omitempty"`
-	Protocol      Protocol `json:"protocol,omitempty"`
-	HostIP        string   `json:"hostIP,omitempty"`
-	HostPort      int32    `json:"hostPort,omitempty"`
-	ContainerPort int32    `json:"containerPort"`
+	Name          string   `json:"name,omitempty" binding:"omitempty,portname"`
+	Protocol      Protocol `json:"protocol,omitempty" binding:"omitempty,oneof=TCP UDP SCTP"`
+	HostIP        string   `json:"hostIP,omitempty" binding:"omitempty,ip"`
+	HostPort      int32    `json:"hostPort,omitempty" binding:"omitempty,min=1,max=65535"`
+	ContainerPort int32    `json:"containerPort" binding:"min=1,max=65535"`
 }
 type Volume struct {
 	VolumeSource `json:",inline"`
-	Name         string `json:"name"`
+	Name         string `json:"name" binding:"required,dns1123label"`
+}
+
+// VolumeSource represents the location and type of the mounted volume, exactly one of its
+// members must be specified.
+type VolumeSource struct {
+	EmptyDir              *EmptyDirVolumeSource              `json:"emptyDir,omitempty"`
+	HostPath              *HostPathVolumeSource              `json:"hostPath,omitempty"`
+	ConfigMap             *ConfigMapVolumeSource             `json:"configMap,omitempty"`
+	Secret                *SecretVolumeSource                `json:"secret,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	DownwardAPI           *DownwardAPIVolumeSource           `json:"downwardAPI,omitempty"`
+	Projected             *ProjectedVolumeSource             `json:"projected,omitempty"`
+}
+
+// StorageMedium defines ways storage can be allocated to a volume, "" (the node's default) or Memory.
+type StorageMedium string
+
+// EmptyDirVolumeSource is an empty directory sharing the pod's lifetime.
+type EmptyDirVolumeSource struct {
+	Medium StorageMedium `json:"medium,omitempty" binding:"omitempty,oneof=Memory"`
+	// Total amount of local storage required, like 1Gi.
+	SizeLimit *string `json:"sizeLimit,omitempty" binding:"omitempty,quantity"`
+}
+
+// HostPathType is the type of the path checked before mounting a host path, like Directory or File.
+type HostPathType string
+
+// HostPathVolumeSource is a file or directory on the node mapped into the pod.
+type HostPathVolumeSource struct {
+	Path string        `json:"path" binding:"required"`
+	Type *HostPathType `json:"type,omitempty"`
+}
+
+// LocalObjectReference contains enough information to locate the referenced object inside the same namespace.
+type LocalObjectReference struct {
+	Name string `json:"name,omitempty"`
+}
+
+// KeyToPath maps a key of a config map or secret to a path within a volume.
+type KeyToPath struct {
+	Key  string `json:"key" binding:"required"`
+	Path string `json:"path" binding:"required"`
+	// Mode bits of the file, like 0644, defaulting to the mode of the volume.
+	Mode *int32 `json:"mode,omitempty"`
+}
+
+// ConfigMapVolumeSource populates a volume with the data of a config map.
+type ConfigMapVolumeSource struct {
+	LocalObjectReference `json:",inline"`
+	Items                []KeyToPath `json:"items,omitempty" binding:"dive"`
+	DefaultMode          *int32      `json:"defaultMode,omitempty"`
+	Optional             *bool       `json:"optional,omitempty"`
+}
+
+// SecretVolumeSource populates a volume with the data of a secret.
+type SecretVolumeSource struct {
+	SecretName  string      `json:"secretName,omitempty"`
+	Items       []KeyToPath `json:"items,omitempty" binding:"dive"`
+	DefaultMode *int32      `json:"defaultMode,omitempty"`
+	Optional    *bool       `json:"optional,omitempty"`
+}
+
+// PersistentVolumeClaimVolumeSource references a persistent volume claim in the same namespace.
+type PersistentVolumeClaimVolumeSource struct {
+	ClaimName string `json:"claimName" binding:"required"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// ObjectFieldSelector selects a field of the pod, like metadata.name or status.podIP.
+type ObjectFieldSelector struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	FieldPath  string `json:"fieldPath" binding:"required"`
+}
+
+// ResourceFieldSelector selects a resource of a container, like limits.cpu or requests.memory.
+type ResourceFieldSelector struct {
+	ContainerName string `json:"containerName,omitempty"`
+	Resource      string `json:"resource" binding:"required"`
+	// Output format of the exposed resources, like 1Mi, defaults to 1.
+	Divisor *string `json:"divisor,omitempty" binding:"omitempty,quantity"`
+}
+
+// DownwardAPIVolumeFile is a file of a downward API volume holding a field of the pod or a resource of a container.
+type DownwardAPIVolumeFile struct {
+	Path             string                 `json:"path" binding:"required"`
+	FieldRef         *ObjectFieldSelector   `json:"fieldRef,omitempty"`
+	ResourceFieldRef *ResourceFieldSelector `json:"resourceFieldRef,omitempty"`
+	Mode             *int32                 `json:"mode,omitempty"`
+}
+
+// DownwardAPIVolumeSource populates a volume with the fields of the pod and the resources of its containers.
+type DownwardAPIVolumeSource struct {
+	Items       []DownwardAPIVolumeFile `json:"items,omitempty" binding:"dive"`
+	DefaultMode *int32                  `json:"defaultMode,omitempty"`
+}
+
+// ProjectedVolumeSource projects several sources in the same directory.
+type ProjectedVolumeSource struct {
+	Sources     []VolumeProjection `json:"sources" binding:"dive"`
+	DefaultMode *int32             `json:"defaultMode,omitempty"`
+}
+
+// VolumeProjection is a source projected along others, exactly one of its members must be specified.
+type VolumeProjection struct {
+	Secret              *SecretProjection              `json:"secret,omitempty"`
+	ConfigMap           *ConfigMapProjection           `json:"configMap,omitempty"`
+	DownwardAPI         *DownwardAPIProjection         `json:"downwardAPI,omitempty"`
+	ServiceAccountToken *ServiceAccountTokenProjection `json:"serviceAccountToken,omitempty"`
+}
+
+// SecretProjection projects the data of a secret.
+type SecretProjection struct {
+	LocalObjectReference `json:",inline"`
+	Items                []KeyToPath `json:"items,omitempty" binding:"dive"`
+	Optional             *bool       `json:"optional,omitempty"`
+}
+
+// ConfigMapProjection projects the data of a config map.
+type ConfigMapProjection struct {
+	LocalObjectReference `json:",inline"`
+	Items                []KeyToPath `json:"items,omitempty" binding:"dive"`
+	Optional             *bool       `json:"optional,omitempty"`
+}
+
+// DownwardAPIProjection projects the fields of the pod and the resources of its containers.
+type DownwardAPIProjection struct {
+	Items []DownwardAPIVolumeFile `json:"items,omitempty" binding:"dive"`
 }
 
-type VolumeSource struct{}
+// ServiceAccountTokenProjection projects a token of the service account of the pod.
+type ServiceAccountTokenProjection struct {
+	Audience          string `json:"audience,omitempty"`
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" binding:"omitempty,min=600"`
+	Path              string `json:"path" binding:"required"`
+}
 
 type TaintEffect string
 
@@ -112,21 +434,21 @@ type LabelSelector struct {
 	// map is equivalent to an element of matchExpressions, whose key field is "key", the
 	// operator is "In", and the values array contains only "value". The requirements are ANDed.
 	// +optional
-	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	MatchLabels map[string]string `json:"matchLabels,omitempty" binding:"omitempty,labels"`
 	// matchExpressions is a list of label selector requirements. The requirements are ANDed.
 	// +optional
 	// +listType=atomic
-	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty" binding:"dive"`
 }
 
 // A label selector requirement is a selector that contains values, a key, and an operator that
 // relates the key and values.
 type LabelSelectorRequirement struct {
 	// key is the label key that the selector applies to.
-	Key string `json:"key"`
+	Key string `json:"key" binding:"required,labelkey"`
 	// operator represents a key's relationship to a set of values.
 	// Valid operators are In, NotIn, Exists and DoesNotExist.
-	Operator LabelSelectorOperator `json:"operator"`
+	Operator LabelSelectorOperator `json:"operator" binding:"oneof=In NotIn Exists DoesNotExist"`
 	// values is an array of string values. If the operator is In or NotIn,
 	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
 	// the values array must be empty. This array is replaced during a strategic
@@ -146,6 +468,47 @@ const (
 	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
 )
 
+// IsEmpty reports whether the selector has no requirement, matching every object.
+func (rc *LabelSelector) IsEmpty() bool {
+	return len(rc.MatchLabels) == 0 && len(rc.MatchExpressions) == 0
+}
+
+// Matches reports whether the labels satisfy every requirement of the selector.
+func (rc *LabelSelector) Matches(labels map[string]string) bool {
+	for k, v := range rc.MatchLabels {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	for _, v := range rc.MatchExpressions {
+		value, ok := labels[v.Key]
+
+		switch v.Operator {
+		case LabelSelectorOpIn:
+			if !ok || !slices.Contains(v.Values, value) {
+				return false
+			}
+		case LabelSelectorOpNotIn:
+			if ok && slices.Contains(v.Values, value) {
+				return false
+			}
+		case LabelSelectorOpExists:
+			if !ok {
+				return false
+			}
+		case LabelSelectorOpDoesNotExist:
+			if ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 // CreateOptions may be provided when creating an API object.
 type CreateOptions struct {
 	TypeMeta        `json:",inline"`
@@ -161,6 +524,30 @@ type UpdateOptions struct {
 	FieldManager    string   `json:"fieldManager,omitempty"`
 	FieldValidation string   `json:"fieldValidation,omitempty"`
 	DryRun          []string `json:"dryRun,omitempty"`
+	// Must be fulfilled before the update is carried out, set from the If-Match header.
+	// +optional
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+	// Retry the update on the latest version of the object when it changed meanwhile.
+	// Ignored with preconditions, the caller asked for a given version then.
+	// +optional
+	RetryOnConflict bool `json:"retryOnConflict,omitempty"`
+}
+
+// PatchType is the format of a patch, given as the media type of the request.
+type PatchType string
+
+const (
+	MergePatchType          PatchType = "application/merge-patch+json"
+	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+	ApplyPatchType          PatchType = "application/apply-patch+yaml"
+)
+
+// PatchOptions may be provided when patching an API object.
+// Force is only allowed with server-side apply, to take over the fields owned by other managers.
+type PatchOptions struct {
+	UpdateOptions `json:",inline"`
+	// +optional
+	Force *bool `json:"force,omitempty"`
 }
 
 // resourceVersionMatch specifies how the resourceVersion parameter is applied. resourceVersionMatch
@@ -15,6 +15,10 @@ type Deployment struct {
 	// Most recently observed status of the Deployment.
 	// +optional
 	Status DeploymentStatus `json:"status,omitempty"`
+	// Warnings are the latest warning reasons reported by the cluster for the deployment.
+	// They come from Kubernetes events, not from the audit log. On creation, they list the
+	// config maps, secrets and keys referenced by the deployment but missing from its namespace.
+	Warnings []ClusterEventWarning `json:"warnings,omitempty"`
 }
 
 // DeploymentList is a list of Deployments.
@@ -37,7 +41,7 @@ type DeploymentSpec struct {
 	// Number of desired pods. This is a pointer to distinguish between explicit
 	// zero and not specified. Defaults to 1.
 	// +optional
-	Replicas *int32 `json:"replicas,omitempty"`
+	Replicas *int32 `json:"replicas,omitempty" binding:"omitempty,replicas"`
 	// Label selector for pods. Existing ReplicaSets whose pods are
 	// selected by this will be the ones affected by this deployment.
 	// It must match the pod template's labels.
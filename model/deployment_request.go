@@ -17,12 +17,12 @@ type (
 	}
 
 	DeploymentObjectMetaUpdateRequest struct {
-		Labels      map[string]string `json:"labels,omitempty"`
-		Annotations map[string]string `json:"annotations,omitempty"`
+		Labels      map[string]string `json:"labels,omitempty" binding:"omitempty,labels"`
+		Annotations map[string]string `json:"annotations,omitempty" binding:"omitempty,annotations"`
 	}
 
 	DeploymentSpecUpdateRequest struct {
-		Replicas                *int32             `json:"replicas,omitempty"`
+		Replicas                *int32             `json:"replicas,omitempty" binding:"omitempty,replicas"`
 		ProgressDeadlineSeconds *int32             `json:"progressDeadlineSeconds,omitempty"`
 		Strategy                DeploymentStrategy `json:"strategy,omitempty"`
 		Template                PodTemplateSpec    `json:"template"`
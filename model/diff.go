@@ -0,0 +1,28 @@
+package model
+
+// DiffOperation tells how a change affects a field.
+type DiffOperation string
+
+const (
+	DiffOperationAdd     DiffOperation = "add"
+	DiffOperationRemove  DiffOperation = "remove"
+	DiffOperationReplace DiffOperation = "replace"
+)
+
+// FieldChange is the change of one field, found at Path like spec.containers[0].image.
+// From is empty for the added fields and To for the removed ones.
+type FieldChange struct {
+	From      interface{}   `json:"from,omitempty"`
+	To        interface{}   `json:"to,omitempty"`
+	Path      string        `json:"path"`
+	Operation DiffOperation `json:"op"`
+}
+
+// Diff previews a change: the live object, null for creations, the object computed by the
+// dry run of the change, null for deletions, their changed fields and a unified diff of their YAML.
+type Diff struct {
+	Live    interface{}   `json:"live"`
+	Result  interface{}   `json:"result"`
+	Changes []FieldChange `json:"changes"`
+	Unified string        `json:"unified"`
+}
@@ -1,18 +1,32 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
 
 const (
 	UserCategory       = "user"
 	PodCategory        = "pod"
 	DeploymentCategory = "deployment"
 	NamespaceCategory  = "namespace"
+	ClusterCategory    = "cluster"
+	NodeCategory       = "node"
 )
 
 const (
 	CreateEventType = "create"
 	UpdateEventType = "update"
 	DeleteEventType = "delete"
+	// EvictEventType is the deletion of a pod through the eviction API.
+	EvictEventType = "evict"
+
+	// Maintenance of the nodes.
+	CordonEventType   = "cordon"
+	UncordonEventType = "uncordon"
+	DrainEventType    = "drain"
 )
 
 type Event struct {
@@ -20,7 +34,27 @@ type Event struct {
 	DeletedAt time.Time `json:"deleted_at" pg:",soft_delete"`
 	Type      string    `json:"type"`
 	Category  string    `json:"category"`
+	Hash      string    `json:"hash"`
+	PrevHash  string    `json:"prev_hash"`
 	Owner     Owner     `json:"owner" pg:"rel:has-one"`
+	ID        int64     `json:"id" pg:",pk"`
+}
+
+// ComputeHash returns the hash chaining the event to its predecessor.
+// The row id is not part of the hash since it is assigned by the database on insert.
+func (e *Event) ComputeHash(prevHash string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%d|%s|%s|%d",
+		prevHash,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		e.Category,
+		e.Type,
+		e.Owner.ID,
+		e.Owner.Username,
+		e.Owner.Email,
+		e.Owner.RoleID,
+	)))
+
+	return hex.EncodeToString(sum[:])
 }
 
 type EventList struct {
@@ -38,3 +72,36 @@ type EventFindOpts struct {
 	FieldsOpts
 	PaginationOpts
 }
+
+// EventArchive records a batch of events moved out of the database into an archive file.
+// The last hash anchors the chain of the events remaining in the database.
+type EventArchive struct {
+	CreatedAt    time.Time `json:"created_at"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	FileName     string    `json:"file_name"`
+	LastHash     string    `json:"last_hash"`
+	ID           int64     `json:"id" pg:",pk"`
+	FirstEventID int64     `json:"first_event_id"`
+	LastEventID  int64     `json:"last_event_id"`
+	Count        int       `json:"count"`
+}
+
+// EventChainReport is the result of verifying the event hash chain.
+type EventChainReport struct {
+	// BrokenAt is the id of the first event whose link does not match, if any.
+	BrokenAt   *int64 `json:"broken_at,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	AnchorHash string `json:"anchor_hash"`
+	Checked    int    `json:"checked"`
+	// Unchained counts the events written before the hash chain was introduced.
+	Unchained int  `json:"unchained"`
+	Valid     bool `json:"valid"`
+}
+
+// EventRetentionOpts configures how long events stay in the database before being archived.
+type EventRetentionOpts struct {
+	HotDays   int
+	BatchSize int
+	Interval  time.Duration
+}
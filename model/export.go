@@ -0,0 +1,16 @@
+package model
+
+// ExportFormat is the encoding of exported manifests.
+type ExportFormat string
+
+const (
+	ExportFormatYAML  ExportFormat = "yaml"
+	ExportFormatJSON  ExportFormat = "json"
+	ExportFormatTarGz ExportFormat = "tar.gz"
+)
+
+// NamespaceExportOptions may be provided when exporting every object of a namespace.
+// Secrets are left out unless IncludeSecrets is set, as exports often end up in git.
+type NamespaceExportOptions struct {
+	IncludeSecrets bool `json:"includeSecrets,omitempty"`
+}
@@ -0,0 +1,22 @@
+package model
+
+// Health statuses reported by the probes.
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusFailing  = "failing"
+	HealthStatusDraining = "draining"
+)
+
+// HealthCheck is the result of checking one dependency of the API.
+type HealthCheck struct {
+	Name     string `json:"name"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+	Healthy  bool   `json:"healthy"`
+}
+
+// HealthReport is the readiness of the API along with the result of each check.
+type HealthReport struct {
+	Status string        `json:"status"`
+	Checks []HealthCheck `json:"checks,omitempty"`
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// MetricsSort is the resource the pods are sorted by, from the most used.
+type MetricsSort string
+
+const (
+	MetricsSortCPU    MetricsSort = "cpu"
+	MetricsSortMemory MetricsSort = "memory"
+)
+
+// ResourceUsage is the usage of a resource compared with its request and limit. The quantities
+// are in millicores for the CPU and in bytes for the memory, like 250m or 128Mi. The request or
+// the limit is left out when not set, and so is the limit of a pod or a namespace as soon as one
+// of its containers runs without one.
+type ResourceUsage struct {
+	Usage   string `json:"usage"`
+	Request string `json:"request,omitempty"`
+	Limit   string `json:"limit,omitempty"`
+	// RequestPercent is the usage in percent of the request, above 100 when the request is exceeded.
+	RequestPercent *int64 `json:"requestPercent,omitempty"`
+	LimitPercent   *int64 `json:"limitPercent,omitempty"`
+}
+
+// ContainerMetrics is the resource usage of a container.
+type ContainerMetrics struct {
+	Name   string        `json:"name"`
+	CPU    ResourceUsage `json:"cpu"`
+	Memory ResourceUsage `json:"memory"`
+}
+
+// PodMetrics is the resource usage of a pod, the sum of its containers, as reported by metrics-server.
+type PodMetrics struct {
+	// Timestamp is the end of the Window the usage was averaged over.
+	Timestamp  time.Time          `json:"timestamp"`
+	Name       string             `json:"name"`
+	Namespace  string             `json:"namespace"`
+	Window     string             `json:"window"`
+	Containers []ContainerMetrics `json:"containers"`
+	CPU        ResourceUsage      `json:"cpu"`
+	Memory     ResourceUsage      `json:"memory"`
+}
+
+// NamespaceMetrics is the resource usage of a namespace, the sum of the pods metrics-server reports,
+// most CPU using pod first. The pods without metrics yet, like the pending ones, are left out.
+type NamespaceMetrics struct {
+	Namespace string        `json:"namespace"`
+	Pods      []PodMetrics  `json:"pods"`
+	CPU       ResourceUsage `json:"cpu"`
+	Memory    ResourceUsage `json:"memory"`
+}
+
+// PodUsage is the CPU and memory used by a pod, shown in the pod list when it is sorted by usage.
+type PodUsage struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
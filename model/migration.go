@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// MigrationStatus tells whether a schema migration has been applied to the database.
+type MigrationStatus struct {
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	Name      string     `json:"name"`
+	Version   int64      `json:"version"`
+	Applied   bool       `json:"applied"`
+}
@@ -16,7 +16,7 @@ type (
 		Spec                             NamespaceSpec `json:"spec,omitempty"`
 	}
 	NamespaceObjectMetaUpdateRequest struct {
-		Labels      map[string]string `json:"labels,omitempty"`
-		Annotations map[string]string `json:"annotations,omitempty"`
+		Labels      map[string]string `json:"labels,omitempty" binding:"omitempty,labels"`
+		Annotations map[string]string `json:"annotations,omitempty" binding:"omitempty,annotations"`
 	}
 )
@@ -0,0 +1,118 @@
+package model
+
+import "time"
+
+// Node is a worker machine of the cluster.
+type Node struct {
+	TypeMeta   `json:",inline"`
+	Spec       NodeSpec   `json:"spec,omitempty"`
+	Status     NodeStatus `json:"status,omitempty"`
+	ObjectMeta `json:"metadata,omitempty"`
+	// Pods are the pods scheduled on the node, in every namespace.
+	Pods []NodePod `json:"pods,omitempty"`
+}
+
+// NodeList is a list of Nodes.
+type NodeList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+	Items    []Node `json:"items"`
+}
+
+type NodeSpec struct {
+	PodCIDR    string `json:"podCIDR,omitempty"`
+	ProviderID string `json:"providerID,omitempty"`
+	// Unschedulable is set on cordoned nodes, no new pod is scheduled on them.
+	Unschedulable bool    `json:"unschedulable,omitempty"`
+	Taints        []Taint `json:"taints,omitempty"`
+}
+
+// Taint repels the pods not tolerating it from the node.
+type Taint struct {
+	Key       string      `json:"key"`
+	Value     string      `json:"value,omitempty"`
+	Effect    TaintEffect `json:"effect"`
+	TimeAdded *time.Time  `json:"timeAdded,omitempty"`
+}
+
+type NodeStatus struct {
+	// Capacity is the total of the resources of the node, Allocatable what is left of it for the pods.
+	Capacity    ResourceList    `json:"capacity,omitempty"`
+	Allocatable ResourceList    `json:"allocatable,omitempty"`
+	Conditions  []NodeCondition `json:"conditions,omitempty"`
+	Addresses   []NodeAddress   `json:"addresses,omitempty"`
+	NodeInfo    NodeSystemInfo  `json:"nodeInfo,omitempty"`
+}
+
+// NodeCondition is a condition of the node, like Ready or MemoryPressure.
+type NodeCondition struct {
+	LastHeartbeatTime  time.Time       `json:"lastHeartbeatTime,omitempty"`
+	LastTransitionTime time.Time       `json:"lastTransitionTime,omitempty"`
+	Type               string          `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	Reason             string          `json:"reason,omitempty"`
+	Message            string          `json:"message,omitempty"`
+}
+
+// NodeAddress is an address of the node, like its InternalIP or Hostname.
+type NodeAddress struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+type NodeSystemInfo struct {
+	KernelVersion           string `json:"kernelVersion,omitempty"`
+	OSImage                 string `json:"osImage,omitempty"`
+	ContainerRuntimeVersion string `json:"containerRuntimeVersion,omitempty"`
+	KubeletVersion          string `json:"kubeletVersion,omitempty"`
+	OperatingSystem         string `json:"operatingSystem,omitempty"`
+	Architecture            string `json:"architecture,omitempty"`
+}
+
+// NodePod is a pod scheduled on a node.
+type NodePod struct {
+	Name      string     `json:"name"`
+	Namespace string     `json:"namespace"`
+	Phase     PodPhase   `json:"phase,omitempty"`
+	Summary   PodSummary `json:"summary"`
+}
+
+// DrainOptions may be provided when draining a node.
+type DrainOptions struct {
+	DryRun []string `json:"dryRun,omitempty"`
+	// Timeout bounds the whole drain, evictions blocked by a PodDisruptionBudget are retried until then.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// GracePeriodSeconds overrides the termination grace period of the evicted pods.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	// Force evicts the pods no controller recreates, which are lost, the drain refuses to start otherwise.
+	Force bool `json:"force,omitempty"`
+}
+
+// DrainStep is the kind of a DrainProgress.
+type DrainStep string
+
+const (
+	// DrainStepCordoned is reported once the node is cordoned.
+	DrainStepCordoned DrainStep = "cordoned"
+	// DrainStepSkipped is reported for the pods left on the node, like the ones of a DaemonSet.
+	DrainStepSkipped DrainStep = "skipped"
+	// DrainStepEvicting is reported when the eviction of a pod is requested.
+	DrainStepEvicting DrainStep = "evicting"
+	// DrainStepBlocked is reported when a PodDisruptionBudget refuses the eviction, it is retried.
+	DrainStepBlocked DrainStep = "blocked"
+	// DrainStepEvicted is reported once an evicted pod is gone.
+	DrainStepEvicted DrainStep = "evicted"
+	// DrainStepFailed is reported when a pod can't be evicted, or the drain fails or times out.
+	DrainStepFailed DrainStep = "failed"
+	// DrainStepDone is reported once every pod is evicted, last.
+	DrainStepDone DrainStep = "done"
+)
+
+// DrainProgress is a step of the drain of a node, streamed to the client as it happens.
+type DrainProgress struct {
+	Time      time.Time `json:"time"`
+	Step      DrainStep `json:"step"`
+	Namespace string    `json:"namespace,omitempty"`
+	Pod       string    `json:"pod,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"sort"
+	"strings"
+)
+
+// MiniNode is a Node with only the information needed for the UI.
+type MiniNode struct {
+	MiniObjectMeta `json:"metadata,omitempty"`
+	// Status is Ready, NotReady or Unknown, followed by SchedulingDisabled on cordoned nodes.
+	Status         string       `json:"status"`
+	Roles          []string     `json:"roles,omitempty"`
+	KubeletVersion string       `json:"kubeletVersion,omitempty"`
+	Capacity       ResourceList `json:"capacity,omitempty"`
+	Allocatable    ResourceList `json:"allocatable,omitempty"`
+	Taints         []Taint      `json:"taints,omitempty"`
+	// Pods is the number of pods scheduled on the node.
+	Pods int `json:"pods"`
+}
+
+// MiniNodeList is a list of Nodes with only the information needed for the UI.
+type MiniNodeList struct {
+	ListMeta `json:"metadata,omitempty"`
+	Items    []MiniNode `json:"items"`
+}
+
+// nodeRoleLabelPrefix prefixes the labels of the roles of a node, like node-role.kubernetes.io/control-plane.
+const nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+// ConvertMini converts a NodeList object into a MiniNodeList object.
+func (rc *NodeList) ConvertMini() MiniNodeList {
+	return MiniNodeList{
+		ListMeta: ListMeta(rc.ListMeta),
+		Items:    rc.convertNodesToMini(),
+	}
+}
+
+// convertNodesToMini converts a slice of Node objects into a slice of MiniNode objects.
+func (rc *NodeList) convertNodesToMini() []MiniNode {
+	nodes := make([]MiniNode, len(rc.Items))
+	for i, node := range rc.Items {
+		nodes[i] = MiniNode{
+			MiniObjectMeta: MiniObjectMeta{
+				UID:               node.UID,
+				CreationTimestamp: node.CreationTimestamp,
+				Name:              node.Name,
+				GenerateName:      node.GenerateName,
+			},
+			Status:         node.ReadyStatus(),
+			Roles:          node.Roles(),
+			KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+			Capacity:       node.Status.Capacity,
+			Allocatable:    node.Status.Allocatable,
+			Taints:         node.Spec.Taints,
+			Pods:           len(node.Pods),
+		}
+	}
+
+	return nodes
+}
+
+// ReadyStatus returns the status of the Ready condition of the node like kubectl get nodes,
+// followed by SchedulingDisabled when the node is cordoned.
+func (rc *Node) ReadyStatus() string {
+	status := "Unknown"
+	for _, condition := range rc.Status.Conditions {
+		if condition.Type == "Ready" {
+			switch condition.Status {
+			case "True":
+				status = "Ready"
+			case "False":
+				status = "NotReady"
+			}
+		}
+	}
+
+	if rc.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+
+	return status
+}
+
+// Roles returns the roles of the node, from its node-role.kubernetes.io labels.
+func (rc *Node) Roles() []string {
+	roles := make([]string, 0)
+	for label := range rc.Labels {
+		if role, ok := strings.CutPrefix(label, nodeRoleLabelPrefix); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+
+	return roles
+}
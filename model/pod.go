@@ -9,6 +9,10 @@ type Pod struct {
 	Spec       PodSpec   `json:"spec,omitempty"`
 	Status     PodStatus `json:"status,omitempty"`
 	ObjectMeta `json:"metadata,omitempty"`
+	// Warnings are the latest warning reasons reported by the cluster for the pod.
+	// They come from Kubernetes events, not from the audit log. On creation, they list the
+	// config maps, secrets and keys referenced by the pod but missing from its namespace.
+	Warnings []ClusterEventWarning `json:"warnings,omitempty"`
 }
 
 // PodList is a list of Pods.
@@ -25,12 +29,115 @@ type PodTemplateSpec struct {
 }
 
 type PodSpec struct {
-	Volumes                       []Volume     `json:"volumes,omitempty"`
-	InitContainers                []Container  `json:"initContainers,omitempty"`
-	Containers                    []Container  `json:"containers"`
-	ActiveDeadlineSeconds         *int64       `json:"activeDeadlineSeconds,omitempty"`
-	TerminationGracePeriodSeconds *int64       `json:"terminationGracePeriodSeconds,omitempty"`
-	Tolerations                   []Toleration `json:"tolerations,omitempty"`
+	Volumes                       []Volume               `json:"volumes,omitempty" binding:"dive"`
+	InitContainers                []Container            `json:"initContainers,omitempty" binding:"dive"`
+	Containers                    []Container            `json:"containers" binding:"dive"`
+	RestartPolicy                 RestartPolicy          `json:"restartPolicy,omitempty" binding:"omitempty,oneof=Always OnFailure Never"`
+	ActiveDeadlineSeconds         *int64                 `json:"activeDeadlineSeconds,omitempty" binding:"omitempty,min=1"`
+	TerminationGracePeriodSeconds *int64                 `json:"terminationGracePeriodSeconds,omitempty" binding:"omitempty,min=0"`
+	NodeSelector                  map[string]string      `json:"nodeSelector,omitempty" binding:"omitempty,labels"`
+	ServiceAccountName            string                 `json:"serviceAccountName,omitempty" binding:"omitempty,dns1123subdomain"`
+	NodeName                      string                 `json:"nodeName,omitempty"`
+	SecurityContext               *PodSecurityContext    `json:"securityContext,omitempty"`
+	ImagePullSecrets              []LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	Affinity                      *Affinity              `json:"affinity,omitempty"`
+	Tolerations                   []Toleration           `json:"tolerations,omitempty"`
+}
+
+// RestartPolicy describes how the containers of the pod are restarted: Always, OnFailure or Never.
+// Pods of deployments only allow Always.
+type RestartPolicy string
+
+// PodSecurityContext holds the security configuration applied to every container of the pod.
+type PodSecurityContext struct {
+	RunAsUser    *int64 `json:"runAsUser,omitempty" binding:"omitempty,min=0"`
+	RunAsGroup   *int64 `json:"runAsGroup,omitempty" binding:"omitempty,min=0"`
+	RunAsNonRoot *bool  `json:"runAsNonRoot,omitempty"`
+	// A group owning the volumes of the pod, added to the groups of every container.
+	FSGroup            *int64          `json:"fsGroup,omitempty" binding:"omitempty,min=0"`
+	SupplementalGroups []int64         `json:"supplementalGroups,omitempty"`
+	SeccompProfile     *SeccompProfile `json:"seccompProfile,omitempty"`
+	Sysctls            []Sysctl        `json:"sysctls,omitempty"`
+}
+
+// Sysctl defines a kernel parameter to be set.
+type Sysctl struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Affinity is a group of affinity scheduling rules.
+type Affinity struct {
+	// Describes node affinity scheduling rules for the pod.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty"`
+	// Describes pod affinity scheduling rules, like co-locating this pod in the same zone as some other pods.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
+	// Describes pod anti-affinity scheduling rules, like avoiding this pod on the nodes of some other pods.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+}
+
+// NodeAffinity is a group of node affinity scheduling rules.
+type NodeAffinity struct {
+	// The pod is not scheduled onto a node not meeting these requirements.
+	RequiredDuringSchedulingIgnoredDuringExecution *NodeSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers the nodes meeting these requirements, by the sum of their weights.
+	PreferredDuringSchedulingIgnoredDuringExecution []PreferredSchedulingTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" binding:"dive"`
+}
+
+// NodeSelector is the union of the results of one or more queries over nodes.
+type NodeSelector struct {
+	NodeSelectorTerms []NodeSelectorTerm `json:"nodeSelectorTerms" binding:"dive"`
+}
+
+// NodeSelectorTerm is the intersection of its requirements over the labels and fields of nodes.
+type NodeSelectorTerm struct {
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions,omitempty" binding:"dive"`
+	MatchFields      []NodeSelectorRequirement `json:"matchFields,omitempty" binding:"dive"`
+}
+
+// NodeSelectorOperator is the set of operators that can be used in a node selector requirement.
+type NodeSelectorOperator string
+
+// NodeSelectorRequirement is a selector that contains values, a key, and an operator that relates the key and values.
+type NodeSelectorRequirement struct {
+	Key      string               `json:"key" binding:"required"`
+	Operator NodeSelectorOperator `json:"operator" binding:"oneof=In NotIn Exists DoesNotExist Gt Lt"`
+	Values   []string             `json:"values,omitempty"`
+}
+
+// PreferredSchedulingTerm is a node selector term weighted from 1 to 100.
+type PreferredSchedulingTerm struct {
+	Weight     int32            `json:"weight" binding:"min=1,max=100"`
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// PodAffinity is a group of inter pod affinity scheduling rules.
+type PodAffinity struct {
+	RequiredDuringSchedulingIgnoredDuringExecution  []PodAffinityTerm         `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty" binding:"dive"`
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" binding:"dive"`
+}
+
+// PodAntiAffinity is a group of inter pod anti affinity scheduling rules.
+type PodAntiAffinity struct {
+	RequiredDuringSchedulingIgnoredDuringExecution  []PodAffinityTerm         `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty" binding:"dive"`
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty" binding:"dive"`
+}
+
+// PodAffinityTerm selects the pods this pod should be co-located (or not) with, in the same
+// topology domain, like the same node or zone.
+type PodAffinityTerm struct {
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+	// The namespaces of the selected pods, the namespace of this pod when empty.
+	Namespaces        []string       `json:"namespaces,omitempty"`
+	NamespaceSelector *LabelSelector `json:"namespaceSelector,omitempty"`
+	// The label of the nodes defining the topology domain, like topology.kubernetes.io/zone.
+	TopologyKey string `json:"topologyKey" binding:"required,labelkey"`
+}
+
+// WeightedPodAffinityTerm is a pod affinity term weighted from 1 to 100.
+type WeightedPodAffinityTerm struct {
+	Weight          int32           `json:"weight" binding:"min=1,max=100"`
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm"`
 }
 
 type ConditionStatus string
@@ -39,15 +146,74 @@ type (
 	PodPhase string
 
 	PodStatus struct {
-		Phase             PodPhase       `json:"phase,omitempty"`
-		Message           string         `json:"message,omitempty"`
-		Reason            string         `json:"reason,omitempty"`
-		NominatedNodeName string         `json:"nominatedNodeName,omitempty"`
-		HostIP            string         `json:"hostIP,omitempty"`
-		Conditions        []PodCondition `json:"conditions,omitempty"`
+		Phase             PodPhase `json:"phase,omitempty"`
+		Message           string   `json:"message,omitempty"`
+		Reason            string   `json:"reason,omitempty"`
+		NominatedNodeName string   `json:"nominatedNodeName,omitempty"`
+		HostIP            string   `json:"hostIP,omitempty"`
+		PodIP             string   `json:"podIP,omitempty"`
+		PodIPs            []PodIP  `json:"podIPs,omitempty"`
+		// StartTime is when the pod was acknowledged by the kubelet, before its images were pulled.
+		StartTime             *time.Time        `json:"startTime,omitempty"`
+		Conditions            []PodCondition    `json:"conditions,omitempty"`
+		InitContainerStatuses []ContainerStatus `json:"initContainerStatuses,omitempty"`
+		ContainerStatuses     []ContainerStatus `json:"containerStatuses,omitempty"`
+		QOSClass              PodQOSClass       `json:"qosClass,omitempty"`
 	}
 )
 
+// PodIP is an IP address allocated to the pod, one per IP family.
+type PodIP struct {
+	IP string `json:"ip"`
+}
+
+// PodQOSClass is the quality of service class of the pod: Guaranteed, Burstable or BestEffort.
+type PodQOSClass string
+
+// ContainerStatus is the state of a container of the pod, as reported by the kubelet.
+type ContainerStatus struct {
+	Name  string         `json:"name"`
+	State ContainerState `json:"state,omitempty"`
+	// LastTerminationState is the last termination of the container, like an OOMKilled reason
+	// for a container restarted after running out of memory.
+	LastTerminationState ContainerState `json:"lastState,omitempty"`
+	Ready                bool           `json:"ready"`
+	RestartCount         int32          `json:"restartCount"`
+	Image                string         `json:"image"`
+	ImageID              string         `json:"imageID"`
+	ContainerID          string         `json:"containerID,omitempty"`
+	Started              *bool          `json:"started,omitempty"`
+}
+
+// ContainerState holds one of the possible states of a container, running when none is set.
+type ContainerState struct {
+	Waiting    *ContainerStateWaiting    `json:"waiting,omitempty"`
+	Running    *ContainerStateRunning    `json:"running,omitempty"`
+	Terminated *ContainerStateTerminated `json:"terminated,omitempty"`
+}
+
+// ContainerStateWaiting is a container waiting to run, like after a crash in CrashLoopBackOff.
+type ContainerStateWaiting struct {
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ContainerStateRunning is a running container.
+type ContainerStateRunning struct {
+	StartedAt time.Time `json:"startedAt,omitempty"`
+}
+
+// ContainerStateTerminated is a container which ran and exited.
+type ContainerStateTerminated struct {
+	ExitCode    int32     `json:"exitCode"`
+	Signal      int32     `json:"signal,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	StartedAt   time.Time `json:"startedAt,omitempty"`
+	FinishedAt  time.Time `json:"finishedAt,omitempty"`
+	ContainerID string    `json:"containerID,omitempty"`
+}
+
 type (
 	PodConditionType string
 
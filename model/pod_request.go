@@ -18,15 +18,15 @@ type (
 	}
 
 	SpecRequest struct {
-		InitContainers                []ContainerRequest `json:"initContainers,omitempty"`
-		Containers                    []ContainerRequest `json:"containers"`
-		ActiveDeadlineSeconds         *int64             `json:"activeDeadlineSeconds,omitempty"`
-		TerminationGracePeriodSeconds *int64             `json:"terminationGracePeriodSeconds,omitempty"`
+		InitContainers                []ContainerRequest `json:"initContainers,omitempty" binding:"dive"`
+		Containers                    []ContainerRequest `json:"containers" binding:"dive"`
+		ActiveDeadlineSeconds         *int64             `json:"activeDeadlineSeconds,omitempty" binding:"omitempty,min=1"`
+		TerminationGracePeriodSeconds *int64             `json:"terminationGracePeriodSeconds,omitempty" binding:"omitempty,min=0"`
 		Tolerations                   []Toleration       `json:"tolerations,omitempty"` // allow it to be set to 1 if it was previously negative
 	}
 
 	ContainerRequest struct {
-		Name  string `json:"name"` // cannot changable
-		Image string `json:"image,omitempty"`
+		Name  string `json:"name" binding:"required,dns1123label"` // cannot changable
+		Image string `json:"image,omitempty" binding:"omitempty,image"`
 	}
 )
@@ -1,8 +1,30 @@
 package model
 
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
 // MiniPod is a Pod with only the information needed for the UI.
 type MiniPod struct {
 	MiniObjectMeta `json:"metadata,omitempty"`
+	Summary        PodSummary `json:"summary"`
+	// Usage is set when the list is sorted by usage, left out for the pods without metrics yet.
+	Usage *PodUsage `json:"usage,omitempty"`
+}
+
+// PodSummary is the state of a pod at a glance, as printed by kubectl get pods.
+type PodSummary struct {
+	// Ready is the number of ready containers over the number of containers, like 1/2.
+	Ready string `json:"ready"`
+	// Status is the phase of the pod, or the reason a container is not running, like CrashLoopBackOff.
+	Status string `json:"status"`
+	// Restarts is the number of restarts of the containers of the pod.
+	Restarts int32 `json:"restarts"`
+	// Age is the time since the pod was created, like 5m or 3d4h.
+	Age string `json:"age"`
 }
 
 // MiniPodList is a list of Pods with only the information needed for the UI.
@@ -15,12 +37,12 @@ type MiniPodList struct {
 func (rc *PodList) ConvertMini() MiniPodList {
 	return MiniPodList{
 		ListMeta: ListMeta(rc.ListMeta),
-		Items:    rc.convertPodsToMiniPods(),
+		Items:    rc.convertPodsToMiniPods(time.Now()),
 	}
 }
 
 // convertPodsToMiniPods converts a slice of Pod objects into a slice of MiniPod objects.
-func (rc *PodList) convertPodsToMiniPods() []MiniPod {
+func (rc *PodList) convertPodsToMiniPods(now time.Time) []MiniPod {
 	pods := make([]MiniPod, len(rc.Items))
 	for i, pod := range rc.Items {
 		pods[i] = MiniPod{
@@ -31,8 +53,104 @@ func (rc *PodList) convertPodsToMiniPods() []MiniPod {
 				GenerateName:      pod.GenerateName,
 				Namespace:         pod.Namespace,
 			},
+			Summary: pod.Summary(now),
 		}
 	}
 
 	return pods
 }
+
+// Summary computes the ready containers, restarts, age and status of the pod at the time now,
+// following the rules of kubectl get pods.
+func (rc *Pod) Summary(now time.Time) PodSummary {
+	status := string(rc.Status.Phase)
+	if rc.Status.Reason != "" {
+		status = rc.Status.Reason
+	}
+
+	var restarts int32
+	initializing := false
+	for i, container := range rc.Status.InitContainerStatuses {
+		restarts += container.RestartCount
+		state := container.State
+		switch {
+		case state.Terminated != nil && state.Terminated.ExitCode == 0:
+			continue
+		case state.Terminated != nil:
+			status = "Init:" + terminatedReason(state.Terminated)
+		case state.Waiting != nil && state.Waiting.Reason != "" && state.Waiting.Reason != "PodInitializing":
+			status = "Init:" + state.Waiting.Reason
+		default:
+			status = fmt.Sprintf("Init:%d/%d", i, len(rc.Spec.InitContainers))
+		}
+		initializing = true
+		break
+	}
+
+	var ready int
+	if !initializing {
+		hasRunning := false
+		for i := len(rc.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			container := rc.Status.ContainerStatuses[i]
+			restarts += container.RestartCount
+			state := container.State
+			switch {
+			case state.Waiting != nil && state.Waiting.Reason != "":
+				status = state.Waiting.Reason
+			case state.Terminated != nil:
+				status = terminatedReason(state.Terminated)
+			case container.Ready && state.Running != nil:
+				hasRunning = true
+				ready++
+			}
+		}
+
+		// a completed container does not make the pod completed while another one still runs
+		if status == "Completed" && hasRunning {
+			status = "NotReady"
+			if rc.isReady() {
+				status = "Running"
+			}
+		}
+	}
+
+	if rc.DeletionTimestamp != nil && !rc.DeletionTimestamp.IsZero() {
+		status = "Terminating"
+	}
+
+	age := "<unknown>"
+	if !rc.CreationTimestamp.IsZero() {
+		age = duration.HumanDuration(now.Sub(rc.CreationTimestamp))
+	}
+
+	return PodSummary{
+		Ready:    fmt.Sprintf("%d/%d", ready, len(rc.Spec.Containers)),
+		Status:   status,
+		Restarts: restarts,
+		Age:      age,
+	}
+}
+
+// isReady returns whether the Ready condition of the pod is true.
+func (rc *Pod) isReady() bool {
+	for _, condition := range rc.Status.Conditions {
+		if condition.Type == "Ready" {
+			return condition.Status == "True"
+		}
+	}
+
+	return false
+}
+
+// terminatedReason returns the reason the container terminated, or its signal or exit code when
+// the runtime gave no reason.
+func terminatedReason(terminated *ContainerStateTerminated) string {
+	switch {
+	case terminated.Reason != "":
+		return terminated.Reason
+	case terminated.Signal != 0:
+		return fmt.Sprintf("Signal:%d", terminated.Signal)
+	default:
+		return fmt.Sprintf("ExitCode:%d", terminated.ExitCode)
+	}
+}
@@ -29,7 +29,7 @@ type Owner struct {
 
 type UserRequest struct {
 	Username string `json:"username" binding:"required"`
-	Email    string `json:"email" binding:"required"`
+	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
 	RoleID   uint   `json:"role_id" binding:"required"`
 }
@@ -0,0 +1,160 @@
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/pmezard/go-difflib/difflib"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// Diff compares the live object with the result of a change, either may be nil when the change
+// creates or deletes the object. The objects are compared as they are serialized in the responses.
+func Diff(live, result interface{}) (*model.Diff, error) {
+	liveContent, err := toContent(live)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the live object: %w", err)
+	}
+
+	resultContent, err := toContent(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the result: %w", err)
+	}
+
+	unified, err := unifiedDiff(liveContent, resultContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Diff{
+		Live:    liveContent,
+		Result:  resultContent,
+		Changes: diffValues("", liveContent, resultContent, make([]model.FieldChange, 0)),
+		Unified: unified,
+	}, nil
+}
+
+// toContent converts the object to its JSON representation, nil for nil objects.
+func toContent(object interface{}) (interface{}, error) {
+	if object == nil {
+		return nil, nil
+	}
+	if value := reflect.ValueOf(object); value.Kind() == reflect.Pointer && value.IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var content interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func diffValues(path string, from, to interface{}, changes []model.FieldChange) []model.FieldChange {
+	switch {
+	case from == nil && to == nil:
+		return changes
+	case from == nil:
+		return append(changes, model.FieldChange{Path: path, Operation: model.DiffOperationAdd, To: to})
+	case to == nil:
+		return append(changes, model.FieldChange{Path: path, Operation: model.DiffOperationRemove, From: from})
+	}
+
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := make([]string, 0, len(fromMap)+len(toMap))
+		for k := range fromMap {
+			keys = append(keys, k)
+		}
+		for k := range toMap {
+			if _, ok := fromMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			changes = diffValues(fieldPath(path, k), fromMap[k], toMap[k], changes)
+		}
+
+		return changes
+	}
+
+	fromSlice, fromIsSlice := from.([]interface{})
+	toSlice, toIsSlice := to.([]interface{})
+	if fromIsSlice && toIsSlice {
+		for i := 0; i < max(len(fromSlice), len(toSlice)); i++ {
+			var fromItem, toItem interface{}
+			if i < len(fromSlice) {
+				fromItem = fromSlice[i]
+			}
+			if i < len(toSlice) {
+				toItem = toSlice[i]
+			}
+
+			changes = diffValues(path+"["+strconv.Itoa(i)+"]", fromItem, toItem, changes)
+		}
+
+		return changes
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return changes
+	}
+
+	return append(changes, model.FieldChange{Path: path, Operation: model.DiffOperationReplace, From: from, To: to})
+}
+
+func fieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+// unifiedDiff returns the unified diff of the YAML of the contents.
+func unifiedDiff(from, to interface{}) (string, error) {
+	liveYAML, err := toYAML(from)
+	if err != nil {
+		return "", err
+	}
+
+	resultYAML, err := toYAML(to)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(resultYAML),
+		FromFile: "live",
+		ToFile:   "result",
+		Context:  3,
+	})
+}
+
+func toYAML(content interface{}) (string, error) {
+	if content == nil {
+		return "", nil
+	}
+
+	data, err := sigsyaml.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode to YAML: %w", err)
+	}
+
+	return string(data), nil
+}
@@ -5,19 +5,76 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
-	"github.com/spf13/viper"
+	"github.com/fleimkeipa/kubernetes-api/config"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-func NewKubernetesClient() (*kubernetes.Clientset, error) {
+// Clientset is the clientset of a cluster along with its dynamic client, serving the objects of any kind.
+type Clientset struct {
+	kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+// NewClientset builds the clientset and the dynamic client of the cluster of config.
+func NewClientset(config *rest.Config) (*Clientset, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Clientset{
+		Interface: clientset,
+		dynamic:   dynamicClient,
+	}, nil
+}
+
+func (rc *Clientset) Dynamic() dynamic.Interface {
+	return rc.dynamic
+}
+
+// PodMetricsResource is the resource of the pod metrics of the metrics API, served by metrics-server.
+var PodMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// DynamicClientFor returns the dynamic client of the cluster of client. The one of a fake cluster
+// serves the objects of its clientset.
+func DynamicClientFor(client kubernetes.Interface) (dynamic.Interface, error) {
+	switch client := client.(type) {
+	case *Clientset:
+		return client.Dynamic(), nil
+	case *fake.Clientset:
+		return newFakeDynamicClient(client), nil
+	}
+
+	return nil, fmt.Errorf("no dynamic client for clients of type %T", client)
+}
+
+// kubeconfigFlag lets the user override the kubeconfig path of the dev stage
+var kubeconfigFlag = flag.String("kubeconfig", "", "absolute path to the kubeconfig file, ~/.kube/config by default")
+
+func NewKubernetesClient() (kubernetes.Interface, error) {
+	// Determine if we are on local, cluster or running against a fake cluster
+	cfg := config.Get()
+	if cfg.Stage == "fake" {
+		return NewFakeKubernetesClient(cfg.Fake.FixturesDir)
+	}
+
 	var config *rest.Config
 	var err error
 
-	// Determine if we are on local or cluster
-	if stage := viper.GetString("stage"); stage == "prod" {
+	if cfg.Stage == "prod" {
 		config, err = getConfigOnCluster()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get config for cluster stage: %w", err)
@@ -29,8 +86,12 @@ func NewKubernetesClient() (*kubernetes.Clientset, error) {
 		}
 	}
 
+	// Record the metrics and traces of the requests sent to the API server
+	config.Wrap(KubeMetricsTransport)
+	config.Wrap(KubeTracingTransport)
+
 	// Create the clientset
-	clientset, err := kubernetes.NewForConfig(config)
+	clientset, err := NewClientset(config)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +120,12 @@ func getConfigOnLocal() (*rest.Config, error) {
 	}
 
 	// Let the user override the path with the --kubeconfig flag
-	kubeconfig := flag.String("kubeconfig", kubeconfigPath, "absolute path to the kubeconfig file")
-	flag.Parse()
+	if *kubeconfigFlag != "" {
+		kubeconfigPath = *kubeconfigFlag
+	}
 
 	// Build the client configuration
-	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
 	if err != nil {
 		return nil, err
 	}
@@ -79,3 +141,82 @@ func getConfigOnCluster() (*rest.Config, error) {
 
 	return config, nil
 }
+
+// NewKubernetesClientFromKubeconfig builds a client from raw kubeconfig content.
+// An empty context uses the current context of the kubeconfig.
+func NewKubernetesClientFromKubeconfig(kubeconfig []byte, context string) (kubernetes.Interface, error) {
+	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: context}
+
+	config, err := clientcmd.NewDefaultClientConfig(rawConfig, overrides).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build config from kubeconfig: %w", err)
+	}
+
+	config.Wrap(KubeMetricsTransport)
+	config.Wrap(KubeTracingTransport)
+
+	clientset, err := NewClientset(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientset, nil
+}
+
+// KubeconfigExternalReferences returns the fields of a kubeconfig reading local files or running
+// plugins, sorted. A kubeconfig registered through the API must embed its credentials with the
+// *-data and token fields instead, the files and commands would be those of the API server.
+func KubeconfigExternalReferences(kubeconfig []byte) ([]string, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	var fields []string
+	for name, cluster := range config.Clusters {
+		if cluster.CertificateAuthority != "" {
+			fields = append(fields, fmt.Sprintf("clusters[%s].certificate-authority", name))
+		}
+	}
+
+	for name, user := range config.AuthInfos {
+		if user.ClientCertificate != "" {
+			fields = append(fields, fmt.Sprintf("users[%s].client-certificate", name))
+		}
+		if user.ClientKey != "" {
+			fields = append(fields, fmt.Sprintf("users[%s].client-key", name))
+		}
+		if user.TokenFile != "" {
+			fields = append(fields, fmt.Sprintf("users[%s].tokenFile", name))
+		}
+		if user.AuthProvider != nil {
+			fields = append(fields, fmt.Sprintf("users[%s].auth-provider", name))
+		}
+		if user.Exec != nil {
+			fields = append(fields, fmt.Sprintf("users[%s].exec", name))
+		}
+	}
+	sort.Strings(fields)
+
+	return fields, nil
+}
+
+// NewKubernetesClientFromFile builds a client from the kubeconfig file at path.
+func NewKubernetesClientFromFile(path, context string) (kubernetes.Interface, error) {
+	kubeconfig, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+
+	return NewKubernetesClientFromKubeconfig(kubeconfig, context)
+}
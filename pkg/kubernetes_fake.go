@@ -0,0 +1,408 @@
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/applyconfigurations"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// NewFakeKubernetesClient returns an in-memory cluster seeded with the objects of every
+// YAML file in fixturesDir. An empty fixturesDir starts an empty cluster.
+func NewFakeKubernetesClient(fixturesDir string) (kubernetes.Interface, error) {
+	objects := make([]runtime.Object, 0)
+	if fixturesDir != "" {
+		var err error
+		objects, err = LoadFixtures(fixturesDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewFakeKubernetesClientWithObjects(objects...), nil
+}
+
+// NewFakeKubernetesClientWithObjects returns an in-memory cluster holding the objects.
+// Like the API server, it assigns a UID and a creation timestamp to the objects missing them.
+func NewFakeKubernetesClientWithObjects(objects ...runtime.Object) kubernetes.Interface {
+	for _, v := range objects {
+		setServerFields(v)
+	}
+
+	client := fake.NewClientset(objects...)
+	client.Resources = fakeAPIResources
+	client.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if !isDryRunAction(action) {
+			return false, nil, nil
+		}
+
+		return dryRunReaction(client.Tracker(), action)
+	})
+	client.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if create, ok := action.(k8stesting.CreateAction); ok {
+			setServerFields(create.GetObject())
+		}
+
+		// let the default reactor store the object
+		return false, nil, nil
+	})
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create, ok := action.(k8stesting.CreateAction)
+		if !ok || create.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+
+		eviction, ok := create.GetObject().(*policyv1.Eviction)
+		if !ok {
+			return true, nil, apierrors.NewBadRequest("the eviction is not a policy/v1 Eviction")
+		}
+
+		return true, nil, evictReaction(client.Tracker(), eviction)
+	})
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(k8stesting.PatchAction)
+		if !ok {
+			return false, nil, nil
+		}
+
+		// let the default reactor patch the object unless it conflicts
+		err := patchConflict(client.Tracker(), patch)
+		return err != nil, nil, err
+	})
+	// like a cluster without metrics-server, until ServeFakePodMetrics
+	client.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetResource().Group != PodMetricsResource.Group {
+			return false, nil, nil
+		}
+
+		return true, nil, apierrors.NewGenericServerResponse(http.StatusNotFound, action.GetVerb(), PodMetricsResource.GroupResource(), "", "the server could not find the requested resource", 0, false)
+	})
+
+	return client
+}
+
+// evictReaction deletes the evicted pod like the API server, unless a PodDisruptionBudget selecting it
+// allows no disruption. The fake cluster never updates the disruptions allowed by the budgets.
+func evictReaction(tracker k8stesting.ObjectTracker, eviction *policyv1.Eviction) error {
+	podsResource := corev1.SchemeGroupVersion.WithResource("pods")
+	object, err := tracker.Get(podsResource, eviction.Namespace, eviction.Name)
+	if err != nil {
+		return err
+	}
+	pod := object.(*corev1.Pod)
+
+	budgets, err := tracker.List(policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"), policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"), pod.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, budget := range budgets.(*policyv1.PodDisruptionBudgetList).Items {
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		if budget.Status.DisruptionsAllowed < 1 {
+			return apierrors.NewTooManyRequests(fmt.Sprintf("Cannot evict pod as it would violate the pod's disruption budget %s.", budget.Name), 10)
+		}
+	}
+
+	if eviction.DeleteOptions != nil && len(eviction.DeleteOptions.DryRun) > 0 {
+		return nil
+	}
+
+	return tracker.Delete(podsResource, pod.Namespace, pod.Name)
+}
+
+// patchConflict refuses the patch setting a resourceVersion other than the one of the stored object
+// with a conflict, like the API server. The fake cluster never changes the resourceVersion of the objects.
+func patchConflict(tracker k8stesting.ObjectTracker, action k8stesting.PatchAction) error {
+	data := action.GetPatch()
+	if action.GetPatchType() == types.ApplyPatchType {
+		jsonData, err := sigsyaml.YAMLToJSON(data)
+		if err != nil {
+			return nil
+		}
+		data = jsonData
+	}
+
+	var patch struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+	}
+	// JSON patches are lists, they don't set the resourceVersion
+	if err := json.Unmarshal(data, &patch); err != nil || patch.Metadata.ResourceVersion == "" {
+		return nil
+	}
+
+	object, err := tracker.Get(action.GetResource(), action.GetNamespace(), action.GetName())
+	if err != nil {
+		return nil
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil || accessor.GetResourceVersion() == patch.Metadata.ResourceVersion {
+		return nil
+	}
+
+	return apierrors.NewConflict(action.GetResource().GroupResource(), action.GetName(), errors.New("the object has been modified; please apply your changes to the latest version and try again"))
+}
+
+// ServeFakePodMetrics makes the fake cluster serve the metrics API, as if metrics-server was installed,
+// reporting the pod metrics. They are metrics.k8s.io/v1beta1 PodMetrics objects.
+func ServeFakePodMetrics(client kubernetes.Interface, metrics ...*unstructured.Unstructured) error {
+	fakeClient, ok := client.(*fake.Clientset)
+	if !ok {
+		return fmt.Errorf("no fake metrics API for clients of type %T", client)
+	}
+
+	fakeClient.Resources = append(fakeClient.Resources, &metav1.APIResourceList{
+		GroupVersion: PodMetricsResource.GroupVersion().String(),
+		APIResources: []metav1.APIResource{
+			{Name: PodMetricsResource.Resource, Kind: "PodMetrics", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	fakeClient.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetResource() != PodMetricsResource {
+			return false, nil, nil
+		}
+
+		switch action := action.(type) {
+		case k8stesting.GetAction:
+			for _, v := range metrics {
+				if v.GetNamespace() == action.GetNamespace() && v.GetName() == action.GetName() {
+					return true, v.DeepCopy(), nil
+				}
+			}
+
+			return true, nil, apierrors.NewNotFound(PodMetricsResource.GroupResource(), action.GetName())
+		case k8stesting.ListAction:
+			list := &unstructured.UnstructuredList{Object: map[string]interface{}{
+				"apiVersion": PodMetricsResource.GroupVersion().String(),
+				"kind":       "PodMetricsList",
+			}}
+			for _, v := range metrics {
+				if action.GetNamespace() == "" || v.GetNamespace() == action.GetNamespace() {
+					list.Items = append(list.Items, *v.DeepCopy())
+				}
+			}
+
+			return true, list, nil
+		}
+
+		return true, nil, apierrors.NewMethodNotSupported(PodMetricsResource.GroupResource(), action.GetVerb())
+	})
+
+	return nil
+}
+
+// fakeFieldManager manages the fields applied through the dynamic client of a fake cluster,
+// which drops the options of the patches.
+const fakeFieldManager = "kubernetes-api"
+
+// fakeAPIResources are the resources the discovery of a fake cluster reports.
+var fakeAPIResources = []*metav1.APIResourceList{
+	{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace", Namespaced: false, Verbs: fakeVerbs},
+			{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "services", Kind: "Service", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "serviceaccounts", Kind: "ServiceAccount", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "events", Kind: "Event", Namespaced: true, Verbs: fakeVerbs},
+		},
+	},
+	{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "statefulsets", Kind: "StatefulSet", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "daemonsets", Kind: "DaemonSet", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "replicasets", Kind: "ReplicaSet", Namespaced: true, Verbs: fakeVerbs},
+		},
+	},
+	{
+		GroupVersion: "batch/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "jobs", Kind: "Job", Namespaced: true, Verbs: fakeVerbs},
+			{Name: "cronjobs", Kind: "CronJob", Namespaced: true, Verbs: fakeVerbs},
+		},
+	},
+}
+
+var fakeVerbs = metav1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"}
+
+// newFakeDynamicClient returns a dynamic client serving the objects of the fake clientset.
+func newFakeDynamicClient(client *fake.Clientset) dynamic.Interface {
+	listKinds := map[schema.GroupVersionResource]string{PodMetricsResource: "PodMetricsList"}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme, listKinds)
+	dynamicClient.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if patch, ok := action.(k8stesting.PatchActionImpl); ok && patch.GetPatchType() == types.ApplyPatchType && patch.PatchOptions.FieldManager == "" {
+			patch.PatchOptions.FieldManager = fakeFieldManager
+			action = patch
+		}
+
+		object, err := client.Invokes(action, nil)
+		return true, object, err
+	})
+
+	return dynamicClient
+}
+
+// isDryRunAction tells if the action asks for a dry run, which the trackers of the fake clientsets ignore.
+func isDryRunAction(action k8stesting.Action) bool {
+	switch action := action.(type) {
+	case k8stesting.CreateActionImpl:
+		return len(action.CreateOptions.DryRun) > 0
+	case k8stesting.UpdateActionImpl:
+		return len(action.UpdateOptions.DryRun) > 0
+	case k8stesting.PatchActionImpl:
+		return len(action.PatchOptions.DryRun) > 0
+	case k8stesting.DeleteActionImpl:
+		return len(action.DeleteOptions.DryRun) > 0
+	case k8stesting.DeleteCollectionActionImpl:
+		return len(action.DeleteOptions.DryRun) > 0
+	}
+
+	return false
+}
+
+// dryRunReaction runs the action against a scratch copy of the object it targets, so the result
+// is computed like a dry run of the API server while the cluster is left unchanged.
+func dryRunReaction(tracker k8stesting.ObjectTracker, action k8stesting.Action) (bool, runtime.Object, error) {
+	if _, ok := action.(k8stesting.DeleteCollectionActionImpl); ok {
+		return true, nil, nil
+	}
+
+	scratch := k8stesting.NewFieldManagedObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder(), applyconfigurations.NewTypeConverter(scheme.Scheme))
+
+	var name string
+	switch action := action.(type) {
+	case k8stesting.CreateActionImpl:
+		name = objectName(action.GetObject())
+	case k8stesting.UpdateActionImpl:
+		name = objectName(action.GetObject())
+	case k8stesting.PatchActionImpl:
+		name = action.GetName()
+	case k8stesting.DeleteActionImpl:
+		name = action.GetName()
+	}
+
+	existing, err := tracker.Get(action.GetResource(), action.GetNamespace(), name)
+	if err == nil {
+		if err := scratch.Create(action.GetResource(), existing, action.GetNamespace()); err != nil {
+			return true, nil, err
+		}
+	}
+
+	return k8stesting.ObjectReaction(scratch)(action)
+}
+
+func objectName(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return ""
+	}
+
+	return accessor.GetName()
+}
+
+// LoadFixtures decodes every kubernetes object of the YAML files in dir, in file name order.
+func LoadFixtures(dir string) ([]runtime.Object, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.y*ml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	objects := make([]runtime.Object, 0)
+	for _, file := range files {
+		fileObjects, err := loadFixtureFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fixture %s: %w", file, err)
+		}
+
+		objects = append(objects, fileObjects...)
+	}
+
+	return objects, nil
+}
+
+func loadFixtureFile(file string) ([]runtime.Object, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := scheme.Codecs.UniversalDeserializer()
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+
+	objects := make([]runtime.Object, 0)
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		object, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+func setServerFields(object runtime.Object) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return
+	}
+
+	if accessor.GetUID() == "" {
+		accessor.SetUID(uuid.NewUUID())
+	}
+
+	if creationTimestamp := accessor.GetCreationTimestamp(); creationTimestamp.IsZero() {
+		// the API server keeps timestamps to the second
+		accessor.SetCreationTimestamp(metav1.Now().Rfc3339Copy())
+	}
+}
@@ -0,0 +1,151 @@
+package pkg
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// DecodeManifests decodes the objects of multi-document YAML or JSON manifests. Lists, either
+// a JSON array or a List object, are flattened into their items. Every object needs an apiVersion,
+// a kind and a name.
+func DecodeManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+
+	objects := make([]*unstructured.Unstructured, 0)
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %d: %w", len(objects)+1, err)
+		}
+
+		doc = bytes.TrimSpace(doc)
+		if len(doc) == 0 {
+			continue
+		}
+
+		docObjects, err := decodeManifest(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, docObjects...)
+	}
+
+	for i, v := range objects {
+		if v.GetAPIVersion() == "" || v.GetKind() == "" || v.GetName() == "" {
+			return nil, fmt.Errorf("object %d must have an apiVersion, a kind and a metadata.name", i+1)
+		}
+	}
+
+	return objects, nil
+}
+
+func decodeManifest(doc []byte) ([]*unstructured.Unstructured, error) {
+	if doc[0] == '[' {
+		var items []map[string]interface{}
+		if err := sigsyaml.Unmarshal(doc, &items); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+
+		objects := make([]*unstructured.Unstructured, 0, len(items))
+		for _, v := range items {
+			objects = append(objects, &unstructured.Unstructured{Object: v})
+		}
+
+		return objects, nil
+	}
+
+	object := &unstructured.Unstructured{}
+	if err := sigsyaml.Unmarshal(doc, &object.Object); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if object.Object == nil {
+		return nil, nil
+	}
+
+	if !object.IsList() {
+		return []*unstructured.Unstructured{object}, nil
+	}
+
+	list, err := object.ToList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode list: %w", err)
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		objects = append(objects, &list.Items[i])
+	}
+
+	return objects, nil
+}
+
+// EncodeManifests encodes the objects as multi-document YAML.
+func EncodeManifests(objects []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, v := range objects {
+		data, err := sigsyaml.Marshal(v.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s %s: %w", v.GetKind(), v.GetName(), err)
+		}
+
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ArchiveManifests returns a tar.gz archive holding a YAML file for each object under dir. The files are
+// numbered in the order of the objects, so applying the directory keeps their order.
+func ArchiveManifests(dir string, objects []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for i, v := range objects {
+		data, err := sigsyaml.Marshal(v.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s %s: %w", v.GetKind(), v.GetName(), err)
+		}
+
+		header := &tar.Header{
+			Name:    path.Join(dir, fmt.Sprintf("%02d-%s-%s.yaml", i, strings.ToLower(v.GetKind()), v.GetName())),
+			Mode:    0o644,
+			Size:    int64(len(data)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
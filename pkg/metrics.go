@@ -0,0 +1,274 @@
+package pkg
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "kubernetes_api"
+
+// MetricsRegistry holds the metrics exposed on /metrics.
+var MetricsRegistry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled, by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the HTTP requests, by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	kubeRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "kubernetes_requests_total",
+		Help:      "Requests sent to the Kubernetes API servers, by verb, resource and status code, 0 when no response was received.",
+	}, []string{"verb", "resource", "code"})
+
+	kubeRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "kubernetes_request_duration_seconds",
+		Help:      "Latency of the requests sent to the Kubernetes API servers, by verb and resource.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verb", "resource"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Latency of the database queries, by statement and whether they failed.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"statement", "failed"})
+
+	eventWriteFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "event_write_failures_total",
+		Help:      "Audit events that could not be recorded, by category.",
+	}, []string{"category"})
+
+	loginsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "logins_total",
+		Help:      "Login attempts, by method and result.",
+	}, []string{"method", "result"})
+)
+
+func init() {
+	MetricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		kubeRequestsTotal,
+		kubeRequestDuration,
+		dbQueryDuration,
+		eventWriteFailuresTotal,
+		loginsTotal,
+	)
+}
+
+// MetricsHandler serves the metrics in the Prometheus text format.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(MetricsRegistry, promhttp.HandlerOpts{})
+}
+
+// HTTPMetrics records the count and latency of the requests by route template,
+// so /pods/web-0 and /pods/web-1 are both counted as /pods/:id.
+func HTTPMetrics(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		err := next(c)
+
+		status := c.Response().Status
+		if err != nil && !c.Response().Committed {
+			status = http.StatusInternalServerError
+
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		labels := prometheus.Labels{
+			"method": c.Request().Method,
+			"route":  route,
+			"status": strconv.Itoa(status),
+		}
+		httpRequestsTotal.With(labels).Inc()
+		httpRequestDuration.With(labels).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// LoginMetrics counts the attempts of the login route it wraps, successful when answered with 200.
+func LoginMetrics(method string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+
+			result := "failure"
+			if err == nil && c.Response().Status == http.StatusOK {
+				result = "success"
+			}
+			loginsTotal.WithLabelValues(method, result).Inc()
+
+			return err
+		}
+	}
+}
+
+// ObserveEventWriteFailure counts an audit event that could not be recorded.
+func ObserveEventWriteFailure(category string) {
+	eventWriteFailuresTotal.WithLabelValues(category).Inc()
+}
+
+// KubeMetricsTransport wraps the transport of a Kubernetes client to record
+// the count and latency of its requests. It is meant for rest.Config.Wrap.
+func KubeMetricsTransport(rt http.RoundTripper) http.RoundTripper {
+	return &kubeMetricsRoundTripper{next: rt}
+}
+
+type kubeMetricsRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rc *kubeMetricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	res, err := rc.next.RoundTrip(req)
+
+	verb := KubeRequestVerb(req.Method, req.URL.Path, req.URL.Query().Get("watch"))
+	resource := KubeRequestResource(req.URL.Path)
+
+	code := "0"
+	if err == nil {
+		code = strconv.Itoa(res.StatusCode)
+	}
+
+	kubeRequestsTotal.WithLabelValues(verb, resource, code).Inc()
+	kubeRequestDuration.WithLabelValues(verb, resource).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+// KubeRequestResource returns the resource an API server path targets,
+// e.g. pods for /api/v1/namespaces/default/pods/web-0.
+func KubeRequestResource(path string) string {
+	resource, _ := parseKubePath(path)
+	return resource
+}
+
+// KubeRequestVerb returns the Kubernetes verb of a request, telling lists and watches from gets.
+func KubeRequestVerb(method, path, watch string) string {
+	_, named := parseKubePath(path)
+
+	switch method {
+	case http.MethodGet:
+		switch {
+		case watch == "true" || watch == "1":
+			return "watch"
+		case named:
+			return "get"
+		default:
+			return "list"
+		}
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if named {
+			return "delete"
+		}
+		return "deletecollection"
+	}
+
+	return strings.ToLower(method)
+}
+
+// parseKubePath returns the resource of an API server path and whether it names a single object.
+func parseKubePath(path string) (string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		// non resource paths like /version or /healthz
+		return parts[0], false
+	}
+
+	if len(parts) == 0 {
+		return "discovery", false
+	}
+
+	// namespaced resources are listed under /namespaces/<namespace>/
+	if parts[0] == "namespaces" && len(parts) >= 3 {
+		parts = parts[2:]
+	}
+
+	return parts[0], len(parts) > 1
+}
+
+// DBMetricsHook records the latency of the queries of a go-pg database.
+type DBMetricsHook struct{}
+
+type dbQueryStartKey struct{}
+
+func (rc DBMetricsHook) BeforeQuery(event *pg.QueryEvent) {
+	event.Data[dbQueryStartKey{}] = time.Now()
+}
+
+func (rc DBMetricsHook) AfterQuery(event *pg.QueryEvent) {
+	start, ok := event.Data[dbQueryStartKey{}].(time.Time)
+	if !ok {
+		return
+	}
+
+	statement := "other"
+	if query, err := event.UnformattedQuery(); err == nil {
+		statement = queryStatement(query)
+	}
+
+	failed := strconv.FormatBool(event.Error != nil && !errors.Is(event.Error, pg.ErrNoRows))
+	dbQueryDuration.WithLabelValues(statement, failed).Observe(time.Since(start).Seconds())
+}
+
+// queryStatement returns the kind of statement of a query, like select or insert.
+func queryStatement(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+
+	switch statement := strings.ToLower(fields[0]); statement {
+	case "select", "insert", "update", "delete", "with", "begin", "commit", "rollback", "create", "alter", "drop":
+		return statement
+	default:
+		return "other"
+	}
+}
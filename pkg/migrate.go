@@ -0,0 +1,251 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/go-pg/pg"
+)
+
+// migrationLockID is the advisory lock key serializing schema migrations across replicas.
+const migrationLockID = 7_301_031
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a numbered schema change along with the statements reverting it.
+type Migration struct {
+	Name    string
+	Up      string
+	Down    string
+	Version int64
+}
+
+// Migrator applies the migrations of a file system to the database.
+type Migrator struct {
+	db         *pg.DB
+	migrations []Migration
+}
+
+// NewMigrator loads the <version>_<name>.up.sql and .down.sql files of fsys.
+func NewMigrator(db *pg.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// LoadMigrations reads the migrations of fsys sorted by version.
+// Every version needs both an up and a down file.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		matches := migrationFileName.FindStringSubmatch(file.Name())
+		if matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", file.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, file.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", file.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d is used by %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, v := range byVersion {
+		if v.Up == "" || v.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", v.Version, v.Name)
+		}
+
+		migrations = append(migrations, *v)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies every pending migration and returns how many were applied.
+// Each migration runs in its own transaction holding the migration lock,
+// so replicas starting together apply it only once.
+func (rc *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	for _, migration := range rc.migrations {
+		applied := false
+
+		err := rc.inLock(ctx, func(tx *pg.Tx, versions map[int64]time.Time) error {
+			if _, ok := versions[migration.Version]; ok {
+				return nil
+			}
+
+			if _, err := tx.Exec(migration.Up); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, now())", migration.Version, migration.Name)
+			applied = err == nil
+			return err
+		})
+		if err != nil {
+			return count, fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		if applied {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// Down reverts the latest applied migrations, at most steps of them, and returns how many were reverted.
+func (rc *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	for count < steps {
+		reverted := false
+
+		err := rc.inLock(ctx, func(tx *pg.Tx, versions map[int64]time.Time) error {
+			if len(versions) == 0 {
+				return nil
+			}
+
+			latest := int64(-1)
+			for v := range versions {
+				latest = max(latest, v)
+			}
+
+			migration := rc.find(latest)
+			if migration == nil {
+				return fmt.Errorf("applied migration %d is unknown to this binary", latest)
+			}
+
+			if _, err := tx.Exec(migration.Down); err != nil {
+				return fmt.Errorf("failed to revert migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			_, err := tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version)
+			reverted = err == nil
+			return err
+		})
+		if err != nil {
+			return count, err
+		}
+
+		if !reverted {
+			break
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// Status lists every known migration and whether it has been applied.
+func (rc *Migrator) Status(ctx context.Context) ([]model.MigrationStatus, error) {
+	statuses := make([]model.MigrationStatus, 0, len(rc.migrations))
+
+	err := rc.inLock(ctx, func(tx *pg.Tx, versions map[int64]time.Time) error {
+		for _, v := range rc.migrations {
+			status := model.MigrationStatus{
+				Name:    v.Name,
+				Version: v.Version,
+			}
+
+			if appliedAt, ok := versions[v.Version]; ok {
+				status.AppliedAt = &appliedAt
+				status.Applied = true
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// inLock runs fn in a transaction holding the migration lock, with the applied versions.
+func (rc *Migrator) inLock(ctx context.Context, fn func(tx *pg.Tx, versions map[int64]time.Time) error) error {
+	return rc.db.WithContext(ctx).RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL
+		)`)
+		if err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+
+		var rows []struct {
+			AppliedAt time.Time
+			Version   int64
+		}
+		if _, err := tx.Query(&rows, "SELECT version, applied_at FROM schema_migrations"); err != nil {
+			return fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+
+		versions := make(map[int64]time.Time, len(rows))
+		for _, v := range rows {
+			versions[v.Version] = v.AppliedAt
+		}
+
+		return fn(tx, versions)
+	})
+}
+
+func (rc *Migrator) find(version int64) *Migration {
+	for i := range rc.migrations {
+		if rc.migrations[i].Version == version {
+			return &rc.migrations[i]
+		}
+	}
+
+	return nil
+}
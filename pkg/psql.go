@@ -2,57 +2,272 @@ package pkg
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/config"
+	"github.com/fleimkeipa/kubernetes-api/migrations"
 
 	"github.com/go-pg/pg"
-	"github.com/go-pg/pg/orm"
-	"github.com/spf13/viper"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
-func NewPSQLClient() *pg.DB {
-	// Determine if we are on local or cluster
-	addr := "localhost:5432"
-	if stage := viper.GetString("stage"); stage == "prod" {
-		addr = "postgres-service:5432"
+// SSL modes of the database connection, following libpq.
+const (
+	SSLModeDisable    = "disable"
+	SSLModeRequire    = "require"
+	SSLModeVerifyCA   = "verify-ca"
+	SSLModeVerifyFull = "verify-full"
+)
+
+// PSQLConfig holds the database connection settings.
+type PSQLConfig struct {
+	Host              string
+	Name              string
+	User              string
+	Password          string
+	PasswordFile      string
+	SSLMode           string
+	SSLRootCert       string
+	Port              int
+	PoolSize          int
+	MinIdleConns      int
+	MaxConnAge        time.Duration
+	IdleTimeout       time.Duration
+	PoolTimeout       time.Duration
+	StatementTimeout  time.Duration
+	ConnectAttempts   int
+	ConnectBackoff    time.Duration
+	ConnectMaxBackoff time.Duration
+}
+
+// LoadPSQLConfig returns the database settings of the loaded configuration.
+// The host defaults to the in-cluster service on the prod stage and to localhost otherwise.
+func LoadPSQLConfig() PSQLConfig {
+	cfg := config.Get()
+	db := cfg.Database
+
+	host := db.Host
+	if host == "" {
+		host = "localhost"
+		if cfg.Stage == "prod" {
+			host = "postgres-service"
+		}
+	}
+
+	return PSQLConfig{
+		Host:              host,
+		Port:              db.Port,
+		Name:              db.Name,
+		User:              db.User,
+		Password:          db.Password,
+		PasswordFile:      db.PasswordFile,
+		SSLMode:           db.SSLMode,
+		SSLRootCert:       db.SSLRootCert,
+		PoolSize:          db.Pool.Size,
+		MinIdleConns:      db.Pool.MinIdle,
+		MaxConnAge:        db.Pool.MaxConnAge,
+		IdleTimeout:       db.Pool.IdleTimeout,
+		PoolTimeout:       db.Pool.Timeout,
+		StatementTimeout:  db.StatementTimeout,
+		ConnectAttempts:   db.Connect.Attempts,
+		ConnectBackoff:    db.Connect.Backoff,
+		ConnectMaxBackoff: db.Connect.MaxBackoff,
+	}
+}
+
+// NewPSQLOptions converts the settings to go-pg options.
+// A password file, e.g. a mounted secret, takes precedence over the password.
+func NewPSQLOptions(cfg PSQLConfig) (*pg.Options, error) {
+	password := cfg.Password
+	if cfg.PasswordFile != "" {
+		content, err := os.ReadFile(cfg.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read database password file: %w", err)
+		}
+
+		password = strings.TrimRight(string(content), "\r\n")
+	}
+
+	tlsConfig, err := newPSQLTLSConfig(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	opts := pg.Options{
-		Database: "kubernetes-api",
-		User:     "postgres",
-		Password: "password",
-		Addr:     addr,
+		Addr:         net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Database:     cfg.Name,
+		User:         cfg.User,
+		Password:     password,
+		TLSConfig:    tlsConfig,
+		PoolSize:     cfg.PoolSize,
+		MinIdleConns: cfg.MinIdleConns,
+		MaxConnAge:   cfg.MaxConnAge,
+		IdleTimeout:  cfg.IdleTimeout,
+		PoolTimeout:  cfg.PoolTimeout,
 	}
-	db := pg.Connect(&opts)
 
-	if err := createSchema(db); err != nil {
-		log.Fatalf("Failed to create schema: %v", err)
+	if cfg.StatementTimeout > 0 {
+		timeout := cfg.StatementTimeout.Milliseconds()
+		opts.OnConnect = func(conn *pg.Conn) error {
+			_, err := conn.Exec("SET statement_timeout = ?", timeout)
+			return err
+		}
 	}
 
-	return db
+	return &opts, nil
 }
 
-func createSchema(db *pg.DB) error {
-	models := []interface{}{
-		(*model.Event)(nil),
-		(*model.User)(nil),
+func newPSQLTLSConfig(cfg PSQLConfig) (*tls.Config, error) {
+	switch cfg.SSLMode {
+	case "", SSLModeDisable:
+		return nil, nil
+	case SSLModeRequire:
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	case SSLModeVerifyCA, SSLModeVerifyFull:
+	default:
+		return nil, fmt.Errorf("invalid database sslmode %q", cfg.SSLMode)
 	}
 
-	for _, model := range models {
-		opts := &orm.CreateTableOptions{
-			IfNotExists: true,
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+
+	if cfg.SSLRootCert != "" {
+		pem, err := os.ReadFile(cfg.SSLRootCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read database root certificate: %w", err)
 		}
 
-		if err := db.Model(model).CreateTable(opts); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", cfg.SSLRootCert)
 		}
 	}
 
+	if cfg.SSLMode == SSLModeVerifyFull {
+		return &tls.Config{
+			RootCAs:    roots,
+			ServerName: cfg.Host,
+		}, nil
+	}
+
+	// verify-ca checks the chain but not the host name, which the standard verification can't skip alone
+	return &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("database server sent no certificate")
+			}
+
+			certs := make([]*x509.Certificate, 0, len(rawCerts))
+			for _, v := range rawCerts {
+				cert, err := x509.ParseCertificate(v)
+				if err != nil {
+					return err
+				}
+				certs = append(certs, cert)
+			}
+
+			intermediates := x509.NewCertPool()
+			for _, v := range certs[1:] {
+				intermediates.AddCert(v)
+			}
+
+			_, err := certs[0].Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+			})
+			return err
+		},
+	}, nil
+}
+
+// NewPSQLClient connects to PostgreSQL and, when database.auto_migrate is set, applies the pending migrations.
+func NewPSQLClient(ctx context.Context) (*pg.DB, error) {
+	db, err := ConnectPSQL(ctx, LoadPSQLConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Get().Database.AutoMigrate {
+		if err := migrate(ctx, db); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to migrate schema: %w", err)
+		}
+	}
+
+	return db, nil
+}
+
+// ConnectPSQL connects to PostgreSQL without touching the schema.
+// The connection is checked with a ping, retried with exponential backoff up to cfg.ConnectAttempts times.
+func ConnectPSQL(ctx context.Context, cfg PSQLConfig) (*pg.DB, error) {
+	opts, err := NewPSQLOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db := pg.Connect(opts)
+	db.AddQueryHook(DBMetricsHook{})
+	db.AddQueryHook(DBTracingHook{})
+
+	attempts := max(cfg.ConnectAttempts, 1)
+	backoff := cfg.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		_, err = db.WithContext(ctx).Exec("SELECT 1")
+		if err == nil {
+			return db, nil
+		}
+
+		if attempt == attempts {
+			break
+		}
+
+		log.Printf("Database %s is not reachable (attempt %d/%d), retrying in %s: %v", opts.Addr, attempt, attempts, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			db.Close()
+			return nil, fmt.Errorf("failed to connect to database %s: %w", opts.Addr, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if cfg.ConnectMaxBackoff > 0 {
+			backoff = min(backoff, cfg.ConnectMaxBackoff)
+		}
+	}
+
+	db.Close()
+	return nil, fmt.Errorf("failed to connect to database %s after %d attempts: %w", opts.Addr, attempts, err)
+}
+
+func migrate(ctx context.Context, db *pg.DB) error {
+	migrator, err := NewMigrator(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+
+	if applied != 0 {
+		log.Printf("Applied %d schema migrations", applied)
+	}
+
 	return nil
 }
 
@@ -96,8 +311,8 @@ func GetTestInstance(ctx context.Context) (*pg.DB, func()) {
 	}
 	client := pg.Connect(&opts)
 
-	if err := createTestTables(client); err != nil {
-		log.Fatalf("Failed to create test schema: %v", err)
+	if err := migrate(ctx, client); err != nil {
+		log.Fatalf("Failed to migrate test schema: %v", err)
 	}
 
 	// Return the client and a cleanup function
@@ -106,26 +321,3 @@ func GetTestInstance(ctx context.Context) (*pg.DB, func()) {
 		psqlClient.Terminate(ctx)
 	}
 }
-
-// createTestTables creates temporary test tables for the provided models.
-func createTestTables(db *pg.DB) error {
-	models := []interface{}{
-		(*model.Event)(nil),
-		(*model.User)(nil),
-	}
-
-	for _, model := range models {
-		opts := orm.CreateTableOptions{
-			Temp:        true, // Creates a temporary table for testing purposes.
-			IfNotExists: true,
-		}
-		err := db.
-			Model(model).
-			CreateTable(&opts)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
@@ -0,0 +1,190 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+
+	"github.com/go-pg/pg"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/fleimkeipa/kubernetes-api"
+
+// InitTracing sets up the global tracer provider exporting spans over OTLP/HTTP, along with the
+// W3C trace context propagation. The returned function flushes the pending spans on shutdown.
+// Spans are dropped when tracing is disabled, the trace context is still propagated.
+func InitTracing(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.Endpoint),
+		otlptracehttp.WithHeaders(cfg.Headers),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span named after the operation, a child of the span of ctx if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on the span, if any, and ends it.
+// Meant to be deferred with the named error result of the traced function.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// TraceID returns the trace ID of the span of ctx, empty when there is none.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return spanContext.TraceID().String()
+}
+
+// TracingMiddleware starts the server span of each request, continuing the trace of the
+// traceparent header of the caller, and makes it the parent of the spans of the handlers.
+func TracingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx, span := otel.Tracer(tracerName).Start(ctx, req.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(req.URL.Path),
+				semconv.ClientAddress(c.RealIP()),
+			),
+		)
+		defer span.End()
+
+		c.SetRequest(req.WithContext(ctx))
+
+		err := next(c)
+
+		status := c.Response().Status
+		if err != nil && !c.Response().Committed {
+			status = http.StatusInternalServerError
+
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+		}
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	}
+}
+
+// KubeTracingTransport wraps the transport of a Kubernetes client to trace its requests
+// and propagate the trace context to the API server. It is meant for rest.Config.Wrap.
+func KubeTracingTransport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+		return "kubernetes " + KubeRequestVerb(req.Method, req.URL.Path, req.URL.Query().Get("watch")) + " " + KubeRequestResource(req.URL.Path)
+	}))
+}
+
+// DBTracingHook traces the queries of a go-pg database run with a context holding a span,
+// as with ModelContext or WithContext.
+type DBTracingHook struct{}
+
+type dbQuerySpanKey struct{}
+
+func (rc DBTracingHook) BeforeQuery(event *pg.QueryEvent) {
+	if event.Ctx == nil || !trace.SpanContextFromContext(event.Ctx).IsValid() {
+		return
+	}
+
+	query, err := event.UnformattedQuery()
+	if err != nil {
+		return
+	}
+
+	_, span := otel.Tracer(tracerName).Start(event.Ctx, "db "+queryStatement(query),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBQueryText(strings.TrimSpace(query)),
+		),
+	)
+	event.Data[dbQuerySpanKey{}] = span
+}
+
+func (rc DBTracingHook) AfterQuery(event *pg.QueryEvent) {
+	span, ok := event.Data[dbQuerySpanKey{}].(trace.Span)
+	if !ok {
+		return
+	}
+
+	err := event.Error
+	if errors.Is(err, pg.ErrNoRows) {
+		err = nil
+	}
+
+	EndSpan(span, err)
+}
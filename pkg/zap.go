@@ -1,14 +1,82 @@
 package pkg
 
 import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+	"github.com/fleimkeipa/kubernetes-api/util"
+
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// LogErrorKey is the key of the echo context holding the error of a failure response, logged with the request.
+const LogErrorKey = "log_error"
+
+// maxRequestIDLength bounds the request IDs taken from the callers, longer ones are replaced.
+const maxRequestIDLength = 128
+
+const redactedValue = "REDACTED"
+
+type requestIDKey struct{}
+
+// RequestID assigns an ID to each request, the X-Request-ID of the caller when set, and returns it
+// in the X-Request-ID header of the response. The ID is added to the context of the request.
+func RequestID() echo.MiddlewareFunc {
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			if len(id) > maxRequestIDLength || strings.ContainsFunc(id, isNotPrintable) {
+				id = newRequestID()
+				c.Response().Header().Set(echo.HeaderXRequestID, id)
+			}
+
+			ctx := context.WithValue(c.Request().Context(), requestIDKey{}, id)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
+}
+
+// RequestIDFromCtx returns the ID of the request of ctx, empty when there is none.
+func RequestIDFromCtx(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	return middleware.DefaultRequestIDConfig.Generator()
+}
+
+func isNotPrintable(r rune) bool {
+	return r < 0x20 || r > 0x7e
+}
+
+// NewZapLogger builds the logger of the configuration, logging JSON or, with log.format console,
+// human readable lines. The level is the one of level, to change it while running.
+func NewZapLogger(cfg config.LogConfig, level zap.AtomicLevel) (*zap.Logger, error) {
+	loggerConfig := zap.NewProductionConfig()
+	if cfg.Format == "console" {
+		loggerConfig.Encoding = "console"
+		loggerConfig.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+	}
+	loggerConfig.Level = level
+
+	return loggerConfig.Build()
+}
+
+// ZapLogger logs each request once it is handled, with its ID, route, status, latency, user
+// and namespace, along with the error of failure responses. The values of the query parameters
+// named in log.redact are masked. The response body is not read, so streams are not buffered.
 func ZapLogger(log *zap.Logger) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			start := time.Now()
+
 			if err := next(c); err != nil {
 				c.Error(err)
 			}
@@ -16,11 +84,40 @@ func ZapLogger(log *zap.Logger) echo.MiddlewareFunc {
 			req := c.Request()
 			res := c.Response()
 
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+
 			fields := []zapcore.Field{
-				zap.Int("status", res.Status),
+				zap.String("request_id", RequestIDFromCtx(req.Context())),
 				zap.String("method", req.Method),
-				zap.String("uri", req.RequestURI),
-				zap.String("host", req.Host),
+				zap.String("route", route),
+				zap.String("path", req.URL.Path),
+				zap.Int("status", res.Status),
+				zap.Duration("latency", time.Since(start)),
+				zap.Int64("bytes_out", res.Size),
+				zap.String("remote_ip", c.RealIP()),
+			}
+
+			if query := req.URL.Query(); len(query) > 0 {
+				fields = append(fields, zap.String("query", RedactQuery(query, config.Get().Log.Redact)))
+			}
+
+			if owner := util.GetOwnerFromCtx(req.Context()); owner != nil {
+				fields = append(fields, zap.Int64("user_id", owner.ID))
+			}
+
+			if namespace := c.QueryParam("namespace"); namespace != "" {
+				fields = append(fields, zap.String("namespace", namespace))
+			}
+
+			if traceID := TraceID(req.Context()); traceID != "" {
+				fields = append(fields, zap.String("trace_id", traceID))
+			}
+
+			if failure, ok := c.Get(LogErrorKey).(string); ok && failure != "" {
+				fields = append(fields, zap.String("error", failure))
 			}
 
 			status := res.Status
@@ -39,3 +136,18 @@ func ZapLogger(log *zap.Logger) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// RedactQuery encodes the query, masking the values of the sensitive parameters.
+// Parameter names are matched case insensitively.
+func RedactQuery(query url.Values, sensitive []string) string {
+	redacted := make(url.Values, len(query))
+	for k, v := range query {
+		if slices.ContainsFunc(sensitive, func(s string) bool { return strings.EqualFold(s, k) }) {
+			v = []string{redactedValue}
+		}
+
+		redacted[k] = v
+	}
+
+	return redacted.Encode()
+}
@@ -0,0 +1,257 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/util"
+
+	"github.com/go-pg/pg"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterResource names the clusters in the errors of the registry, reported like those of the API server.
+var clusterResource = schema.GroupResource{Resource: "clusters"}
+
+// ClusterRepository is the registry of the clusters the API can talk to.
+// Clusters come either from the configuration or from the clusters table,
+// where their kubeconfig is stored encrypted. Without a database only the
+// configured clusters are known.
+type ClusterRepository struct {
+	db          *pg.DB
+	configured  map[string]kubernetes.Interface
+	clients     map[string]cachedClient
+	defaultName string
+	secret      string
+	mu          sync.RWMutex
+}
+
+// cachedClient is the client built from the kubeconfig of the stored cluster with the ID.
+type cachedClient struct {
+	client kubernetes.Interface
+	id     int64
+}
+
+// NewClusterRepository creates the registry. configured holds the clusters declared in the configuration,
+// defaultName the one used when a request does not select a cluster and secret the key encrypting stored kubeconfigs.
+func NewClusterRepository(db *pg.DB, configured map[string]kubernetes.Interface, defaultName, secret string) *ClusterRepository {
+	return &ClusterRepository{
+		db:          db,
+		configured:  configured,
+		clients:     make(map[string]cachedClient),
+		defaultName: defaultName,
+		secret:      secret,
+	}
+}
+
+// ClientFor returns the client of the cluster selected on the context, or of the default cluster.
+func (rc *ClusterRepository) ClientFor(ctx context.Context) (kubernetes.Interface, error) {
+	name := util.GetClusterFromCtx(ctx)
+	if name == "" {
+		name = rc.defaultName
+	}
+
+	return rc.client(ctx, name)
+}
+
+func (rc *ClusterRepository) Create(ctx context.Context, cluster model.Cluster) (*model.Cluster, error) {
+	if _, ok := rc.configured[cluster.Name]; ok {
+		return nil, apierrors.NewAlreadyExists(clusterResource, cluster.Name)
+	}
+
+	if rc.db == nil {
+		return nil, fmt.Errorf("registering clusters requires a database")
+	}
+
+	if exist, err := rc.getByName(ctx, cluster.Name); err != nil {
+		return nil, err
+	} else if exist != nil {
+		return nil, apierrors.NewAlreadyExists(clusterResource, cluster.Name)
+	}
+
+	client, err := pkg.NewKubernetesClientFromKubeconfig([]byte(cluster.Kubeconfig), cluster.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := pkg.Encrypt(rc.secret, []byte(cluster.Kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt kubeconfig: %w", err)
+	}
+	cluster.Kubeconfig = encrypted
+
+	if _, err := rc.db.ModelContext(ctx, &cluster).Insert(); err != nil {
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	rc.mu.Lock()
+	rc.clients[cluster.Name] = cachedClient{client: client, id: cluster.ID}
+	rc.mu.Unlock()
+
+	return &cluster, nil
+}
+
+// List returns every known cluster, sorted by name, without checking its health.
+func (rc *ClusterRepository) List(ctx context.Context) ([]model.ClusterStatus, error) {
+	clusters := make([]model.ClusterStatus, 0, len(rc.configured))
+	for name := range rc.configured {
+		clusters = append(clusters, model.ClusterStatus{
+			Name:    name,
+			Source:  model.ClusterSourceConfig,
+			Default: name == rc.defaultName,
+		})
+	}
+
+	var stored []model.Cluster
+	if rc.db != nil {
+		if err := rc.db.ModelContext(ctx, &stored).Column("name").Select(); err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+	}
+
+	for _, v := range stored {
+		clusters = append(clusters, model.ClusterStatus{
+			Name:    v.Name,
+			Source:  model.ClusterSourceDatabase,
+			Default: v.Name == rc.defaultName,
+		})
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	return clusters, nil
+}
+
+// Default returns the name of the cluster used when a request does not select one.
+func (rc *ClusterRepository) Default() string {
+	return rc.defaultName
+}
+
+// Version asks the API server of the cluster for its version.
+func (rc *ClusterRepository) Version(ctx context.Context, name string) (string, error) {
+	client, err := rc.client(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	// fake clients have no REST client to send the request with
+	restClient := client.Discovery().RESTClient()
+	if restClient == nil {
+		info, err := client.Discovery().ServerVersion()
+		if err != nil {
+			return "", err
+		}
+
+		return info.GitVersion, nil
+	}
+
+	body, err := restClient.Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return "", err
+	}
+
+	var info version.Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		return "", fmt.Errorf("failed to decode version: %w", err)
+	}
+
+	return info.GitVersion, nil
+}
+
+func (rc *ClusterRepository) Delete(ctx context.Context, name string) error {
+	if _, ok := rc.configured[name]; ok {
+		return apierrors.NewForbidden(clusterResource, name, errors.New("clusters defined in the configuration cannot be deleted"))
+	}
+
+	if rc.db == nil {
+		return apierrors.NewNotFound(clusterResource, name)
+	}
+
+	result, err := rc.db.ModelContext(ctx, &model.Cluster{}).Where("name = ?", name).Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete cluster: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return apierrors.NewNotFound(clusterResource, name)
+	}
+
+	rc.mu.Lock()
+	delete(rc.clients, name)
+	rc.mu.Unlock()
+
+	return nil
+}
+
+// client returns the client of the cluster. The row of a stored cluster is read on every call, another
+// replica may have deleted it or registered it again with another kubeconfig: the cached client is only
+// reused for the row it was built from.
+func (rc *ClusterRepository) client(ctx context.Context, name string) (kubernetes.Interface, error) {
+	if client, ok := rc.configured[name]; ok {
+		return client, nil
+	}
+
+	cluster, err := rc.getByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil {
+		rc.mu.Lock()
+		delete(rc.clients, name)
+		rc.mu.Unlock()
+
+		return nil, apierrors.NewNotFound(clusterResource, name)
+	}
+
+	rc.mu.RLock()
+	cached, ok := rc.clients[name]
+	rc.mu.RUnlock()
+	if ok && cached.id == cluster.ID {
+		return cached.client, nil
+	}
+
+	kubeconfig, err := pkg.Decrypt(rc.secret, cluster.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt kubeconfig of cluster %s: %w", name, err)
+	}
+
+	client, err := pkg.NewKubernetesClientFromKubeconfig(kubeconfig, cluster.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.mu.Lock()
+	rc.clients[name] = cachedClient{client: client, id: cluster.ID}
+	rc.mu.Unlock()
+
+	return client, nil
+}
+
+func (rc *ClusterRepository) getByName(ctx context.Context, name string) (*model.Cluster, error) {
+	if rc.db == nil {
+		return nil, nil
+	}
+
+	var clusters []model.Cluster
+	if err := rc.db.ModelContext(ctx, &clusters).Where("name = ?", name).Limit(1).Select(); err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	return &clusters[0], nil
+}
@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+type ClusterEventRepository struct {
+	clients KubeClients
+}
+
+func NewClusterEventRepository(clients KubeClients) *ClusterEventRepository {
+	return &ClusterEventRepository{
+		clients: clients,
+	}
+}
+
+// ListByObject lists the cluster events whose involved object matches the reference.
+func (rc *ClusterEventRepository) ListByObject(ctx context.Context, ref model.ObjectReference, opts model.ListOptions) (*model.ClusterEventList, error) {
+	selector := fields.Set{
+		"involvedObject.kind": ref.Kind,
+		"involvedObject.name": ref.Name,
+	}
+	if ref.UID != "" {
+		selector["involvedObject.uid"] = ref.UID
+	}
+
+	opts.FieldSelector = joinFieldSelectors(opts.FieldSelector, selector.String())
+
+	kubeEvents, err := rc.list(ctx, ref.Namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// not every client honors field selectors, so match the involved object again
+	items := make([]corev1.Event, 0, len(kubeEvents.Items))
+	for _, v := range kubeEvents.Items {
+		if v.InvolvedObject.Kind != ref.Kind || v.InvolvedObject.Name != ref.Name {
+			continue
+		}
+		if ref.UID != "" && string(v.InvolvedObject.UID) != ref.UID {
+			continue
+		}
+		items = append(items, v)
+	}
+	kubeEvents.Items = items
+
+	return rc.fillResponseEvents(kubeEvents), nil
+}
+
+// ListByNamespace lists every cluster event in the namespace.
+func (rc *ClusterEventRepository) ListByNamespace(ctx context.Context, namespace string, opts model.ListOptions) (*model.ClusterEventList, error) {
+	kubeEvents, err := rc.list(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponseEvents(kubeEvents), nil
+}
+
+func (rc *ClusterEventRepository) list(ctx context.Context, namespace string, opts model.ListOptions) (*corev1.EventList, error) {
+	listOpts := convertListOptsToKube(opts)
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CoreV1().Events(namespace).List(ctx, listOpts)
+}
+
+func (rc *ClusterEventRepository) fillResponseEvents(kubeEvents *corev1.EventList) *model.ClusterEventList {
+	events := make([]model.ClusterEvent, 0, len(kubeEvents.Items))
+	for _, v := range kubeEvents.Items {
+		events = append(events, model.ClusterEvent{
+			FirstTimestamp: eventFirstSeen(&v),
+			LastTimestamp:  eventLastSeen(&v),
+			InvolvedObject: model.ObjectReference{
+				Kind:       v.InvolvedObject.Kind,
+				Namespace:  v.InvolvedObject.Namespace,
+				Name:       v.InvolvedObject.Name,
+				UID:        string(v.InvolvedObject.UID),
+				APIVersion: v.InvolvedObject.APIVersion,
+				FieldPath:  v.InvolvedObject.FieldPath,
+			},
+			Name:      v.Name,
+			Namespace: v.Namespace,
+			Type:      v.Type,
+			Reason:    v.Reason,
+			Message:   v.Message,
+			Source:    eventSource(&v),
+			Count:     eventCount(&v),
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp)
+	})
+
+	return &model.ClusterEventList{
+		ListMeta: model.ListMeta{
+			RemainingItemCount: kubeEvents.ListMeta.RemainingItemCount,
+			ResourceVersion:    kubeEvents.ListMeta.ResourceVersion,
+			Continue:           kubeEvents.ListMeta.Continue,
+		},
+		Items: events,
+	}
+}
+
+// eventFirstSeen falls back to the event time set by the newer events.k8s.io API.
+func eventFirstSeen(event *corev1.Event) time.Time {
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+
+	return event.EventTime.Time
+}
+
+func eventLastSeen(event *corev1.Event) time.Time {
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		return event.Series.LastObservedTime.Time
+	}
+
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+
+	return eventFirstSeen(event)
+}
+
+func eventCount(event *corev1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+
+	return event.Count
+}
+
+func eventSource(event *corev1.Event) string {
+	if event.ReportingController != "" {
+		return event.ReportingController
+	}
+
+	return event.Source.Component
+}
+
+func joinFieldSelectors(selectors ...string) string {
+	joined := ""
+	for _, v := range selectors {
+		if v == "" {
+			continue
+		}
+
+		if joined != "" {
+			joined += ","
+		}
+		joined += v
+	}
+
+	return joined
+}
@@ -2,32 +2,42 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 )
 
 type DeploymentRepository struct {
-	client *kubernetes.Clientset
+	clients KubeClients
 }
 
-func NewDeploymentInterfaces(client *kubernetes.Clientset) *DeploymentRepository {
-	return &DeploymentRepository{client}
+func NewDeploymentInterfaces(clients KubeClients) *DeploymentRepository {
+	return &DeploymentRepository{clients}
 }
 
-func (rc *DeploymentRepository) Create(ctx context.Context, deployment *model.Deployment, opts model.CreateOptions) (*model.Deployment, error) {
+func (rc *DeploymentRepository) Create(ctx context.Context, deployment *model.Deployment, opts model.CreateOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.Create")
+	defer func() { pkg.EndSpan(span, err) }()
+
 	metaOpts := convertCreateOptsToKube(opts)
 
 	kubeDeployment := rc.fillRequestDeployment(deployment)
 
-	createdDeployment, err := rc.client.AppsV1().Deployments(deployment.Namespace).Create(ctx, kubeDeployment, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdDeployment, err := client.AppsV1().Deployments(deployment.Namespace).Create(ctx, kubeDeployment, metaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -35,17 +45,41 @@ func (rc *DeploymentRepository) Create(ctx context.Context, deployment *model.De
 	return rc.fillResponseDeployment(createdDeployment), nil
 }
 
-func (rc *DeploymentRepository) Update(ctx context.Context, namespace, deploymentID string, deployment *model.Deployment, opts model.UpdateOptions) (*model.Deployment, error) {
+func (rc *DeploymentRepository) Update(ctx context.Context, namespace, deploymentID string, deployment *model.Deployment, opts model.UpdateOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.Update", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	metaOpts := convertUpdateOptsToKube(opts)
 
-	existDeployment, err := rc.getByNameOrUID(ctx, namespace, deploymentID, model.ListOptions{})
+	client, err := rc.clients.ClientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	kubeDeployment := rc.overwriteOnKubeDeployment(deployment, existDeployment)
+	update := func() (*v1.Deployment, error) {
+		existDeployment, err := rc.getByNameOrUID(ctx, namespace, deploymentID, model.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkResourceVersion(v1.Resource("deployments"), existDeployment.Name, existDeployment.ResourceVersion, opts.Preconditions); err != nil {
+			return nil, err
+		}
+
+		kubeDeployment := rc.overwriteOnKubeDeployment(deployment, existDeployment)
+
+		return client.AppsV1().Deployments(namespace).Update(ctx, kubeDeployment, metaOpts)
+	}
 
-	updatedDeployment, err := rc.client.AppsV1().Deployments(namespace).Update(ctx, kubeDeployment, metaOpts)
+	var updatedDeployment *v1.Deployment
+	if opts.RetryOnConflict && !hasResourceVersionPrecondition(opts.Preconditions) {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+			updatedDeployment, err = update()
+			return err
+		})
+	} else {
+		updatedDeployment, err = update()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +87,43 @@ func (rc *DeploymentRepository) Update(ctx context.Context, namespace, deploymen
 	return rc.fillResponseDeployment(updatedDeployment), nil
 }
 
-func (rc *DeploymentRepository) List(ctx context.Context, namespace string, opts model.ListOptions) (*model.DeploymentList, error) {
+func (rc *DeploymentRepository) Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.Patch", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	metaOpts := convertPatchOptsToKube(opts)
+
+	existDeployment, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResourceVersion(v1.Resource("deployments"), existDeployment.Name, existDeployment.ResourceVersion, opts.Preconditions); err != nil {
+		return nil, err
+	}
+
+	data, err = patchWithResourceVersion(patchType, data, opts.Preconditions)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedDeployment, err := client.AppsV1().Deployments(namespace).Patch(ctx, existDeployment.Name, types.PatchType(patchType), data, metaOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponseDeployment(patchedDeployment), nil
+}
+
+func (rc *DeploymentRepository) List(ctx context.Context, namespace string, opts model.ListOptions) (_ *model.DeploymentList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.List", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	kubeDeployments, err := rc.list(ctx, namespace, opts)
 	if err != nil {
 		return nil, err
@@ -74,17 +144,48 @@ func (rc *DeploymentRepository) List(ctx context.Context, namespace string, opts
 	return &deploymentList, nil
 }
 
-func (rc *DeploymentRepository) Delete(ctx context.Context, namespace, nameOrUID string, opts model.DeleteOptions) error {
+func (rc *DeploymentRepository) Delete(ctx context.Context, namespace, nameOrUID string, opts model.DeleteOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.Delete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	metaOpts := convertDeleteOptsToKube(opts)
 
-	if metaOpts.Preconditions != nil && metaOpts.Preconditions.UID != nil {
-		return rc.client.AppsV1().Deployments(namespace).DeleteCollection(ctx, metaOpts, metav1.ListOptions{})
+	name := nameOrUID
+	if hasResourceVersionPrecondition(opts.Preconditions) {
+		existDeployment, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := checkResourceVersion(v1.Resource("deployments"), existDeployment.Name, existDeployment.ResourceVersion, opts.Preconditions); err != nil {
+			return err
+		}
+		name = existDeployment.Name
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.AppsV1().Deployments(namespace).Delete(ctx, name, metaOpts)
+	if !apierrors.IsNotFound(err) || name != nameOrUID {
+		return err
+	}
+
+	// not a name, the deployment may be found by its UID
+	existDeployment, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return err
 	}
 
-	return rc.client.AppsV1().Deployments(namespace).Delete(ctx, nameOrUID, metaOpts)
+	return client.AppsV1().Deployments(namespace).Delete(ctx, existDeployment.Name, metaOpts)
 }
 
-func (rc *DeploymentRepository) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Deployment, error) {
+func (rc *DeploymentRepository) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentRepository.GetByNameOrUID", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	deployment, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, opts)
 	if err != nil {
 		return nil, err
@@ -111,7 +212,7 @@ func (rc *DeploymentRepository) getByNameOrUID(ctx context.Context, namespace, n
 	}
 
 	if deployments.ListMeta.Continue == "" {
-		return nil, fmt.Errorf("deployment %s not found", nameOrUID)
+		return nil, apierrors.NewNotFound(v1.Resource("deployments"), nameOrUID)
 	}
 
 	opts.Continue = deployments.ListMeta.Continue
@@ -121,7 +222,12 @@ func (rc *DeploymentRepository) getByNameOrUID(ctx context.Context, namespace, n
 func (rc *DeploymentRepository) list(ctx context.Context, namespace string, opts model.ListOptions) (*v1.DeploymentList, error) {
 	metaOpts := convertListOptsToKube(opts)
 
-	return rc.client.AppsV1().Deployments(namespace).List(ctx, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.AppsV1().Deployments(namespace).List(ctx, metaOpts)
 }
 
 func (rc *DeploymentRepository) fillRequestDeployment(deployment *model.Deployment) *v1.Deployment {
@@ -171,25 +277,7 @@ func (rc *DeploymentRepository) fillRequestDeployment(deployment *model.Deployme
 }
 
 func fillTemplate(deployment *model.Deployment) corev1.PodTemplateSpec {
-	containers := convertContainersToKube(deployment.Spec.Template.Spec.Containers)
-	initContainers := convertContainersToKube(deployment.Spec.Template.Spec.InitContainers)
-
-	tolerations := convertTolerationsToKube(deployment.Spec.Template.Spec.Tolerations)
-
-	template := corev1.PodTemplateSpec{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        deployment.Spec.Template.ObjectMeta.Name,
-			Labels:      deployment.Spec.Template.ObjectMeta.Labels,
-			Annotations: deployment.Spec.Template.ObjectMeta.Annotations,
-		},
-		Spec: corev1.PodSpec{
-			Containers:            containers,
-			InitContainers:        initContainers,
-			Tolerations:           tolerations,
-			ActiveDeadlineSeconds: deployment.Spec.Template.Spec.ActiveDeadlineSeconds,
-		},
-	}
-	return template
+	return convertTemplateToKube(&deployment.Spec.Template)
 }
 
 func fillConditions(newDeployment *model.Deployment) []v1.DeploymentCondition {
@@ -3,8 +3,10 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
 
 	"github.com/go-pg/pg"
 )
@@ -19,10 +21,38 @@ func NewEventRepository(db *pg.DB) *EventRepository {
 	}
 }
 
+// eventChainLockID is the advisory lock key serializing writers of the event hash chain.
+const eventChainLockID = 7_301_026
+
+// eventZeroCredsFields are the columns listed when the fields are model.ZeroCreds.
+var eventZeroCredsFields = []string{
+	"category",
+	"type",
+	"created_at",
+	"owner",
+	"deleted_at",
+}
+
 func (rc *EventRepository) Create(ctx context.Context, newEvent *model.Event) (*model.Event, error) {
-	q := rc.db.Model(newEvent)
+	// postgres keeps microseconds, truncate so the stored row hashes the same way
+	newEvent.CreatedAt = newEvent.CreatedAt.Truncate(time.Microsecond)
+
+	err := rc.db.WithContext(ctx).RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", eventChainLockID); err != nil {
+			return err
+		}
+
+		prevHash, err := rc.lastHash(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		newEvent.PrevHash = prevHash
+		newEvent.Hash = newEvent.ComputeHash(prevHash)
 
-	_, err := q.Insert()
+		_, err = tx.ModelContext(ctx, newEvent).Insert()
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event (kind: [%s], event kind: [%s]): %v", newEvent.Category, newEvent.Type, err)
 	}
@@ -36,7 +66,7 @@ func (rc *EventRepository) List(ctx context.Context, opts *model.EventFindOpts)
 	filter := rc.fillFilter(opts)
 	fields := rc.fillFields(opts)
 
-	q := rc.db.Model(&events).Column(fields...)
+	q := rc.db.ModelContext(ctx, &events).Column(fields...)
 
 	if filter != "" {
 		q = q.Where(filter)
@@ -62,7 +92,7 @@ func (rc *EventRepository) List(ctx context.Context, opts *model.EventFindOpts)
 func (rc *EventRepository) GetByID(ctx context.Context, id string) (*model.Event, error) {
 	var event model.Event
 
-	q := rc.db.Model(event)
+	q := rc.db.ModelContext(ctx, &event)
 
 	if id == "0" || id == "" {
 		return nil, fmt.Errorf("invalid event id")
@@ -77,6 +107,85 @@ func (rc *EventRepository) GetByID(ctx context.Context, id string) (*model.Event
 	return &event, nil
 }
 
+// ListChain returns events with an id greater than afterID in chain order, soft deleted ones included.
+func (rc *EventRepository) ListChain(ctx context.Context, afterID int64, limit int) ([]model.Event, error) {
+	var events []model.Event
+
+	_, err := rc.db.QueryContext(ctx, &events, "SELECT * FROM events WHERE id > ? ORDER BY id LIMIT ?", afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event chain after [%d]: %w", afterID, err)
+	}
+
+	return events, nil
+}
+
+// LastArchive returns the most recent archive, or nil if nothing has been archived yet.
+func (rc *EventRepository) LastArchive(ctx context.Context) (*model.EventArchive, error) {
+	var archives []model.EventArchive
+
+	err := rc.db.ModelContext(ctx, &archives).Order("last_event_id DESC").Limit(1).Select()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find last event archive: %w", err)
+	}
+
+	if len(archives) == 0 {
+		return nil, nil
+	}
+
+	return &archives[0], nil
+}
+
+// Purge records the archive and removes the archived events from the database.
+func (rc *EventRepository) Purge(ctx context.Context, archive *model.EventArchive) error {
+	err := rc.db.WithContext(ctx).RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", eventChainLockID); err != nil {
+			return err
+		}
+
+		// another replica may have archived the same events while this one waited for the lock
+		var archived bool
+		if _, err := tx.QueryOneContext(ctx, pg.Scan(&archived), "SELECT EXISTS(SELECT 1 FROM event_archives WHERE last_event_id >= ?)", archive.FirstEventID); err != nil {
+			return err
+		}
+		if archived {
+			return interfaces.ErrAlreadyArchived
+		}
+
+		if _, err := tx.ModelContext(ctx, archive).Insert(); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, "DELETE FROM events WHERE id <= ?", archive.LastEventID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to purge events up to [%d]: %w", archive.LastEventID, err)
+	}
+
+	return nil
+}
+
+// lastHash returns the hash of the newest event, falling back to the last archive anchor.
+func (rc *EventRepository) lastHash(ctx context.Context, tx *pg.Tx) (string, error) {
+	var hashes []string
+
+	if _, err := tx.QueryContext(ctx, &hashes, "SELECT hash FROM events ORDER BY id DESC LIMIT 1"); err != nil {
+		return "", err
+	}
+	if len(hashes) != 0 {
+		return hashes[0], nil
+	}
+
+	if _, err := tx.QueryContext(ctx, &hashes, "SELECT last_hash FROM event_archives ORDER BY last_event_id DESC LIMIT 1"); err != nil {
+		return "", err
+	}
+	if len(hashes) != 0 {
+		return hashes[0], nil
+	}
+
+	return "", nil
+}
+
 func (rc *EventRepository) fillFields(opts *model.EventFindOpts) []string {
 	fields := opts.Fields
 
@@ -85,13 +194,7 @@ func (rc *EventRepository) fillFields(opts *model.EventFindOpts) []string {
 	}
 
 	if len(fields) == 1 && fields[0] == model.ZeroCreds {
-		return []string{
-			"kind",
-			"event_kind",
-			"creation_time",
-			"owner.id",
-			"deleted_at",
-		}
+		return eventZeroCredsFields
 	}
 
 	return fields
@@ -113,11 +216,11 @@ func (rc *EventRepository) fillFilter(opts *model.EventFindOpts) string {
 	}
 
 	if opts.OwnerID.IsSended {
-		filter = addFilterClause(filter, "owner.id", opts.OwnerID.Value)
+		filter = addFilterClause(filter, "owner->>'id'", opts.OwnerID.Value)
 	}
 
 	if opts.OwnerUsername.IsSended {
-		filter = addFilterClause(filter, "owner.username", opts.OwnerUsername.Value)
+		filter = addFilterClause(filter, "owner->>'username'", opts.OwnerUsername.Value)
 	}
 
 	return filter
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+type EventArchiveRepository struct {
+	dir string
+}
+
+func NewEventArchiveRepository(dir string) *EventArchiveRepository {
+	return &EventArchiveRepository{
+		dir: dir,
+	}
+}
+
+// Write stores the events as gzip compressed JSON lines and returns the created file path.
+func (rc *EventArchiveRepository) Write(ctx context.Context, name string, events []model.Event) (string, error) {
+	if err := os.MkdirAll(rc.dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create archive directory [%s]: %w", rc.dir, err)
+	}
+
+	path := filepath.Join(rc.dir, name)
+
+	// write to a temporary file first so a crash never leaves a truncated archive behind
+	tmp, err := os.CreateTemp(rc.dir, name+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create archive file [%s]: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	gz := gzip.NewWriter(tmp)
+	encoder := json.NewEncoder(gz)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			tmp.Close()
+			return "", fmt.Errorf("failed to write event [%d] to archive: %w", event.ID, err)
+		}
+	}
+
+	if err := gz.Close(); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to compress archive [%s]: %w", path, err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to sync archive [%s]: %w", path, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to close archive [%s]: %w", path, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to move archive into place [%s]: %w", path, err)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"github.com/go-pg/pg"
+)
+
+// PSQLHealthRepository checks the database answers queries.
+type PSQLHealthRepository struct {
+	db *pg.DB
+}
+
+func NewPSQLHealthRepository(db *pg.DB) *PSQLHealthRepository {
+	return &PSQLHealthRepository{
+		db: db,
+	}
+}
+
+func (rc *PSQLHealthRepository) Name() string {
+	return "postgres"
+}
+
+func (rc *PSQLHealthRepository) Check(ctx context.Context) error {
+	if _, err := rc.db.WithContext(ctx).Exec("SELECT 1"); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return nil
+}
+
+// KubeAPIHealthRepository checks the API server of the default cluster is reachable.
+type KubeAPIHealthRepository struct {
+	clusterRepo interfaces.ClusterInterfaces
+}
+
+func NewKubeAPIHealthRepository(clusterRepo interfaces.ClusterInterfaces) *KubeAPIHealthRepository {
+	return &KubeAPIHealthRepository{
+		clusterRepo: clusterRepo,
+	}
+}
+
+func (rc *KubeAPIHealthRepository) Name() string {
+	return "kubernetes"
+}
+
+func (rc *KubeAPIHealthRepository) Check(ctx context.Context) error {
+	_, err := rc.clusterRepo.Version(ctx, rc.clusterRepo.Default())
+	return err
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+type ClusterInterfaces interface {
+	Create(ctx context.Context, cluster model.Cluster) (*model.Cluster, error)
+	List(ctx context.Context) ([]model.ClusterStatus, error)
+	Default() string
+	Version(ctx context.Context, name string) (string, error)
+	Delete(ctx context.Context, name string) error
+}
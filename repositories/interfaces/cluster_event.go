@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+type ClusterEventInterfaces interface {
+	ListByObject(ctx context.Context, ref model.ObjectReference, opts model.ListOptions) (*model.ClusterEventList, error)
+	ListByNamespace(ctx context.Context, namespace string, opts model.ListOptions) (*model.ClusterEventList, error)
+}
@@ -9,6 +9,7 @@ import (
 type DeploymentInterfaces interface {
 	Create(ctx context.Context, deployment *model.Deployment, opts model.CreateOptions) (*model.Deployment, error)
 	Update(ctx context.Context, namespace, id string, deployment *model.Deployment, opts model.UpdateOptions) (*model.Deployment, error)
+	Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (*model.Deployment, error)
 	List(ctx context.Context, namespace string, opts model.ListOptions) (*model.DeploymentList, error)
 	Delete(ctx context.Context, namespace string, deploymentID string, opts model.DeleteOptions) error
 	GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Deployment, error)
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"errors"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
 )
@@ -10,4 +11,15 @@ type EventInterfaces interface {
 	Create(ctx context.Context, event *model.Event) (*model.Event, error)
 	List(ctx context.Context, event *model.EventFindOpts) (*model.EventList, error)
 	GetByID(ctx context.Context, eventID string) (*model.Event, error)
+	ListChain(ctx context.Context, afterID int64, limit int) ([]model.Event, error)
+	LastArchive(ctx context.Context) (*model.EventArchive, error)
+	// Purge returns ErrAlreadyArchived when another replica archived the events first.
+	Purge(ctx context.Context, archive *model.EventArchive) error
+}
+
+// ErrAlreadyArchived is returned by Purge for events archived since they were read.
+var ErrAlreadyArchived = errors.New("events already archived")
+
+type EventArchiveInterfaces interface {
+	Write(ctx context.Context, name string, events []model.Event) (string, error)
 }
@@ -0,0 +1,9 @@
+package interfaces
+
+import "context"
+
+// HealthCheckInterfaces checks a dependency the API needs to serve requests.
+type HealthCheckInterfaces interface {
+	Name() string
+	Check(ctx context.Context) error
+}
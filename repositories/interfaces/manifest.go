@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ManifestInterfaces reads and applies the objects of manifests, whatever their kind.
+type ManifestInterfaces interface {
+	IsNamespaced(ctx context.Context, gvk schema.GroupVersionKind) (bool, error)
+	Get(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Apply(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) (*unstructured.Unstructured, error)
+	List(ctx context.Context, gvk schema.GroupVersionKind, namespace, selector string) ([]unstructured.Unstructured, error)
+	Delete(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+// MetricsInterfaces reads the resource usage of the pods from the metrics API, served by metrics-server.
+// Only the usage of the metrics is set, and the reads fail with a ServiceUnavailable error when the
+// cluster does not serve the metrics API.
+type MetricsInterfaces interface {
+	GetPodMetrics(ctx context.Context, namespace, name string) (*model.PodMetrics, error)
+	ListPodMetrics(ctx context.Context, namespace string) ([]model.PodMetrics, error)
+}
@@ -9,6 +9,7 @@ import (
 type NamespaceInterfaces interface {
 	Create(ctx context.Context, namespace *model.Namespace, opts model.CreateOptions) (*model.Namespace, error)
 	Update(ctx context.Context, nameOrUID string, namespace *model.Namespace, opts model.UpdateOptions) (*model.Namespace, error)
+	Patch(ctx context.Context, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (*model.Namespace, error)
 	List(ctx context.Context, opts model.ListOptions) (*model.NamespaceList, error)
 	Delete(ctx context.Context, name string, opts model.DeleteOptions) error
 	GetByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (*model.Namespace, error)
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+type NodeInterfaces interface {
+	List(ctx context.Context, opts model.ListOptions) (*model.NodeList, error)
+	GetByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (*model.Node, error)
+	// SetUnschedulable cordons the node, or uncordons it when unschedulable is false.
+	SetUnschedulable(ctx context.Context, name string, unschedulable bool, opts model.PatchOptions) (*model.Node, error)
+}
@@ -9,7 +9,13 @@ import (
 type PodInterfaces interface {
 	Create(ctx context.Context, pod *model.Pod, opts model.CreateOptions) (*model.Pod, error)
 	Update(ctx context.Context, id string, pod *model.Pod, opts model.UpdateOptions) (*model.Pod, error)
+	Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (*model.Pod, error)
 	List(ctx context.Context, namespace string, opts model.ListOptions) (*model.PodList, error)
 	Delete(ctx context.Context, namespace string, podID string, opts model.DeleteOptions) error
 	GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Pod, error)
+	// Get reads the pod by its name only, in a single request.
+	Get(ctx context.Context, namespace, name string) (*model.Pod, error)
+	// Evict evicts the pod through the eviction API, which refuses with a TooManyRequests error
+	// the evictions a PodDisruptionBudget does not allow.
+	Evict(ctx context.Context, namespace, name string, opts model.DeleteOptions) error
 }
@@ -0,0 +1,10 @@
+package interfaces
+
+import "context"
+
+// ReferenceInterfaces looks up the config maps and secrets referenced by the pods.
+// The lookups return a NotFound error when the object is missing.
+type ReferenceInterfaces interface {
+	ConfigMapKeys(ctx context.Context, namespace, name string) ([]string, error)
+	SecretKeys(ctx context.Context, namespace, name string) ([]string, error)
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// KubeClients resolves the kubernetes client of the cluster selected for a request.
+type KubeClients interface {
+	ClientFor(ctx context.Context) (kubernetes.Interface, error)
+}
+
+type singleKubeClient struct {
+	client kubernetes.Interface
+}
+
+// NewSingleKubeClient returns KubeClients always resolving to client, whatever cluster is selected.
+func NewSingleKubeClient(client kubernetes.Interface) KubeClients {
+	return &singleKubeClient{client: client}
+}
+
+func (rc *singleKubeClient) ClientFor(ctx context.Context) (kubernetes.Interface, error) {
+	return rc.client, nil
+}
@@ -1,11 +1,14 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/fleimkeipa/kubernetes-api/model"
 
 	"github.com/google/uuid"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -79,15 +82,61 @@ func convertTolerationsToModel(tolerations []corev1.Toleration) []model.Tolerati
 	return newTolerations
 }
 
+func convertPodSpecToKube(spec *model.PodSpec) corev1.PodSpec {
+	return corev1.PodSpec{
+		Volumes:                       convertVolumesToKube(spec.Volumes),
+		InitContainers:                convertContainersToKube(spec.InitContainers),
+		Containers:                    convertContainersToKube(spec.Containers),
+		RestartPolicy:                 corev1.RestartPolicy(spec.RestartPolicy),
+		ActiveDeadlineSeconds:         spec.ActiveDeadlineSeconds,
+		TerminationGracePeriodSeconds: spec.TerminationGracePeriodSeconds,
+		NodeSelector:                  spec.NodeSelector,
+		ServiceAccountName:            spec.ServiceAccountName,
+		NodeName:                      spec.NodeName,
+		SecurityContext:               convertPodSecurityContextToKube(spec.SecurityContext),
+		ImagePullSecrets:              convertLocalObjectReferencesToKube(spec.ImagePullSecrets),
+		Affinity:                      convertAffinityToKube(spec.Affinity),
+		Tolerations:                   convertTolerationsToKube(spec.Tolerations),
+	}
+}
+
+func convertPodSpecToModel(spec *corev1.PodSpec) model.PodSpec {
+	return model.PodSpec{
+		Volumes:                       convertVolumesToModel(spec.Volumes),
+		InitContainers:                convertContainersToModel(spec.InitContainers),
+		Containers:                    convertContainersToModel(spec.Containers),
+		RestartPolicy:                 model.RestartPolicy(spec.RestartPolicy),
+		ActiveDeadlineSeconds:         spec.ActiveDeadlineSeconds,
+		TerminationGracePeriodSeconds: spec.TerminationGracePeriodSeconds,
+		NodeSelector:                  spec.NodeSelector,
+		ServiceAccountName:            spec.ServiceAccountName,
+		NodeName:                      spec.NodeName,
+		SecurityContext:               convertPodSecurityContextToModel(spec.SecurityContext),
+		ImagePullSecrets:              convertLocalObjectReferencesToModel(spec.ImagePullSecrets),
+		Affinity:                      convertAffinityToModel(spec.Affinity),
+		Tolerations:                   convertTolerationsToModel(spec.Tolerations),
+	}
+}
+
 func convertContainersToKube(containers []model.Container) []corev1.Container {
 	newContainers := make([]corev1.Container, 0, len(containers))
 	for _, v := range containers {
 		newContainers = append(newContainers, corev1.Container{
 			Name:                   v.Name,
 			Image:                  v.Image,
+			ImagePullPolicy:        corev1.PullPolicy(v.ImagePullPolicy),
 			Command:                v.Command,
 			Args:                   v.Args,
 			WorkingDir:             v.WorkingDir,
+			Ports:                  convertContainerPortsToKube(v.Ports),
+			EnvFrom:                convertEnvFromToKube(v.EnvFrom),
+			Env:                    convertEnvToKube(v.Env),
+			Resources:              convertResourcesToKube(v.Resources),
+			VolumeMounts:           convertVolumeMountsToKube(v.VolumeMounts),
+			LivenessProbe:          convertProbeToKube(v.LivenessProbe),
+			ReadinessProbe:         convertProbeToKube(v.ReadinessProbe),
+			StartupProbe:           convertProbeToKube(v.StartupProbe),
+			SecurityContext:        convertSecurityContextToKube(v.SecurityContext),
 			TerminationMessagePath: v.TerminationMessagePath,
 			Stdin:                  v.Stdin,
 			StdinOnce:              v.StdinOnce,
@@ -103,9 +152,19 @@ func convertContainersToModel(containers []corev1.Container) []model.Container {
 		newContainers = append(newContainers, model.Container{
 			Name:                   v.Name,
 			Image:                  v.Image,
+			ImagePullPolicy:        model.PullPolicy(v.ImagePullPolicy),
 			Command:                v.Command,
 			Args:                   v.Args,
 			WorkingDir:             v.WorkingDir,
+			Ports:                  convertContainerPortsToModel(v.Ports),
+			EnvFrom:                convertEnvFromToModel(v.EnvFrom),
+			Env:                    convertEnvToModel(v.Env),
+			Resources:              convertResourcesToModel(v.Resources),
+			VolumeMounts:           convertVolumeMountsToModel(v.VolumeMounts),
+			LivenessProbe:          convertProbeToModel(v.LivenessProbe),
+			ReadinessProbe:         convertProbeToModel(v.ReadinessProbe),
+			StartupProbe:           convertProbeToModel(v.StartupProbe),
+			SecurityContext:        convertSecurityContextToModel(v.SecurityContext),
 			TerminationMessagePath: v.TerminationMessagePath,
 			Stdin:                  v.Stdin,
 			StdinOnce:              v.StdinOnce,
@@ -115,6 +174,1058 @@ func convertContainersToModel(containers []corev1.Container) []model.Container {
 	return newContainers
 }
 
+func convertPodStatusToModel(status *corev1.PodStatus) model.PodStatus {
+	conditions := make([]model.PodCondition, 0, len(status.Conditions))
+	for _, v := range status.Conditions {
+		conditions = append(conditions, model.PodCondition{
+			Type:               model.PodConditionType(v.Type),
+			Status:             model.ConditionStatus(v.Status),
+			LastProbeTime:      v.LastProbeTime.Time,
+			LastTransitionTime: v.LastTransitionTime.Time,
+			Reason:             v.Reason,
+			Message:            v.Message,
+		})
+	}
+
+	podIPs := make([]model.PodIP, 0, len(status.PodIPs))
+	for _, v := range status.PodIPs {
+		podIPs = append(podIPs, model.PodIP{IP: v.IP})
+	}
+
+	var startTime *time.Time
+	if status.StartTime != nil {
+		startTime = &status.StartTime.Time
+	}
+
+	return model.PodStatus{
+		Phase:                 model.PodPhase(status.Phase),
+		Message:               status.Message,
+		Reason:                status.Reason,
+		NominatedNodeName:     status.NominatedNodeName,
+		HostIP:                status.HostIP,
+		PodIP:                 status.PodIP,
+		PodIPs:                podIPs,
+		StartTime:             startTime,
+		Conditions:            conditions,
+		InitContainerStatuses: convertContainerStatusesToModel(status.InitContainerStatuses),
+		ContainerStatuses:     convertContainerStatusesToModel(status.ContainerStatuses),
+		QOSClass:              model.PodQOSClass(status.QOSClass),
+	}
+}
+
+func convertContainerStatusesToModel(statuses []corev1.ContainerStatus) []model.ContainerStatus {
+	newStatuses := make([]model.ContainerStatus, 0, len(statuses))
+	for _, v := range statuses {
+		newStatuses = append(newStatuses, model.ContainerStatus{
+			Name:                 v.Name,
+			State:                convertContainerStateToModel(v.State),
+			LastTerminationState: convertContainerStateToModel(v.LastTerminationState),
+			Ready:                v.Ready,
+			RestartCount:         v.RestartCount,
+			Image:                v.Image,
+			ImageID:              v.ImageID,
+			ContainerID:          v.ContainerID,
+			Started:              v.Started,
+		})
+	}
+	return newStatuses
+}
+
+func convertContainerStateToModel(state corev1.ContainerState) model.ContainerState {
+	var newState model.ContainerState
+	if state.Waiting != nil {
+		newState.Waiting = &model.ContainerStateWaiting{
+			Reason:  state.Waiting.Reason,
+			Message: state.Waiting.Message,
+		}
+	}
+	if state.Running != nil {
+		newState.Running = &model.ContainerStateRunning{
+			StartedAt: state.Running.StartedAt.Time,
+		}
+	}
+	if state.Terminated != nil {
+		newState.Terminated = &model.ContainerStateTerminated{
+			ExitCode:    state.Terminated.ExitCode,
+			Signal:      state.Terminated.Signal,
+			Reason:      state.Terminated.Reason,
+			Message:     state.Terminated.Message,
+			StartedAt:   state.Terminated.StartedAt.Time,
+			FinishedAt:  state.Terminated.FinishedAt.Time,
+			ContainerID: state.Terminated.ContainerID,
+		}
+	}
+	return newState
+}
+
+func convertContainerPortsToKube(ports []model.ContainerPort) []corev1.ContainerPort {
+	newPorts := make([]corev1.ContainerPort, 0, len(ports))
+	for _, v := range ports {
+		newPorts = append(newPorts, corev1.ContainerPort{
+			Name:          v.Name,
+			HostPort:      v.HostPort,
+			ContainerPort: v.ContainerPort,
+			Protocol:      corev1.Protocol(v.Protocol),
+			HostIP:        v.HostIP,
+		})
+	}
+	return newPorts
+}
+
+func convertContainerPortsToModel(ports []corev1.ContainerPort) []model.ContainerPort {
+	newPorts := make([]model.ContainerPort, 0, len(ports))
+	for _, v := range ports {
+		newPorts = append(newPorts, model.ContainerPort{
+			Name:          v.Name,
+			HostPort:      v.HostPort,
+			ContainerPort: v.ContainerPort,
+			Protocol:      model.Protocol(v.Protocol),
+			HostIP:        v.HostIP,
+		})
+	}
+	return newPorts
+}
+
+func convertEnvToKube(env []model.EnvVar) []corev1.EnvVar {
+	newEnv := make([]corev1.EnvVar, 0, len(env))
+	for _, v := range env {
+		newEnv = append(newEnv, corev1.EnvVar{
+			Name:      v.Name,
+			Value:     v.Value,
+			ValueFrom: convertEnvVarSourceToKube(v.ValueFrom),
+		})
+	}
+	return newEnv
+}
+
+func convertEnvToModel(env []corev1.EnvVar) []model.EnvVar {
+	newEnv := make([]model.EnvVar, 0, len(env))
+	for _, v := range env {
+		newEnv = append(newEnv, model.EnvVar{
+			Name:      v.Name,
+			Value:     v.Value,
+			ValueFrom: convertEnvVarSourceToModel(v.ValueFrom),
+		})
+	}
+	return newEnv
+}
+
+func convertEnvVarSourceToKube(source *model.EnvVarSource) *corev1.EnvVarSource {
+	if source == nil {
+		return nil
+	}
+
+	newSource := &corev1.EnvVarSource{
+		FieldRef:         convertObjectFieldSelectorToKube(source.FieldRef),
+		ResourceFieldRef: convertResourceFieldSelectorToKube(source.ResourceFieldRef),
+	}
+	if ref := source.ConfigMapKeyRef; ref != nil {
+		newSource.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+			Key:                  ref.Key,
+			Optional:             ref.Optional,
+		}
+	}
+	if ref := source.SecretKeyRef; ref != nil {
+		newSource.SecretKeyRef = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+			Key:                  ref.Key,
+			Optional:             ref.Optional,
+		}
+	}
+	return newSource
+}
+
+func convertEnvVarSourceToModel(source *corev1.EnvVarSource) *model.EnvVarSource {
+	if source == nil {
+		return nil
+	}
+
+	newSource := &model.EnvVarSource{
+		FieldRef:         convertObjectFieldSelectorToModel(source.FieldRef),
+		ResourceFieldRef: convertResourceFieldSelectorToModel(source.ResourceFieldRef),
+	}
+	if ref := source.ConfigMapKeyRef; ref != nil {
+		newSource.ConfigMapKeyRef = &model.ConfigMapKeySelector{
+			LocalObjectReference: model.LocalObjectReference{Name: ref.Name},
+			Key:                  ref.Key,
+			Optional:             ref.Optional,
+		}
+	}
+	if ref := source.SecretKeyRef; ref != nil {
+		newSource.SecretKeyRef = &model.SecretKeySelector{
+			LocalObjectReference: model.LocalObjectReference{Name: ref.Name},
+			Key:                  ref.Key,
+			Optional:             ref.Optional,
+		}
+	}
+	return newSource
+}
+
+func convertEnvFromToKube(envFrom []model.EnvFromSource) []corev1.EnvFromSource {
+	newEnvFrom := make([]corev1.EnvFromSource, 0, len(envFrom))
+	for _, v := range envFrom {
+		source := corev1.EnvFromSource{Prefix: v.Prefix}
+		if ref := v.ConfigMapRef; ref != nil {
+			source.ConfigMapRef = &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		if ref := v.SecretRef; ref != nil {
+			source.SecretRef = &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		newEnvFrom = append(newEnvFrom, source)
+	}
+	return newEnvFrom
+}
+
+func convertEnvFromToModel(envFrom []corev1.EnvFromSource) []model.EnvFromSource {
+	newEnvFrom := make([]model.EnvFromSource, 0, len(envFrom))
+	for _, v := range envFrom {
+		source := model.EnvFromSource{Prefix: v.Prefix}
+		if ref := v.ConfigMapRef; ref != nil {
+			source.ConfigMapRef = &model.ConfigMapEnvSource{
+				LocalObjectReference: model.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		if ref := v.SecretRef; ref != nil {
+			source.SecretRef = &model.SecretEnvSource{
+				LocalObjectReference: model.LocalObjectReference{Name: ref.Name},
+				Optional:             ref.Optional,
+			}
+		}
+		newEnvFrom = append(newEnvFrom, source)
+	}
+	return newEnvFrom
+}
+
+func convertResourcesToKube(resources model.ResourceRequirements) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Limits:   convertResourceListToKube(resources.Limits),
+		Requests: convertResourceListToKube(resources.Requests),
+	}
+}
+
+func convertResourcesToModel(resources corev1.ResourceRequirements) model.ResourceRequirements {
+	return model.ResourceRequirements{
+		Limits:   convertResourceListToModel(resources.Limits),
+		Requests: convertResourceListToModel(resources.Requests),
+	}
+}
+
+// convertResourceListToKube skips the quantities failing to parse, the requests are validated beforehand.
+func convertResourceListToKube(list model.ResourceList) corev1.ResourceList {
+	if len(list) == 0 {
+		return nil
+	}
+
+	newList := make(corev1.ResourceList, len(list))
+	for k, v := range list {
+		quantity, err := resource.ParseQuantity(v)
+		if err != nil {
+			continue
+		}
+		newList[corev1.ResourceName(k)] = quantity
+	}
+	return newList
+}
+
+func convertResourceListToModel(list corev1.ResourceList) model.ResourceList {
+	if len(list) == 0 {
+		return nil
+	}
+
+	newList := make(model.ResourceList, len(list))
+	for k, v := range list {
+		newList[model.ResourceName(k)] = v.String()
+	}
+	return newList
+}
+
+func convertQuantityToKube(quantity *string) *resource.Quantity {
+	if quantity == nil {
+		return nil
+	}
+
+	newQuantity, err := resource.ParseQuantity(*quantity)
+	if err != nil {
+		return nil
+	}
+	return &newQuantity
+}
+
+func convertQuantityToModel(quantity *resource.Quantity) *string {
+	if quantity == nil {
+		return nil
+	}
+
+	newQuantity := quantity.String()
+	return &newQuantity
+}
+
+func convertVolumeMountsToKube(mounts []model.VolumeMount) []corev1.VolumeMount {
+	newMounts := make([]corev1.VolumeMount, 0, len(mounts))
+	for _, v := range mounts {
+		newMounts = append(newMounts, corev1.VolumeMount{
+			Name:      v.Name,
+			MountPath: v.MountPath,
+			SubPath:   v.SubPath,
+			ReadOnly:  v.ReadOnly,
+		})
+	}
+	return newMounts
+}
+
+func convertVolumeMountsToModel(mounts []corev1.VolumeMount) []model.VolumeMount {
+	newMounts := make([]model.VolumeMount, 0, len(mounts))
+	for _, v := range mounts {
+		newMounts = append(newMounts, model.VolumeMount{
+			Name:      v.Name,
+			MountPath: v.MountPath,
+			SubPath:   v.SubPath,
+			ReadOnly:  v.ReadOnly,
+		})
+	}
+	return newMounts
+}
+
+func convertProbeToKube(probe *model.Probe) *corev1.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	newProbe := &corev1.Probe{
+		InitialDelaySeconds:           probe.InitialDelaySeconds,
+		TimeoutSeconds:                probe.TimeoutSeconds,
+		PeriodSeconds:                 probe.PeriodSeconds,
+		SuccessThreshold:              probe.SuccessThreshold,
+		FailureThreshold:              probe.FailureThreshold,
+		TerminationGracePeriodSeconds: probe.TerminationGracePeriodSeconds,
+	}
+
+	if probe.Exec != nil {
+		newProbe.Exec = &corev1.ExecAction{Command: probe.Exec.Command}
+	}
+	if probe.HTTPGet != nil {
+		headers := make([]corev1.HTTPHeader, 0, len(probe.HTTPGet.HTTPHeaders))
+		for _, v := range probe.HTTPGet.HTTPHeaders {
+			headers = append(headers, corev1.HTTPHeader{Name: v.Name, Value: v.Value})
+		}
+
+		newProbe.HTTPGet = &corev1.HTTPGetAction{
+			Path:        probe.HTTPGet.Path,
+			Port:        probe.HTTPGet.Port,
+			Host:        probe.HTTPGet.Host,
+			Scheme:      corev1.URIScheme(probe.HTTPGet.Scheme),
+			HTTPHeaders: headers,
+		}
+	}
+	if probe.TCPSocket != nil {
+		newProbe.TCPSocket = &corev1.TCPSocketAction{
+			Port: probe.TCPSocket.Port,
+			Host: probe.TCPSocket.Host,
+		}
+	}
+	if probe.GRPC != nil {
+		newProbe.GRPC = &corev1.GRPCAction{
+			Port:    probe.GRPC.Port,
+			Service: probe.GRPC.Service,
+		}
+	}
+
+	return newProbe
+}
+
+func convertProbeToModel(probe *corev1.Probe) *model.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	newProbe := &model.Probe{
+		InitialDelaySeconds:           probe.InitialDelaySeconds,
+		TimeoutSeconds:                probe.TimeoutSeconds,
+		PeriodSeconds:                 probe.PeriodSeconds,
+		SuccessThreshold:              probe.SuccessThreshold,
+		FailureThreshold:              probe.FailureThreshold,
+		TerminationGracePeriodSeconds: probe.TerminationGracePeriodSeconds,
+	}
+
+	if probe.Exec != nil {
+		newProbe.Exec = &model.ExecAction{Command: probe.Exec.Command}
+	}
+	if probe.HTTPGet != nil {
+		headers := make([]model.HTTPHeader, 0, len(probe.HTTPGet.HTTPHeaders))
+		for _, v := range probe.HTTPGet.HTTPHeaders {
+			headers = append(headers, model.HTTPHeader{Name: v.Name, Value: v.Value})
+		}
+
+		newProbe.HTTPGet = &model.HTTPGetAction{
+			Path:        probe.HTTPGet.Path,
+			Port:        probe.HTTPGet.Port,
+			Host:        probe.HTTPGet.Host,
+			Scheme:      model.URIScheme(probe.HTTPGet.Scheme),
+			HTTPHeaders: headers,
+		}
+	}
+	if probe.TCPSocket != nil {
+		newProbe.TCPSocket = &model.TCPSocketAction{
+			Port: probe.TCPSocket.Port,
+			Host: probe.TCPSocket.Host,
+		}
+	}
+	if probe.GRPC != nil {
+		newProbe.GRPC = &model.GRPCAction{
+			Port:    probe.GRPC.Port,
+			Service: probe.GRPC.Service,
+		}
+	}
+
+	return newProbe
+}
+
+func convertSecurityContextToKube(securityContext *model.SecurityContext) *corev1.SecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	newSecurityContext := &corev1.SecurityContext{
+		Privileged:               securityContext.Privileged,
+		RunAsUser:                securityContext.RunAsUser,
+		RunAsGroup:               securityContext.RunAsGroup,
+		RunAsNonRoot:             securityContext.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   securityContext.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: securityContext.AllowPrivilegeEscalation,
+		SeccompProfile:           convertSeccompProfileToKube(securityContext.SeccompProfile),
+	}
+
+	if capabilities := securityContext.Capabilities; capabilities != nil {
+		newSecurityContext.Capabilities = &corev1.Capabilities{}
+		for _, v := range capabilities.Add {
+			newSecurityContext.Capabilities.Add = append(newSecurityContext.Capabilities.Add, corev1.Capability(v))
+		}
+		for _, v := range capabilities.Drop {
+			newSecurityContext.Capabilities.Drop = append(newSecurityContext.Capabilities.Drop, corev1.Capability(v))
+		}
+	}
+
+	return newSecurityContext
+}
+
+func convertSecurityContextToModel(securityContext *corev1.SecurityContext) *model.SecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	newSecurityContext := &model.SecurityContext{
+		Privileged:               securityContext.Privileged,
+		RunAsUser:                securityContext.RunAsUser,
+		RunAsGroup:               securityContext.RunAsGroup,
+		RunAsNonRoot:             securityContext.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   securityContext.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: securityContext.AllowPrivilegeEscalation,
+		SeccompProfile:           convertSeccompProfileToModel(securityContext.SeccompProfile),
+	}
+
+	if capabilities := securityContext.Capabilities; capabilities != nil {
+		newSecurityContext.Capabilities = &model.Capabilities{}
+		for _, v := range capabilities.Add {
+			newSecurityContext.Capabilities.Add = append(newSecurityContext.Capabilities.Add, model.Capability(v))
+		}
+		for _, v := range capabilities.Drop {
+			newSecurityContext.Capabilities.Drop = append(newSecurityContext.Capabilities.Drop, model.Capability(v))
+		}
+	}
+
+	return newSecurityContext
+}
+
+func convertPodSecurityContextToKube(securityContext *model.PodSecurityContext) *corev1.PodSecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	sysctls := make([]corev1.Sysctl, 0, len(securityContext.Sysctls))
+	for _, v := range securityContext.Sysctls {
+		sysctls = append(sysctls, corev1.Sysctl{Name: v.Name, Value: v.Value})
+	}
+
+	return &corev1.PodSecurityContext{
+		RunAsUser:          securityContext.RunAsUser,
+		RunAsGroup:         securityContext.RunAsGroup,
+		RunAsNonRoot:       securityContext.RunAsNonRoot,
+		FSGroup:            securityContext.FSGroup,
+		SupplementalGroups: securityContext.SupplementalGroups,
+		SeccompProfile:     convertSeccompProfileToKube(securityContext.SeccompProfile),
+		Sysctls:            sysctls,
+	}
+}
+
+func convertPodSecurityContextToModel(securityContext *corev1.PodSecurityContext) *model.PodSecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	sysctls := make([]model.Sysctl, 0, len(securityContext.Sysctls))
+	for _, v := range securityContext.Sysctls {
+		sysctls = append(sysctls, model.Sysctl{Name: v.Name, Value: v.Value})
+	}
+
+	return &model.PodSecurityContext{
+		RunAsUser:          securityContext.RunAsUser,
+		RunAsGroup:         securityContext.RunAsGroup,
+		RunAsNonRoot:       securityContext.RunAsNonRoot,
+		FSGroup:            securityContext.FSGroup,
+		SupplementalGroups: securityContext.SupplementalGroups,
+		SeccompProfile:     convertSeccompProfileToModel(securityContext.SeccompProfile),
+		Sysctls:            sysctls,
+	}
+}
+
+func convertSeccompProfileToKube(profile *model.SeccompProfile) *corev1.SeccompProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &corev1.SeccompProfile{
+		Type:             corev1.SeccompProfileType(profile.Type),
+		LocalhostProfile: profile.LocalhostProfile,
+	}
+}
+
+func convertSeccompProfileToModel(profile *corev1.SeccompProfile) *model.SeccompProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &model.SeccompProfile{
+		Type:             model.SeccompProfileType(profile.Type),
+		LocalhostProfile: profile.LocalhostProfile,
+	}
+}
+
+func convertLocalObjectReferencesToKube(references []model.LocalObjectReference) []corev1.LocalObjectReference {
+	newReferences := make([]corev1.LocalObjectReference, 0, len(references))
+	for _, v := range references {
+		newReferences = append(newReferences, corev1.LocalObjectReference{Name: v.Name})
+	}
+	return newReferences
+}
+
+func convertLocalObjectReferencesToModel(references []corev1.LocalObjectReference) []model.LocalObjectReference {
+	newReferences := make([]model.LocalObjectReference, 0, len(references))
+	for _, v := range references {
+		newReferences = append(newReferences, model.LocalObjectReference{Name: v.Name})
+	}
+	return newReferences
+}
+
+func convertAffinityToKube(affinity *model.Affinity) *corev1.Affinity {
+	if affinity == nil {
+		return nil
+	}
+
+	newAffinity := &corev1.Affinity{}
+
+	if nodeAffinity := affinity.NodeAffinity; nodeAffinity != nil {
+		newAffinity.NodeAffinity = &corev1.NodeAffinity{}
+		if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			terms := make([]corev1.NodeSelectorTerm, 0, len(required.NodeSelectorTerms))
+			for _, v := range required.NodeSelectorTerms {
+				terms = append(terms, convertNodeSelectorTermToKube(v))
+			}
+			newAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{NodeSelectorTerms: terms}
+		}
+		for _, v := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			newAffinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(newAffinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, corev1.PreferredSchedulingTerm{
+				Weight:     v.Weight,
+				Preference: convertNodeSelectorTermToKube(v.Preference),
+			})
+		}
+	}
+
+	if podAffinity := affinity.PodAffinity; podAffinity != nil {
+		newAffinity.PodAffinity = &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  convertPodAffinityTermsToKube(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution),
+			PreferredDuringSchedulingIgnoredDuringExecution: convertWeightedPodAffinityTermsToKube(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		}
+	}
+
+	if podAntiAffinity := affinity.PodAntiAffinity; podAntiAffinity != nil {
+		newAffinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  convertPodAffinityTermsToKube(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution),
+			PreferredDuringSchedulingIgnoredDuringExecution: convertWeightedPodAffinityTermsToKube(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		}
+	}
+
+	return newAffinity
+}
+
+func convertAffinityToModel(affinity *corev1.Affinity) *model.Affinity {
+	if affinity == nil {
+		return nil
+	}
+
+	newAffinity := &model.Affinity{}
+
+	if nodeAffinity := affinity.NodeAffinity; nodeAffinity != nil {
+		newAffinity.NodeAffinity = &model.NodeAffinity{}
+		if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			terms := make([]model.NodeSelectorTerm, 0, len(required.NodeSelectorTerms))
+			for _, v := range required.NodeSelectorTerms {
+				terms = append(terms, convertNodeSelectorTermToModel(v))
+			}
+			newAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &model.NodeSelector{NodeSelectorTerms: terms}
+		}
+		for _, v := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			newAffinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(newAffinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, model.PreferredSchedulingTerm{
+				Weight:     v.Weight,
+				Preference: convertNodeSelectorTermToModel(v.Preference),
+			})
+		}
+	}
+
+	if podAffinity := affinity.PodAffinity; podAffinity != nil {
+		newAffinity.PodAffinity = &model.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  convertPodAffinityTermsToModel(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution),
+			PreferredDuringSchedulingIgnoredDuringExecution: convertWeightedPodAffinityTermsToModel(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		}
+	}
+
+	if podAntiAffinity := affinity.PodAntiAffinity; podAntiAffinity != nil {
+		newAffinity.PodAntiAffinity = &model.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  convertPodAffinityTermsToModel(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution),
+			PreferredDuringSchedulingIgnoredDuringExecution: convertWeightedPodAffinityTermsToModel(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		}
+	}
+
+	return newAffinity
+}
+
+func convertNodeSelectorTermToKube(term model.NodeSelectorTerm) corev1.NodeSelectorTerm {
+	return corev1.NodeSelectorTerm{
+		MatchExpressions: convertNodeSelectorRequirementsToKube(term.MatchExpressions),
+		MatchFields:      convertNodeSelectorRequirementsToKube(term.MatchFields),
+	}
+}
+
+func convertNodeSelectorTermToModel(term corev1.NodeSelectorTerm) model.NodeSelectorTerm {
+	return model.NodeSelectorTerm{
+		MatchExpressions: convertNodeSelectorRequirementsToModel(term.MatchExpressions),
+		MatchFields:      convertNodeSelectorRequirementsToModel(term.MatchFields),
+	}
+}
+
+func convertNodeSelectorRequirementsToKube(requirements []model.NodeSelectorRequirement) []corev1.NodeSelectorRequirement {
+	newRequirements := make([]corev1.NodeSelectorRequirement, 0, len(requirements))
+	for _, v := range requirements {
+		newRequirements = append(newRequirements, corev1.NodeSelectorRequirement{
+			Key:      v.Key,
+			Operator: corev1.NodeSelectorOperator(v.Operator),
+			Values:   v.Values,
+		})
+	}
+	return newRequirements
+}
+
+func convertNodeSelectorRequirementsToModel(requirements []corev1.NodeSelectorRequirement) []model.NodeSelectorRequirement {
+	newRequirements := make([]model.NodeSelectorRequirement, 0, len(requirements))
+	for _, v := range requirements {
+		newRequirements = append(newRequirements, model.NodeSelectorRequirement{
+			Key:      v.Key,
+			Operator: model.NodeSelectorOperator(v.Operator),
+			Values:   v.Values,
+		})
+	}
+	return newRequirements
+}
+
+func convertPodAffinityTermsToKube(terms []model.PodAffinityTerm) []corev1.PodAffinityTerm {
+	newTerms := make([]corev1.PodAffinityTerm, 0, len(terms))
+	for _, v := range terms {
+		newTerms = append(newTerms, convertPodAffinityTermToKube(v))
+	}
+	return newTerms
+}
+
+func convertPodAffinityTermsToModel(terms []corev1.PodAffinityTerm) []model.PodAffinityTerm {
+	newTerms := make([]model.PodAffinityTerm, 0, len(terms))
+	for _, v := range terms {
+		newTerms = append(newTerms, convertPodAffinityTermToModel(v))
+	}
+	return newTerms
+}
+
+func convertWeightedPodAffinityTermsToKube(terms []model.WeightedPodAffinityTerm) []corev1.WeightedPodAffinityTerm {
+	newTerms := make([]corev1.WeightedPodAffinityTerm, 0, len(terms))
+	for _, v := range terms {
+		newTerms = append(newTerms, corev1.WeightedPodAffinityTerm{
+			Weight:          v.Weight,
+			PodAffinityTerm: convertPodAffinityTermToKube(v.PodAffinityTerm),
+		})
+	}
+	return newTerms
+}
+
+func convertWeightedPodAffinityTermsToModel(terms []corev1.WeightedPodAffinityTerm) []model.WeightedPodAffinityTerm {
+	newTerms := make([]model.WeightedPodAffinityTerm, 0, len(terms))
+	for _, v := range terms {
+		newTerms = append(newTerms, model.WeightedPodAffinityTerm{
+			Weight:          v.Weight,
+			PodAffinityTerm: convertPodAffinityTermToModel(v.PodAffinityTerm),
+		})
+	}
+	return newTerms
+}
+
+func convertPodAffinityTermToKube(term model.PodAffinityTerm) corev1.PodAffinityTerm {
+	return corev1.PodAffinityTerm{
+		LabelSelector:     convertLabelSelectorToKube(term.LabelSelector),
+		Namespaces:        term.Namespaces,
+		NamespaceSelector: convertLabelSelectorToKube(term.NamespaceSelector),
+		TopologyKey:       term.TopologyKey,
+	}
+}
+
+func convertPodAffinityTermToModel(term corev1.PodAffinityTerm) model.PodAffinityTerm {
+	return model.PodAffinityTerm{
+		LabelSelector:     convertLabelSelectorToModel(term.LabelSelector),
+		Namespaces:        term.Namespaces,
+		NamespaceSelector: convertLabelSelectorToModel(term.NamespaceSelector),
+		TopologyKey:       term.TopologyKey,
+	}
+}
+
+func convertLabelSelectorToKube(selector *model.LabelSelector) *metav1.LabelSelector {
+	if selector == nil {
+		return nil
+	}
+
+	requirements := make([]metav1.LabelSelectorRequirement, 0, len(selector.MatchExpressions))
+	for _, v := range selector.MatchExpressions {
+		requirements = append(requirements, metav1.LabelSelectorRequirement{
+			Key:      v.Key,
+			Operator: metav1.LabelSelectorOperator(v.Operator),
+			Values:   v.Values,
+		})
+	}
+
+	return &metav1.LabelSelector{
+		MatchLabels:      selector.MatchLabels,
+		MatchExpressions: requirements,
+	}
+}
+
+func convertLabelSelectorToModel(selector *metav1.LabelSelector) *model.LabelSelector {
+	if selector == nil {
+		return nil
+	}
+
+	requirements := make([]model.LabelSelectorRequirement, 0, len(selector.MatchExpressions))
+	for _, v := range selector.MatchExpressions {
+		requirements = append(requirements, model.LabelSelectorRequirement{
+			Key:      v.Key,
+			Operator: model.LabelSelectorOperator(v.Operator),
+			Values:   v.Values,
+		})
+	}
+
+	return &model.LabelSelector{
+		MatchLabels:      selector.MatchLabels,
+		MatchExpressions: requirements,
+	}
+}
+
+func convertVolumesToKube(volumes []model.Volume) []corev1.Volume {
+	newVolumes := make([]corev1.Volume, 0, len(volumes))
+	for _, v := range volumes {
+		newVolumes = append(newVolumes, corev1.Volume{
+			Name:         v.Name,
+			VolumeSource: convertVolumeSourceToKube(v.VolumeSource),
+		})
+	}
+	return newVolumes
+}
+
+func convertVolumesToModel(volumes []corev1.Volume) []model.Volume {
+	newVolumes := make([]model.Volume, 0, len(volumes))
+	for _, v := range volumes {
+		newVolumes = append(newVolumes, model.Volume{
+			Name:         v.Name,
+			VolumeSource: convertVolumeSourceToModel(v.VolumeSource),
+		})
+	}
+	return newVolumes
+}
+
+func convertVolumeSourceToKube(source model.VolumeSource) corev1.VolumeSource {
+	newSource := corev1.VolumeSource{}
+
+	if emptyDir := source.EmptyDir; emptyDir != nil {
+		newSource.EmptyDir = &corev1.EmptyDirVolumeSource{
+			Medium:    corev1.StorageMedium(emptyDir.Medium),
+			SizeLimit: convertQuantityToKube(emptyDir.SizeLimit),
+		}
+	}
+	if hostPath := source.HostPath; hostPath != nil {
+		newSource.HostPath = &corev1.HostPathVolumeSource{
+			Path: hostPath.Path,
+			Type: (*corev1.HostPathType)(hostPath.Type),
+		}
+	}
+	if configMap := source.ConfigMap; configMap != nil {
+		newSource.ConfigMap = &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+			Items:                convertKeyToPathsToKube(configMap.Items),
+			DefaultMode:          configMap.DefaultMode,
+			Optional:             configMap.Optional,
+		}
+	}
+	if secret := source.Secret; secret != nil {
+		newSource.Secret = &corev1.SecretVolumeSource{
+			SecretName:  secret.SecretName,
+			Items:       convertKeyToPathsToKube(secret.Items),
+			DefaultMode: secret.DefaultMode,
+			Optional:    secret.Optional,
+		}
+	}
+	if claim := source.PersistentVolumeClaim; claim != nil {
+		newSource.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: claim.ClaimName,
+			ReadOnly:  claim.ReadOnly,
+		}
+	}
+	if downwardAPI := source.DownwardAPI; downwardAPI != nil {
+		newSource.DownwardAPI = &corev1.DownwardAPIVolumeSource{
+			Items:       convertDownwardAPIFilesToKube(downwardAPI.Items),
+			DefaultMode: downwardAPI.DefaultMode,
+		}
+	}
+	if projected := source.Projected; projected != nil {
+		sources := make([]corev1.VolumeProjection, 0, len(projected.Sources))
+		for _, v := range projected.Sources {
+			projection := corev1.VolumeProjection{}
+			if v.Secret != nil {
+				projection.Secret = &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: v.Secret.Name},
+					Items:                convertKeyToPathsToKube(v.Secret.Items),
+					Optional:             v.Secret.Optional,
+				}
+			}
+			if v.ConfigMap != nil {
+				projection.ConfigMap = &corev1.ConfigMapProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: v.ConfigMap.Name},
+					Items:                convertKeyToPathsToKube(v.ConfigMap.Items),
+					Optional:             v.ConfigMap.Optional,
+				}
+			}
+			if v.DownwardAPI != nil {
+				projection.DownwardAPI = &corev1.DownwardAPIProjection{
+					Items: convertDownwardAPIFilesToKube(v.DownwardAPI.Items),
+				}
+			}
+			if v.ServiceAccountToken != nil {
+				projection.ServiceAccountToken = &corev1.ServiceAccountTokenProjection{
+					Audience:          v.ServiceAccountToken.Audience,
+					ExpirationSeconds: v.ServiceAccountToken.ExpirationSeconds,
+					Path:              v.ServiceAccountToken.Path,
+				}
+			}
+			sources = append(sources, projection)
+		}
+
+		newSource.Projected = &corev1.ProjectedVolumeSource{
+			Sources:     sources,
+			DefaultMode: projected.DefaultMode,
+		}
+	}
+
+	return newSource
+}
+
+func convertVolumeSourceToModel(source corev1.VolumeSource) model.VolumeSource {
+	newSource := model.VolumeSource{}
+
+	if emptyDir := source.EmptyDir; emptyDir != nil {
+		newSource.EmptyDir = &model.EmptyDirVolumeSource{
+			Medium:    model.StorageMedium(emptyDir.Medium),
+			SizeLimit: convertQuantityToModel(emptyDir.SizeLimit),
+		}
+	}
+	if hostPath := source.HostPath; hostPath != nil {
+		newSource.HostPath = &model.HostPathVolumeSource{
+			Path: hostPath.Path,
+			Type: (*model.HostPathType)(hostPath.Type),
+		}
+	}
+	if configMap := source.ConfigMap; configMap != nil {
+		newSource.ConfigMap = &model.ConfigMapVolumeSource{
+			LocalObjectReference: model.LocalObjectReference{Name: configMap.Name},
+			Items:                convertKeyToPathsToModel(configMap.Items),
+			DefaultMode:          configMap.DefaultMode,
+			Optional:             configMap.Optional,
+		}
+	}
+	if secret := source.Secret; secret != nil {
+		newSource.Secret = &model.SecretVolumeSource{
+			SecretName:  secret.SecretName,
+			Items:       convertKeyToPathsToModel(secret.Items),
+			DefaultMode: secret.DefaultMode,
+			Optional:    secret.Optional,
+		}
+	}
+	if claim := source.PersistentVolumeClaim; claim != nil {
+		newSource.PersistentVolumeClaim = &model.PersistentVolumeClaimVolumeSource{
+			ClaimName: claim.ClaimName,
+			ReadOnly:  claim.ReadOnly,
+		}
+	}
+	if downwardAPI := source.DownwardAPI; downwardAPI != nil {
+		newSource.DownwardAPI = &model.DownwardAPIVolumeSource{
+			Items:       convertDownwardAPIFilesToModel(downwardAPI.Items),
+			DefaultMode: downwardAPI.DefaultMode,
+		}
+	}
+	if projected := source.Projected; projected != nil {
+		sources := make([]model.VolumeProjection, 0, len(projected.Sources))
+		for _, v := range projected.Sources {
+			projection := model.VolumeProjection{}
+			if v.Secret != nil {
+				projection.Secret = &model.SecretProjection{
+					LocalObjectReference: model.LocalObjectReference{Name: v.Secret.Name},
+					Items:                convertKeyToPathsToModel(v.Secret.Items),
+					Optional:             v.Secret.Optional,
+				}
+			}
+			if v.ConfigMap != nil {
+				projection.ConfigMap = &model.ConfigMapProjection{
+					LocalObjectReference: model.LocalObjectReference{Name: v.ConfigMap.Name},
+					Items:                convertKeyToPathsToModel(v.ConfigMap.Items),
+					Optional:             v.ConfigMap.Optional,
+				}
+			}
+			if v.DownwardAPI != nil {
+				projection.DownwardAPI = &model.DownwardAPIProjection{
+					Items: convertDownwardAPIFilesToModel(v.DownwardAPI.Items),
+				}
+			}
+			if v.ServiceAccountToken != nil {
+				projection.ServiceAccountToken = &model.ServiceAccountTokenProjection{
+					Audience:          v.ServiceAccountToken.Audience,
+					ExpirationSeconds: v.ServiceAccountToken.ExpirationSeconds,
+					Path:              v.ServiceAccountToken.Path,
+				}
+			}
+			sources = append(sources, projection)
+		}
+
+		newSource.Projected = &model.ProjectedVolumeSource{
+			Sources:     sources,
+			DefaultMode: projected.DefaultMode,
+		}
+	}
+
+	return newSource
+}
+
+func convertKeyToPathsToKube(items []model.KeyToPath) []corev1.KeyToPath {
+	newItems := make([]corev1.KeyToPath, 0, len(items))
+	for _, v := range items {
+		newItems = append(newItems, corev1.KeyToPath{Key: v.Key, Path: v.Path, Mode: v.Mode})
+	}
+	return newItems
+}
+
+func convertKeyToPathsToModel(items []corev1.KeyToPath) []model.KeyToPath {
+	newItems := make([]model.KeyToPath, 0, len(items))
+	for _, v := range items {
+		newItems = append(newItems, model.KeyToPath{Key: v.Key, Path: v.Path, Mode: v.Mode})
+	}
+	return newItems
+}
+
+func convertDownwardAPIFilesToKube(files []model.DownwardAPIVolumeFile) []corev1.DownwardAPIVolumeFile {
+	newFiles := make([]corev1.DownwardAPIVolumeFile, 0, len(files))
+	for _, v := range files {
+		newFiles = append(newFiles, corev1.DownwardAPIVolumeFile{
+			Path:             v.Path,
+			FieldRef:         convertObjectFieldSelectorToKube(v.FieldRef),
+			ResourceFieldRef: convertResourceFieldSelectorToKube(v.ResourceFieldRef),
+			Mode:             v.Mode,
+		})
+	}
+	return newFiles
+}
+
+func convertDownwardAPIFilesToModel(files []corev1.DownwardAPIVolumeFile) []model.DownwardAPIVolumeFile {
+	newFiles := make([]model.DownwardAPIVolumeFile, 0, len(files))
+	for _, v := range files {
+		newFiles = append(newFiles, model.DownwardAPIVolumeFile{
+			Path:             v.Path,
+			FieldRef:         convertObjectFieldSelectorToModel(v.FieldRef),
+			ResourceFieldRef: convertResourceFieldSelectorToModel(v.ResourceFieldRef),
+			Mode:             v.Mode,
+		})
+	}
+	return newFiles
+}
+
+func convertObjectFieldSelectorToKube(selector *model.ObjectFieldSelector) *corev1.ObjectFieldSelector {
+	if selector == nil {
+		return nil
+	}
+
+	return &corev1.ObjectFieldSelector{
+		APIVersion: selector.APIVersion,
+		FieldPath:  selector.FieldPath,
+	}
+}
+
+func convertObjectFieldSelectorToModel(selector *corev1.ObjectFieldSelector) *model.ObjectFieldSelector {
+	if selector == nil {
+		return nil
+	}
+
+	return &model.ObjectFieldSelector{
+		APIVersion: selector.APIVersion,
+		FieldPath:  selector.FieldPath,
+	}
+}
+
+func convertResourceFieldSelectorToKube(selector *model.ResourceFieldSelector) *corev1.ResourceFieldSelector {
+	if selector == nil {
+		return nil
+	}
+
+	newSelector := &corev1.ResourceFieldSelector{
+		ContainerName: selector.ContainerName,
+		Resource:      selector.Resource,
+	}
+	if divisor := convertQuantityToKube(selector.Divisor); divisor != nil {
+		newSelector.Divisor = *divisor
+	}
+	return newSelector
+}
+
+func convertResourceFieldSelectorToModel(selector *corev1.ResourceFieldSelector) *model.ResourceFieldSelector {
+	if selector == nil {
+		return nil
+	}
+
+	newSelector := &model.ResourceFieldSelector{
+		ContainerName: selector.ContainerName,
+		Resource:      selector.Resource,
+	}
+	if !selector.Divisor.IsZero() {
+		newSelector.Divisor = convertQuantityToModel(&selector.Divisor)
+	}
+	return newSelector
+}
+
 func convertTemplateToKube(template *model.PodTemplateSpec) corev1.PodTemplateSpec {
 	return corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
@@ -122,12 +1233,7 @@ func convertTemplateToKube(template *model.PodTemplateSpec) corev1.PodTemplateSp
 			Labels:      template.ObjectMeta.Labels,
 			Annotations: template.ObjectMeta.Annotations,
 		},
-		Spec: corev1.PodSpec{
-			Containers:            convertContainersToKube(template.Spec.Containers),
-			InitContainers:        convertContainersToKube(template.Spec.InitContainers),
-			Tolerations:           convertTolerationsToKube(template.Spec.Tolerations),
-			ActiveDeadlineSeconds: template.Spec.ActiveDeadlineSeconds,
-		},
+		Spec: convertPodSpecToKube(&template.Spec),
 	}
 }
 
@@ -138,12 +1244,7 @@ func convertTemplateToModel(deployment *v1.Deployment) model.PodTemplateSpec {
 			Labels:      deployment.Spec.Template.ObjectMeta.Labels,
 			Annotations: deployment.Spec.Template.ObjectMeta.Annotations,
 		},
-		Spec: model.PodSpec{
-			Containers:            convertContainersToModel(deployment.Spec.Template.Spec.Containers),
-			InitContainers:        convertContainersToModel(deployment.Spec.Template.Spec.InitContainers),
-			Tolerations:           convertTolerationsToModel(deployment.Spec.Template.Spec.Tolerations),
-			ActiveDeadlineSeconds: deployment.Spec.Template.Spec.ActiveDeadlineSeconds,
-		},
+		Spec: convertPodSpecToModel(&deployment.Spec.Template.Spec),
 	}
 	return template
 }
@@ -176,34 +1277,52 @@ func convertUpdateOptsToKube(opts model.UpdateOptions) metav1.UpdateOptions {
 	return updateOptions
 }
 
-func convertDeleteOptsToKube(opts model.DeleteOptions) metav1.DeleteOptions {
-	gracePeriodSeconds := new(int64)
-	if opts.GracePeriodSeconds != nil {
-		gracePeriodSeconds = opts.GracePeriodSeconds
+func convertPatchOptsToKube(opts model.PatchOptions) metav1.PatchOptions {
+	patchOptions := metav1.PatchOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       opts.TypeMeta.Kind,
+			APIVersion: opts.TypeMeta.APIVersion,
+		},
+		DryRun:          opts.DryRun,
+		Force:           opts.Force,
+		FieldManager:    opts.FieldManager,
+		FieldValidation: opts.FieldValidation,
 	}
+	return patchOptions
+}
 
+// convertDeleteOptsToKube leaves the grace period unset unless one is given, the pods then get
+// their own termination grace period instead of being killed at once.
+func convertDeleteOptsToKube(opts model.DeleteOptions) metav1.DeleteOptions {
 	metaOpts := metav1.DeleteOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       opts.TypeMeta.Kind,
 			APIVersion: opts.TypeMeta.APIVersion,
 		},
-		GracePeriodSeconds: gracePeriodSeconds,
+		GracePeriodSeconds: opts.GracePeriodSeconds,
 		DryRun:             opts.DryRun,
 	}
 
-	if opts.Preconditions == nil || opts.Preconditions.UID == nil {
-		return metaOpts
+	if opts.PropagationPolicy != nil {
+		propagationPolicy := metav1.DeletionPropagation(*opts.PropagationPolicy)
+		metaOpts.PropagationPolicy = &propagationPolicy
 	}
 
-	uidStr := *opts.Preconditions.UID
-	if err := uuid.Validate(string(uidStr)); err != nil {
+	if opts.Preconditions == nil {
 		return metaOpts
 	}
 
-	opts.Preconditions.UID = &uidStr
-	uidTypes := types.UID(uidStr)
-	metaOpts.Preconditions = &metav1.Preconditions{
-		UID: &uidTypes,
+	preconditions := metav1.Preconditions{
+		ResourceVersion: opts.Preconditions.ResourceVersion,
+	}
+
+	if uidStr := opts.Preconditions.UID; uidStr != nil && uuid.Validate(*uidStr) == nil {
+		uidTypes := types.UID(*uidStr)
+		preconditions.UID = &uidTypes
+	}
+
+	if preconditions.UID != nil || preconditions.ResourceVersion != nil {
+		metaOpts.Preconditions = &preconditions
 	}
 
 	return metaOpts
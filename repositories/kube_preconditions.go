@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// hasResourceVersionPrecondition tells if the operation may only run on a given version of the object.
+func hasResourceVersionPrecondition(preconditions *model.Preconditions) bool {
+	return preconditions != nil && preconditions.ResourceVersion != nil
+}
+
+// checkResourceVersion returns a conflict when the resourceVersion precondition does not match the
+// version of the object read before the write. It spares the request for stale objects, the write
+// itself must carry the precondition for the objects changing in between: updates and deletes send
+// it along, patches through patchWithResourceVersion.
+func checkResourceVersion(resource schema.GroupResource, name, resourceVersion string, preconditions *model.Preconditions) error {
+	if !hasResourceVersionPrecondition(preconditions) || *preconditions.ResourceVersion == resourceVersion {
+		return nil
+	}
+
+	return apierrors.NewConflict(resource, name, fmt.Errorf(
+		"the resourceVersion in the precondition (%s) does not match the resourceVersion in the object (%s)",
+		*preconditions.ResourceVersion, resourceVersion,
+	))
+}
+
+// patchWithResourceVersion sets the resourceVersion precondition in the metadata of the patch. The API
+// server doesn't check the preconditions of a patch, but refuses with a conflict the patched object
+// whose resourceVersion is not the stored one, and a server-side apply of a configuration with another
+// resourceVersion. Apply patches are YAML, they are returned as JSON which is YAML too.
+func patchWithResourceVersion(patchType model.PatchType, data []byte, preconditions *model.Preconditions) ([]byte, error) {
+	if !hasResourceVersionPrecondition(preconditions) {
+		return data, nil
+	}
+
+	if patchType == model.ApplyPatchType {
+		jsonData, err := sigsyaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid apply patch: %v", err))
+		}
+		data = jsonData
+	}
+
+	// numbers are kept as they are written
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var patch map[string]interface{}
+	if err := decoder.Decode(&patch); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid patch: %v", err))
+	}
+	if patch == nil {
+		return nil, apierrors.NewBadRequest("the patch must be an object")
+	}
+
+	metadata, ok := patch["metadata"].(map[string]interface{})
+	if !ok {
+		if patch["metadata"] != nil {
+			return nil, apierrors.NewBadRequest("the metadata of the patch must be an object")
+		}
+		metadata = map[string]interface{}{}
+	}
+	metadata["resourceVersion"] = *preconditions.ResourceVersion
+	patch["metadata"] = metadata
+
+	return json.Marshal(patch)
+}
@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+)
+
+// ManifestRepository reads and applies objects of any kind, finding their resource through the discovery
+// of the cluster. The discovered resources are cached for each cluster, and refreshed when a kind is missing,
+// like the custom resources of a definition just applied.
+type ManifestRepository struct {
+	clients KubeClients
+	mappers sync.Map // kubernetes.Interface -> *restmapper.DeferredDiscoveryRESTMapper
+}
+
+func NewManifestRepository(clients KubeClients) *ManifestRepository {
+	return &ManifestRepository{
+		clients: clients,
+	}
+}
+
+// IsNamespaced tells if the objects of the kind live in a namespace.
+func (rc *ManifestRepository) IsNamespaced(ctx context.Context, gvk schema.GroupVersionKind) (bool, error) {
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	mapping, err := rc.mapping(client, gvk)
+	if err != nil {
+		return false, err
+	}
+
+	return mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+func (rc *ManifestRepository) Get(ctx context.Context, object *unstructured.Unstructured) (_ *unstructured.Unstructured, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ManifestRepository.Get", objectAttributes(object)...)
+	defer func() { pkg.EndSpan(span, err) }()
+
+	resource, err := rc.resource(ctx, object.GroupVersionKind(), object.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.Get(ctx, object.GetName(), metav1.GetOptions{})
+}
+
+// Apply server-side applies the object, the fields it sets are managed by opts.FieldManager.
+func (rc *ManifestRepository) Apply(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) (_ *unstructured.Unstructured, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ManifestRepository.Apply", objectAttributes(object)...)
+	defer func() { pkg.EndSpan(span, err) }()
+
+	resource, err := rc.resource(ctx, object.GroupVersionKind(), object.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.Apply(ctx, object.GetName(), object, metav1.ApplyOptions{
+		DryRun:       opts.DryRun,
+		Force:        opts.Force,
+		FieldManager: opts.FieldManager,
+	})
+}
+
+// List returns the objects of the kind in the namespace matching the label selector.
+// The namespace is ignored for the kinds not living in one.
+func (rc *ManifestRepository) List(ctx context.Context, gvk schema.GroupVersionKind, namespace, selector string) (_ []unstructured.Unstructured, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ManifestRepository.List", attribute.String("k8s.kind", gvk.Kind), attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	resource, err := rc.resource(ctx, gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := resource.List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// Delete deletes the object, provided it is still the one of the same UID.
+func (rc *ManifestRepository) Delete(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "ManifestRepository.Delete", objectAttributes(object)...)
+	defer func() { pkg.EndSpan(span, err) }()
+
+	resource, err := rc.resource(ctx, object.GroupVersionKind(), object.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+	uid := object.GetUID()
+
+	return resource.Delete(ctx, object.GetName(), metav1.DeleteOptions{
+		DryRun:            opts.DryRun,
+		PropagationPolicy: &propagationPolicy,
+		Preconditions: &metav1.Preconditions{
+			UID: &uid,
+		},
+	})
+}
+
+// resource returns the client of the resource of the kind, in the namespace when the kind lives in one.
+func (rc *ManifestRepository) resource(ctx context.Context, gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := rc.mapping(client, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := pkg.DynamicClientFor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return dynamicClient.Resource(mapping.Resource), nil
+	}
+
+	return dynamicClient.Resource(mapping.Resource).Namespace(namespace), nil
+}
+
+func (rc *ManifestRepository) mapping(client kubernetes.Interface, gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapper, ok := rc.mappers.Load(client)
+	if !ok {
+		mapper, _ = rc.mappers.LoadOrStore(client, restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery())))
+	}
+	restMapper := mapper.(*restmapper.DeferredDiscoveryRESTMapper)
+
+	mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// the kind may have been added since the resources were discovered
+		restMapper.Reset()
+		mapping, err = restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+
+	return mapping, err
+}
+
+func objectAttributes(object *unstructured.Unstructured) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.kind", object.GetKind()),
+		attribute.String("k8s.namespace.name", object.GetNamespace()),
+		attribute.String("k8s.object.name", object.GetName()),
+	}
+}
@@ -0,0 +1,259 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"github.com/go-pg/pg"
+)
+
+// MemoryEventRepository keeps the event hash chain in memory with the same semantics as EventRepository.
+// It is meant for tests and local development, nothing survives a restart.
+type MemoryEventRepository struct {
+	archives []model.EventArchive
+	events   []model.Event
+	nextID   int64
+	mu       sync.RWMutex
+}
+
+func NewMemoryEventRepository() *MemoryEventRepository {
+	return &MemoryEventRepository{
+		nextID: 1,
+	}
+}
+
+func (rc *MemoryEventRepository) Create(ctx context.Context, newEvent *model.Event) (*model.Event, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	// hash the same timestamp postgres would store
+	newEvent.CreatedAt = newEvent.CreatedAt.Truncate(time.Microsecond)
+
+	newEvent.ID = rc.nextID
+	rc.nextID++
+
+	newEvent.PrevHash = rc.lastHash()
+	newEvent.Hash = newEvent.ComputeHash(newEvent.PrevHash)
+
+	rc.events = append(rc.events, *newEvent)
+
+	return newEvent, nil
+}
+
+func (rc *MemoryEventRepository) List(ctx context.Context, opts *model.EventFindOpts) (*model.EventList, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	matched := make([]model.Event, 0)
+	for _, v := range rc.events {
+		if !v.DeletedAt.IsZero() {
+			continue
+		}
+
+		ok, err := rc.matchFilter(&v, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %w", err)
+		}
+		if ok {
+			matched = append(matched, selectEventColumns(v, rc.fillFields(opts)))
+		}
+	}
+
+	return &model.EventList{
+		Events: paginate(matched, opts.PaginationOpts),
+		Total:  len(matched),
+		PaginationOpts: model.PaginationOpts{
+			Skip:  opts.Skip,
+			Limit: opts.Limit,
+		},
+	}, nil
+}
+
+func (rc *MemoryEventRepository) GetByID(ctx context.Context, id string) (*model.Event, error) {
+	if id == "0" || id == "" {
+		return nil, fmt.Errorf("invalid event id")
+	}
+
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event [%s] id: %w", id, err)
+	}
+
+	for _, v := range rc.events {
+		if v.ID == eventID && v.DeletedAt.IsZero() {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find event [%s] id: %w", id, pg.ErrNoRows)
+}
+
+// ListChain returns events with an id greater than afterID in chain order, soft deleted ones included.
+func (rc *MemoryEventRepository) ListChain(ctx context.Context, afterID int64, limit int) ([]model.Event, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	events := make([]model.Event, 0)
+	for _, v := range rc.events {
+		if len(events) == limit {
+			break
+		}
+
+		if v.ID > afterID {
+			events = append(events, v)
+		}
+	}
+
+	return events, nil
+}
+
+// LastArchive returns the most recent archive, or nil if nothing has been archived yet.
+func (rc *MemoryEventRepository) LastArchive(ctx context.Context) (*model.EventArchive, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	if len(rc.archives) == 0 {
+		return nil, nil
+	}
+
+	archive := rc.archives[len(rc.archives)-1]
+
+	return &archive, nil
+}
+
+// Purge records the archive and removes the archived events.
+func (rc *MemoryEventRepository) Purge(ctx context.Context, archive *model.EventArchive) error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for _, v := range rc.archives {
+		if v.LastEventID >= archive.FirstEventID {
+			return interfaces.ErrAlreadyArchived
+		}
+	}
+
+	archive.ID = int64(len(rc.archives) + 1)
+	rc.archives = append(rc.archives, *archive)
+
+	remaining := make([]model.Event, 0, len(rc.events))
+	for _, v := range rc.events {
+		if v.ID > archive.LastEventID {
+			remaining = append(remaining, v)
+		}
+	}
+	rc.events = remaining
+
+	return nil
+}
+
+// lastHash returns the hash of the newest event, falling back to the last archive anchor.
+func (rc *MemoryEventRepository) lastHash() string {
+	if len(rc.events) != 0 {
+		return rc.events[len(rc.events)-1].Hash
+	}
+
+	if len(rc.archives) != 0 {
+		return rc.archives[len(rc.archives)-1].LastHash
+	}
+
+	return ""
+}
+
+func (rc *MemoryEventRepository) fillFields(opts *model.EventFindOpts) []string {
+	fields := opts.Fields
+
+	if len(fields) == 1 && fields[0] == model.ZeroCreds {
+		return eventZeroCredsFields
+	}
+
+	return fields
+}
+
+func (rc *MemoryEventRepository) matchFilter(event *model.Event, opts *model.EventFindOpts) (bool, error) {
+	if opts.Category.IsSended && event.Category != opts.Category.Value {
+		return false, nil
+	}
+
+	if opts.Type.IsSended && event.Type != opts.Type.Value {
+		return false, nil
+	}
+
+	if opts.CreatedAt.IsSended {
+		createdAt, err := parseFilterTime(opts.CreatedAt.Value)
+		if err != nil {
+			return false, err
+		}
+
+		if !event.CreatedAt.Equal(createdAt) {
+			return false, nil
+		}
+	}
+
+	if opts.OwnerID.IsSended && strconv.FormatInt(event.Owner.ID, 10) != opts.OwnerID.Value {
+		return false, nil
+	}
+
+	if opts.OwnerUsername.IsSended && event.Owner.Username != opts.OwnerUsername.Value {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// selectEventColumns keeps the given columns of the event, every column when none are given.
+func selectEventColumns(event model.Event, fields []string) model.Event {
+	if len(fields) == 0 {
+		return event
+	}
+
+	selected := model.Event{}
+	for _, v := range fields {
+		switch v {
+		case "id":
+			selected.ID = event.ID
+		case "category":
+			selected.Category = event.Category
+		case "type":
+			selected.Type = event.Type
+		case "hash":
+			selected.Hash = event.Hash
+		case "prev_hash":
+			selected.PrevHash = event.PrevHash
+		case "owner":
+			selected.Owner = event.Owner
+		case "created_at":
+			selected.CreatedAt = event.CreatedAt
+		case "deleted_at":
+			selected.DeletedAt = event.DeletedAt
+		}
+	}
+
+	return selected
+}
+
+// parseFilterTime parses a timestamp filter the way postgres casts it to timestamptz, in UTC.
+func parseFilterTime(value string) (time.Time, error) {
+	layouts := []string{
+		time.RFC3339Nano,
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp filter [%s]", value)
+}
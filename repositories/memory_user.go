@@ -0,0 +1,251 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/go-pg/pg"
+)
+
+// MemoryUserRepository keeps users in memory with the same semantics as UserRepository.
+// It is meant for tests and local development, nothing survives a restart.
+type MemoryUserRepository struct {
+	users  []model.User
+	nextID int64
+	mu     sync.RWMutex
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{
+		nextID: 1,
+	}
+}
+
+func (rc *MemoryUserRepository) Create(ctx context.Context, newUser model.User) (*model.User, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	// usernames and emails are unique columns of the users table, deleted rows included
+	for _, v := range rc.users {
+		if v.Username == newUser.Username || (newUser.Email != "" && v.Email == newUser.Email) {
+			return nil, fmt.Errorf("failed to create user: username or email already exists")
+		}
+	}
+
+	if newUser.ID == 0 {
+		newUser.ID = rc.nextID
+	}
+	if newUser.ID >= rc.nextID {
+		rc.nextID = newUser.ID + 1
+	}
+
+	rc.users = append(rc.users, newUser)
+
+	return &newUser, nil
+}
+
+func (rc *MemoryUserRepository) Update(ctx context.Context, updatedUser model.User) (*model.User, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	i := rc.indexByID(updatedUser.ID)
+	if i < 0 {
+		return nil, fmt.Errorf("no user updated")
+	}
+
+	rc.users[i] = updatedUser
+
+	return &updatedUser, nil
+}
+
+func (rc *MemoryUserRepository) List(ctx context.Context, opts *model.UserFindOpts) (*model.UserList, error) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	fields := rc.fillFields(opts)
+
+	matched := make([]model.User, 0)
+	for _, v := range rc.users {
+		if !v.DeletedAt.IsZero() {
+			continue
+		}
+
+		ok, err := rc.matchFilter(&v, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		if ok {
+			matched = append(matched, selectUserColumns(v, fields))
+		}
+	}
+
+	return &model.UserList{
+		Users: paginate(matched, opts.PaginationOpts),
+		Total: len(matched),
+		PaginationOpts: model.PaginationOpts{
+			Skip:  opts.Skip,
+			Limit: opts.Limit,
+		},
+	}, nil
+}
+
+func (rc *MemoryUserRepository) GetByID(ctx context.Context, id string) (*model.User, error) {
+	if id == "0" || id == "" {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user by id [%s]: %w", id, err)
+	}
+
+	i := rc.indexByID(userID)
+	if i < 0 {
+		return nil, fmt.Errorf("failed to find user by id [%s]: %w", id, pg.ErrNoRows)
+	}
+
+	user := rc.users[i]
+
+	return &user, nil
+}
+
+func (rc *MemoryUserRepository) GetByUsernameOrEmail(ctx context.Context, usernameOrEmail string) (*model.User, error) {
+	if usernameOrEmail == "" {
+		return nil, fmt.Errorf("invalid username or email")
+	}
+
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	for _, v := range rc.users {
+		if v.DeletedAt.IsZero() && (v.Username == usernameOrEmail || v.Email == usernameOrEmail) {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to get user by [%s]: %w", usernameOrEmail, pg.ErrNoRows)
+}
+
+// Delete marks the user as deleted, like the soft delete of UserRepository.
+func (rc *MemoryUserRepository) Delete(ctx context.Context, id string) error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	i := rc.indexByID(userID)
+	if i < 0 {
+		return fmt.Errorf("no user deleted")
+	}
+
+	rc.users[i].DeletedAt = time.Now()
+
+	return nil
+}
+
+// indexByID returns the index of the user with the id unless it is deleted, -1 otherwise.
+func (rc *MemoryUserRepository) indexByID(id int64) int {
+	for i, v := range rc.users {
+		if v.ID == id && v.DeletedAt.IsZero() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (rc *MemoryUserRepository) fillFields(opts *model.UserFindOpts) []string {
+	fields := opts.Fields
+
+	if len(fields) == 1 && fields[0] == model.ZeroCreds {
+		return []string{
+			"id",
+			"username",
+			"email",
+			"role_id",
+			"deleted_at",
+		}
+	}
+
+	return fields
+}
+
+func (rc *MemoryUserRepository) matchFilter(user *model.User, opts *model.UserFindOpts) (bool, error) {
+	if opts.Username.IsSended && user.Username != opts.Username.Value {
+		return false, nil
+	}
+
+	if opts.Email.IsSended && user.Email != opts.Email.Value {
+		return false, nil
+	}
+
+	if opts.RoleID.IsSended {
+		roleID, err := strconv.ParseUint(opts.RoleID.Value, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid role_id filter [%s]: %w", opts.RoleID.Value, err)
+		}
+
+		if uint64(user.RoleID) != roleID {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// selectUserColumns keeps the given columns of the user, every column when none are given.
+func selectUserColumns(user model.User, fields []string) model.User {
+	if len(fields) == 0 {
+		return user
+	}
+
+	selected := model.User{}
+	for _, v := range fields {
+		switch v {
+		case "id":
+			selected.ID = user.ID
+		case "username":
+			selected.Username = user.Username
+		case "email":
+			selected.Email = user.Email
+		case "password":
+			selected.Password = user.Password
+		case "role_id":
+			selected.RoleID = user.RoleID
+		case "created_at":
+			selected.CreatedAt = user.CreatedAt
+		case "deleted_at":
+			selected.DeletedAt = user.DeletedAt
+		}
+	}
+
+	return selected
+}
+
+// paginate applies skip and limit like OFFSET and LIMIT, a zero limit returning every item.
+// An empty page is nil, as go-pg leaves the slice untouched when no row is selected.
+func paginate[T any](items []T, opts model.PaginationOpts) []T {
+	if opts.Skip >= len(items) {
+		return nil
+	}
+	if opts.Skip > 0 {
+		items = items[opts.Skip:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(items) {
+		items = items[:opts.Limit]
+	}
+
+	return items
+}
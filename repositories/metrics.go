@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// metricsUnavailableMessage tells what is missing when the cluster does not serve the metrics API.
+const metricsUnavailableMessage = "the metrics API (metrics.k8s.io) is not available in the cluster, make sure metrics-server is installed and running"
+
+// podMetrics is a metrics.k8s.io/v1beta1 PodMetrics, read through the dynamic client to spare
+// the dependency on the metrics clientset.
+type podMetrics struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time     `json:"timestamp"`
+	Window            metav1.Duration `json:"window"`
+	Containers        []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+type MetricsRepository struct {
+	clients KubeClients
+}
+
+func NewMetricsRepository(clients KubeClients) *MetricsRepository {
+	return &MetricsRepository{
+		clients: clients,
+	}
+}
+
+func (rc *MetricsRepository) GetPodMetrics(ctx context.Context, namespace, name string) (_ *model.PodMetrics, err error) {
+	ctx, span := pkg.StartSpan(ctx, "MetricsRepository.GetPodMetrics", attribute.String("k8s.namespace.name", namespace), attribute.String("k8s.pod.name", name))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := pkg.DynamicClientFor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := dynamicClient.Resource(pkg.PodMetricsResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, rc.metricsError(client, err)
+	}
+
+	return rc.fillResponsePodMetrics(object)
+}
+
+// ListPodMetrics lists the metrics of the pods of the namespace, of every namespace when empty.
+func (rc *MetricsRepository) ListPodMetrics(ctx context.Context, namespace string) (_ []model.PodMetrics, err error) {
+	ctx, span := pkg.StartSpan(ctx, "MetricsRepository.ListPodMetrics", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := pkg.DynamicClientFor(client)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynamicClient.Resource(pkg.PodMetricsResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, rc.metricsError(client, err)
+	}
+
+	metrics := make([]model.PodMetrics, 0, len(list.Items))
+	for i := range list.Items {
+		podMetrics, err := rc.fillResponsePodMetrics(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		metrics = append(metrics, *podMetrics)
+	}
+
+	return metrics, nil
+}
+
+// metricsError turns the errors of a cluster not serving the metrics API into a ServiceUnavailable
+// error. A missing API answers NotFound too, the discovery tells it apart from a pod without metrics.
+func (rc *MetricsRepository) metricsError(client kubernetes.Interface, err error) error {
+	if !apierrors.IsNotFound(err) && !apierrors.IsServiceUnavailable(err) {
+		return err
+	}
+
+	if _, discoveryErr := client.Discovery().ServerResourcesForGroupVersion(pkg.PodMetricsResource.GroupVersion().String()); discoveryErr != nil || apierrors.IsServiceUnavailable(err) {
+		return apierrors.NewServiceUnavailable(metricsUnavailableMessage)
+	}
+
+	return err
+}
+
+func (rc *MetricsRepository) fillResponsePodMetrics(object *unstructured.Unstructured) (*model.PodMetrics, error) {
+	var metrics podMetrics
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode the metrics of pod %s: %w", object.GetName(), err)
+	}
+
+	containers := make([]model.ContainerMetrics, 0, len(metrics.Containers))
+	for _, v := range metrics.Containers {
+		containers = append(containers, model.ContainerMetrics{
+			Name:   v.Name,
+			CPU:    model.ResourceUsage{Usage: formatCPU(v.Usage.Cpu())},
+			Memory: model.ResourceUsage{Usage: formatMemory(v.Usage.Memory())},
+		})
+	}
+
+	return &model.PodMetrics{
+		Timestamp:  metrics.Timestamp.Time,
+		Name:       metrics.Name,
+		Namespace:  metrics.Namespace,
+		Window:     metrics.Window.Duration.String(),
+		Containers: containers,
+	}, nil
+}
+
+// formatCPU returns the CPU in millicores, metrics-server reports it in nanocores.
+func formatCPU(quantity *resource.Quantity) string {
+	return resource.NewMilliQuantity(quantity.MilliValue(), resource.DecimalSI).String()
+}
+
+// formatMemory returns the memory in bytes, with a binary suffix when it divides evenly.
+func formatMemory(quantity *resource.Quantity) string {
+	return resource.NewQuantity(quantity.Value(), resource.BinarySI).String()
+}
@@ -2,24 +2,23 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
 )
 
 type NamespaceRepository struct {
-	client *kubernetes.Clientset
+	clients KubeClients
 }
 
-func NewNamespaceRepository(client *kubernetes.Clientset) *NamespaceRepository {
+func NewNamespaceRepository(clients KubeClients) *NamespaceRepository {
 	return &NamespaceRepository{
-		client: client,
+		clients: clients,
 	}
 }
 
@@ -28,7 +27,12 @@ func (rc *NamespaceRepository) Create(ctx context.Context, namespace *model.Name
 
 	kubeNamespace := rc.fillRequestNamespace(namespace)
 
-	createdNamespace, err := rc.client.CoreV1().Namespaces().Create(ctx, kubeNamespace, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdNamespace, err := client.CoreV1().Namespaces().Create(ctx, kubeNamespace, metaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +50,12 @@ func (rc *NamespaceRepository) Update(ctx context.Context, nameOrUID string, nam
 
 	kubeNamespace := rc.overwriteOnKubeNamespace(namespace, existNamespace)
 
-	createdNamespace, err := rc.client.CoreV1().Namespaces().Update(ctx, kubeNamespace, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdNamespace, err := client.CoreV1().Namespaces().Update(ctx, kubeNamespace, metaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +63,36 @@ func (rc *NamespaceRepository) Update(ctx context.Context, nameOrUID string, nam
 	return rc.fillResponseNamespace(createdNamespace), nil
 }
 
+func (rc *NamespaceRepository) Patch(ctx context.Context, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (*model.Namespace, error) {
+	metaOpts := convertPatchOptsToKube(opts)
+
+	existNamespace, err := rc.getByNameOrUID(ctx, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResourceVersion(corev1.Resource("namespaces"), existNamespace.Name, existNamespace.ResourceVersion, opts.Preconditions); err != nil {
+		return nil, err
+	}
+
+	data, err = patchWithResourceVersion(patchType, data, opts.Preconditions)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedNamespace, err := client.CoreV1().Namespaces().Patch(ctx, existNamespace.Name, types.PatchType(patchType), data, metaOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponseNamespace(patchedNamespace), nil
+}
+
 func (rc *NamespaceRepository) List(ctx context.Context, opts model.ListOptions) (*model.NamespaceList, error) {
 	kubeNamespaces, err := rc.list(ctx, opts)
 	if err != nil {
@@ -87,13 +126,23 @@ func (rc *NamespaceRepository) GetByNameOrUID(ctx context.Context, nameOrUID str
 func (rc *NamespaceRepository) Delete(ctx context.Context, name string, opts model.DeleteOptions) error {
 	metaOpts := convertDeleteOptsToKube(opts)
 
-	return rc.client.CoreV1().Namespaces().Delete(ctx, name, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.CoreV1().Namespaces().Delete(ctx, name, metaOpts)
 }
 
 func (rc *NamespaceRepository) list(ctx context.Context, opts model.ListOptions) (*corev1.NamespaceList, error) {
 	metaOpts := convertListOptsToKube(opts)
 
-	return rc.client.CoreV1().Namespaces().List(ctx, metaOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CoreV1().Namespaces().List(ctx, metaOpts)
 }
 
 func (rc *NamespaceRepository) getByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (*corev1.Namespace, error) {
@@ -111,7 +160,7 @@ func (rc *NamespaceRepository) getByNameOrUID(ctx context.Context, nameOrUID str
 	}
 
 	if namespaces.ListMeta.Continue == "" {
-		return nil, fmt.Errorf("namespace %s not found", nameOrUID)
+		return nil, apierrors.NewNotFound(corev1.Resource("namespaces"), nameOrUID)
 	}
 
 	opts.Continue = namespaces.ListMeta.Continue
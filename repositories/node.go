@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type NodeRepository struct {
+	clients KubeClients
+}
+
+func NewNodeRepository(clients KubeClients) *NodeRepository {
+	return &NodeRepository{
+		clients: clients,
+	}
+}
+
+func (rc *NodeRepository) List(ctx context.Context, opts model.ListOptions) (_ *model.NodeList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeRepository.List")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	kubeNodes, err := rc.list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList := model.NodeList{
+		Items: make([]model.Node, 0, len(kubeNodes.Items)),
+	}
+	for _, kubeNode := range kubeNodes.Items {
+		nodeList.Items = append(nodeList.Items, *rc.fillResponseNode(&kubeNode))
+	}
+
+	nodeList.ListMeta = model.ListMeta{
+		RemainingItemCount: kubeNodes.ListMeta.RemainingItemCount,
+		ResourceVersion:    kubeNodes.ListMeta.ResourceVersion,
+		Continue:           kubeNodes.ListMeta.Continue,
+	}
+	nodeList.TypeMeta = model.TypeMeta(kubeNodes.TypeMeta)
+
+	return &nodeList, nil
+}
+
+func (rc *NodeRepository) GetByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (_ *model.Node, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeRepository.GetByNameOrUID")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	node, err := rc.getByNameOrUID(ctx, nameOrUID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponseNode(node), nil
+}
+
+func (rc *NodeRepository) SetUnschedulable(ctx context.Context, name string, unschedulable bool, opts model.PatchOptions) (_ *model.Node, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeRepository.SetUnschedulable", attribute.String("k8s.node.name", name), attribute.Bool("k8s.node.unschedulable", unschedulable))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": unschedulable,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedNode, err := client.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, data, convertPatchOptsToKube(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponseNode(patchedNode), nil
+}
+
+func (rc *NodeRepository) list(ctx context.Context, opts model.ListOptions) (*corev1.NodeList, error) {
+	listOpts := convertListOptsToKube(opts)
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CoreV1().Nodes().List(ctx, listOpts)
+}
+
+func (rc *NodeRepository) getByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (*corev1.Node, error) {
+	opts.TypeMeta.Kind = "node"
+
+	opts.Limit = 100
+	nodes, err := rc.list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range nodes.Items {
+		if v.Name == nameOrUID || v.UID == types.UID(nameOrUID) {
+			return &v, nil
+		}
+	}
+
+	if nodes.ListMeta.Continue == "" {
+		return nil, apierrors.NewNotFound(corev1.Resource("nodes"), nameOrUID)
+	}
+
+	opts.Continue = nodes.ListMeta.Continue
+	return rc.getByNameOrUID(ctx, nameOrUID, opts)
+}
+
+func (rc *NodeRepository) fillResponseNode(node *corev1.Node) *model.Node {
+	taints := make([]model.Taint, 0, len(node.Spec.Taints))
+	for _, v := range node.Spec.Taints {
+		var timeAdded *time.Time
+		if v.TimeAdded != nil {
+			timeAdded = &v.TimeAdded.Time
+		}
+
+		taints = append(taints, model.Taint{
+			Key:       v.Key,
+			Value:     v.Value,
+			Effect:    model.TaintEffect(v.Effect),
+			TimeAdded: timeAdded,
+		})
+	}
+
+	conditions := make([]model.NodeCondition, 0, len(node.Status.Conditions))
+	for _, v := range node.Status.Conditions {
+		conditions = append(conditions, model.NodeCondition{
+			LastHeartbeatTime:  v.LastHeartbeatTime.Time,
+			LastTransitionTime: v.LastTransitionTime.Time,
+			Type:               string(v.Type),
+			Status:             model.ConditionStatus(v.Status),
+			Reason:             v.Reason,
+			Message:            v.Message,
+		})
+	}
+
+	addresses := make([]model.NodeAddress, 0, len(node.Status.Addresses))
+	for _, v := range node.Status.Addresses {
+		addresses = append(addresses, model.NodeAddress{
+			Type:    string(v.Type),
+			Address: v.Address,
+		})
+	}
+
+	info := node.Status.NodeInfo
+
+	return &model.Node{
+		TypeMeta: model.TypeMeta(node.TypeMeta),
+		ObjectMeta: model.ObjectMeta{
+			UID:               string(node.UID),
+			CreationTimestamp: node.CreationTimestamp.Time,
+			Labels:            node.Labels,
+			Annotations:       node.Annotations,
+			Name:              node.Name,
+			ResourceVersion:   node.ResourceVersion,
+			Generation:        node.Generation,
+		},
+		Spec: model.NodeSpec{
+			PodCIDR:       node.Spec.PodCIDR,
+			ProviderID:    node.Spec.ProviderID,
+			Unschedulable: node.Spec.Unschedulable,
+			Taints:        taints,
+		},
+		Status: model.NodeStatus{
+			Capacity:    convertResourceListToModel(node.Status.Capacity),
+			Allocatable: convertResourceListToModel(node.Status.Allocatable),
+			Conditions:  conditions,
+			Addresses:   addresses,
+			NodeInfo: model.NodeSystemInfo{
+				KernelVersion:           info.KernelVersion,
+				OSImage:                 info.OSImage,
+				ContainerRuntimeVersion: info.ContainerRuntimeVersion,
+				KubeletVersion:          info.KubeletVersion,
+				OperatingSystem:         info.OperatingSystem,
+				Architecture:            info.Architecture,
+			},
+		},
+	}
+}
@@ -2,31 +2,42 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 )
 
 type PodRepository struct {
-	client *kubernetes.Clientset
+	clients KubeClients
 }
 
-func NewPodRepository(client *kubernetes.Clientset) *PodRepository {
-	return &PodRepository{client}
+func NewPodRepository(clients KubeClients) *PodRepository {
+	return &PodRepository{clients}
 }
 
-func (rc *PodRepository) Create(ctx context.Context, pod *model.Pod, opts model.CreateOptions) (*model.Pod, error) {
+func (rc *PodRepository) Create(ctx context.Context, pod *model.Pod, opts model.CreateOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Create")
+	defer func() { pkg.EndSpan(span, err) }()
+
 	createOptions := convertCreateOptsToKube(opts)
 
 	kubePod := rc.fillRequestPod(pod)
 
-	createdPod, err := rc.client.CoreV1().Pods(pod.Namespace).Create(ctx, kubePod, createOptions)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdPod, err := client.CoreV1().Pods(pod.Namespace).Create(ctx, kubePod, createOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -34,17 +45,41 @@ func (rc *PodRepository) Create(ctx context.Context, pod *model.Pod, opts model.
 	return rc.fillResponsePod(createdPod), nil
 }
 
-func (rc *PodRepository) Update(ctx context.Context, podID string, pod *model.Pod, opts model.UpdateOptions) (*model.Pod, error) {
+func (rc *PodRepository) Update(ctx context.Context, podID string, pod *model.Pod, opts model.UpdateOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Update")
+	defer func() { pkg.EndSpan(span, err) }()
+
 	updateOptions := convertUpdateOptsToKube(opts)
 
-	existPod, err := rc.getByNameOrUID(ctx, pod.Namespace, podID, model.ListOptions{})
+	client, err := rc.clients.ClientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	kubePod := rc.overwriteOnKubePod(pod, existPod)
+	update := func() (*corev1.Pod, error) {
+		existPod, err := rc.getByNameOrUID(ctx, pod.Namespace, podID, model.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
 
-	updatedPod, err := rc.client.CoreV1().Pods(pod.Namespace).Update(ctx, kubePod, updateOptions)
+		if err := checkResourceVersion(corev1.Resource("pods"), existPod.Name, existPod.ResourceVersion, opts.Preconditions); err != nil {
+			return nil, err
+		}
+
+		kubePod := rc.overwriteOnKubePod(pod, existPod)
+
+		return client.CoreV1().Pods(pod.Namespace).Update(ctx, kubePod, updateOptions)
+	}
+
+	var updatedPod *corev1.Pod
+	if opts.RetryOnConflict && !hasResourceVersionPrecondition(opts.Preconditions) {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+			updatedPod, err = update()
+			return err
+		})
+	} else {
+		updatedPod, err = update()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +87,43 @@ func (rc *PodRepository) Update(ctx context.Context, podID string, pod *model.Po
 	return rc.fillResponsePod(updatedPod), nil
 }
 
-func (rc *PodRepository) List(ctx context.Context, namespace string, opts model.ListOptions) (*model.PodList, error) {
+func (rc *PodRepository) Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Patch", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	patchOptions := convertPatchOptsToKube(opts)
+
+	existPod, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResourceVersion(corev1.Resource("pods"), existPod.Name, existPod.ResourceVersion, opts.Preconditions); err != nil {
+		return nil, err
+	}
+
+	data, err = patchWithResourceVersion(patchType, data, opts.Preconditions)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedPod, err := client.CoreV1().Pods(namespace).Patch(ctx, existPod.Name, types.PatchType(patchType), data, patchOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponsePod(patchedPod), nil
+}
+
+func (rc *PodRepository) List(ctx context.Context, namespace string, opts model.ListOptions) (_ *model.PodList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.List", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	kubePods, err := rc.list(ctx, namespace, opts)
 	if err != nil {
 		return nil, err
@@ -73,13 +144,68 @@ func (rc *PodRepository) List(ctx context.Context, namespace string, opts model.
 	return &podList, nil
 }
 
-func (rc *PodRepository) Delete(ctx context.Context, namespace, name string, opts model.DeleteOptions) error {
+func (rc *PodRepository) Delete(ctx context.Context, namespace, nameOrUID string, opts model.DeleteOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Delete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	deleteOpts := convertDeleteOptsToKube(opts)
+
+	name := nameOrUID
+	if hasResourceVersionPrecondition(opts.Preconditions) {
+		existPod, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := checkResourceVersion(corev1.Resource("pods"), existPod.Name, existPod.ResourceVersion, opts.Preconditions); err != nil {
+			return err
+		}
+		name = existPod.Name
+	}
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.CoreV1().Pods(namespace).Delete(ctx, name, deleteOpts)
+	if !apierrors.IsNotFound(err) || name != nameOrUID {
+		return err
+	}
+
+	// not a name, the pod may be found by its UID
+	existPod, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	return client.CoreV1().Pods(namespace).Delete(ctx, existPod.Name, deleteOpts)
+}
+
+func (rc *PodRepository) Evict(ctx context.Context, namespace, name string, opts model.DeleteOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Evict", attribute.String("k8s.namespace.name", namespace), attribute.String("k8s.pod.name", name))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	deleteOpts := convertDeleteOptsToKube(opts)
 
-	return rc.client.CoreV1().Pods(namespace).Delete(ctx, name, deleteOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.PolicyV1().Evictions(namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		DeleteOptions: &deleteOpts,
+	})
 }
 
-func (rc *PodRepository) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Pod, error) {
+func (rc *PodRepository) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.GetByNameOrUID", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	pod, err := rc.getByNameOrUID(ctx, namespace, nameOrUID, opts)
 	if err != nil {
 		return nil, err
@@ -88,6 +214,23 @@ func (rc *PodRepository) GetByNameOrUID(ctx context.Context, namespace, nameOrUI
 	return rc.fillResponsePod(pod), nil
 }
 
+func (rc *PodRepository) Get(ctx context.Context, namespace, name string) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodRepository.Get", attribute.String("k8s.namespace.name", namespace), attribute.String("k8s.pod.name", name))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.fillResponsePod(pod), nil
+}
+
 func (rc *PodRepository) getByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*corev1.Pod, error) {
 	opts.TypeMeta.Kind = "pod"
 	if namespace == "" {
@@ -106,7 +249,7 @@ func (rc *PodRepository) getByNameOrUID(ctx context.Context, namespace, nameOrUI
 	}
 
 	if pods.ListMeta.Continue == "" {
-		return nil, fmt.Errorf("pod %s not found", nameOrUID)
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), nameOrUID)
 	}
 
 	opts.Continue = pods.ListMeta.Continue
@@ -116,7 +259,12 @@ func (rc *PodRepository) getByNameOrUID(ctx context.Context, namespace, nameOrUI
 func (rc *PodRepository) list(ctx context.Context, namespace string, opts model.ListOptions) (*corev1.PodList, error) {
 	listOpts := convertListOptsToKube(opts)
 
-	return rc.client.CoreV1().Pods(namespace).List(ctx, listOpts)
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CoreV1().Pods(namespace).List(ctx, listOpts)
 }
 
 func (rc *PodRepository) overwriteOnKubePod(newPod *model.Pod, existPod *corev1.Pod) *corev1.Pod {
@@ -136,87 +284,17 @@ func (rc *PodRepository) overwriteOnKubePod(newPod *model.Pod, existPod *corev1.
 }
 
 func (rc *PodRepository) fillRequestPod(pod *model.Pod) *corev1.Pod {
-	containers := convertContainersToKube(pod.Spec.Containers)
-
-	initContainers := convertContainersToKube(pod.Spec.InitContainers)
-
-	tolerations := convertTolerationsToKube(pod.Spec.Tolerations)
-
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: pod.Name,
-		},
-		Spec: corev1.PodSpec{
-			InitContainers:        initContainers,
-			Containers:            containers,
-			Tolerations:           tolerations,
-			ActiveDeadlineSeconds: pod.Spec.ActiveDeadlineSeconds,
+			Name:        pod.Name,
+			Labels:      pod.Labels,
+			Annotations: pod.Annotations,
 		},
+		Spec: convertPodSpecToKube(&pod.Spec),
 	}
 }
 
 func (rc *PodRepository) fillResponsePod(pod *corev1.Pod) *model.Pod {
-	volumes := make([]model.Volume, 0, len(pod.Spec.Volumes))
-	for _, v := range pod.Spec.Volumes {
-		volumes = append(volumes, model.Volume{
-			Name:         v.Name,
-			VolumeSource: model.VolumeSource{},
-		})
-	}
-
-	containers := make([]model.Container, 0, len(pod.Spec.Containers))
-	for _, v := range pod.Spec.Containers {
-		containers = append(containers, model.Container{
-			Name:                   v.Name,
-			Image:                  v.Image,
-			Command:                v.Command,
-			Args:                   v.Args,
-			WorkingDir:             v.WorkingDir,
-			TerminationMessagePath: v.TerminationMessagePath,
-			Stdin:                  v.Stdin,
-			StdinOnce:              v.StdinOnce,
-			TTY:                    v.TTY,
-		})
-	}
-
-	initContainers := make([]model.Container, 0, len(pod.Spec.InitContainers))
-	for _, v := range pod.Spec.InitContainers {
-		initContainers = append(initContainers, model.Container{
-			Name:                   v.Name,
-			Image:                  v.Image,
-			Command:                v.Command,
-			Args:                   v.Args,
-			WorkingDir:             v.WorkingDir,
-			TerminationMessagePath: v.TerminationMessagePath,
-			Stdin:                  v.Stdin,
-			StdinOnce:              v.StdinOnce,
-			TTY:                    v.TTY,
-		})
-	}
-
-	conditions := make([]model.PodCondition, 0, len(pod.Status.Conditions))
-	for _, v := range pod.Status.Conditions {
-		conditions = append(conditions, model.PodCondition{
-			Type:               model.PodConditionType(v.Type),
-			Status:             model.ConditionStatus(v.Status),
-			LastProbeTime:      v.LastProbeTime.Time,
-			LastTransitionTime: v.LastTransitionTime.Time,
-			Reason:             v.Reason,
-			Message:            v.Message,
-		})
-	}
-
-	tolerations := make([]model.Toleration, 0, len(pod.Spec.Tolerations))
-	for _, v := range pod.Spec.Tolerations {
-		tolerations = append(tolerations, model.Toleration{
-			Key:               v.Key,
-			Operator:          model.TolerationOperator(v.Operator),
-			Value:             v.Value,
-			Effect:            model.TaintEffect(v.Effect),
-			TolerationSeconds: v.TolerationSeconds,
-		})
-	}
-
 	ownerReferences := make([]model.OwnerReference, 0, len(pod.OwnerReferences))
 	for _, v := range pod.OwnerReferences {
 		ownerReferences = append(ownerReferences, model.OwnerReference{
@@ -250,21 +328,7 @@ func (rc *PodRepository) fillResponsePod(pod *corev1.Pod) *model.Pod {
 			Finalizers:                 pod.Finalizers,
 			Generation:                 pod.Generation,
 		},
-		Spec: model.PodSpec{
-			Volumes:                       volumes,
-			InitContainers:                initContainers,
-			Containers:                    containers,
-			ActiveDeadlineSeconds:         pod.DeletionGracePeriodSeconds,
-			TerminationGracePeriodSeconds: pod.DeletionGracePeriodSeconds,
-			Tolerations:                   tolerations,
-		},
-		Status: model.PodStatus{
-			Phase:             model.PodPhase(pod.Status.Phase),
-			Conditions:        conditions,
-			Message:           pod.Status.Message,
-			Reason:            pod.Status.Reason,
-			NominatedNodeName: pod.Status.NominatedNodeName,
-			HostIP:            pod.Status.HostIP,
-		},
+		Spec:   convertPodSpecToModel(&pod.Spec),
+		Status: convertPodStatusToModel(&pod.Status),
 	}
 }
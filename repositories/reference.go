@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ReferenceRepository struct {
+	clients KubeClients
+}
+
+func NewReferenceRepository(clients KubeClients) *ReferenceRepository {
+	return &ReferenceRepository{
+		clients: clients,
+	}
+}
+
+// ConfigMapKeys returns the keys of the config map, from its data and binary data.
+func (rc *ReferenceRepository) ConfigMapKeys(ctx context.Context, namespace, name string) (_ []string, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ReferenceRepository.ConfigMapKeys")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(configMap.Data)+len(configMap.BinaryData))
+	for k := range configMap.Data {
+		keys = append(keys, k)
+	}
+	for k := range configMap.BinaryData {
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+// SecretKeys returns the keys of the secret, never its values.
+func (rc *ReferenceRepository) SecretKeys(ctx context.Context, namespace, name string) (_ []string, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ReferenceRepository.SecretKeys")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	client, err := rc.clients.ClientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secret.Data)+len(secret.StringData))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	for k := range secret.StringData {
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
@@ -20,7 +20,7 @@ func NewUserRepository(db *pg.DB) *UserRepository {
 }
 
 func (rc *UserRepository) Create(ctx context.Context, newUser model.User) (*model.User, error) {
-	q := rc.db.Model(&newUser)
+	q := rc.db.ModelContext(ctx, &newUser)
 
 	_, err := q.Insert()
 	if err != nil {
@@ -31,7 +31,7 @@ func (rc *UserRepository) Create(ctx context.Context, newUser model.User) (*mode
 }
 
 func (rc *UserRepository) Update(ctx context.Context, updatedUser model.User) (*model.User, error) {
-	q := rc.db.Model(&updatedUser).WherePK()
+	q := rc.db.ModelContext(ctx, &updatedUser).WherePK()
 
 	result, err := q.Update()
 	if err != nil {
@@ -51,7 +51,7 @@ func (rc *UserRepository) List(ctx context.Context, opts *model.UserFindOpts) (*
 	filter := rc.fillFilter(opts)
 	fields := rc.fillFields(opts)
 
-	q := rc.db.Model(&users).Column(fields...)
+	q := rc.db.ModelContext(ctx, &users).Column(fields...)
 
 	if filter != "" {
 		q = q.Where(filter)
@@ -77,7 +77,7 @@ func (rc *UserRepository) List(ctx context.Context, opts *model.UserFindOpts) (*
 func (rc *UserRepository) GetByID(ctx context.Context, id string) (*model.User, error) {
 	var user model.User
 
-	q := rc.db.Model(&user)
+	q := rc.db.ModelContext(ctx, &user)
 
 	if id == "0" || id == "" {
 		return nil, fmt.Errorf("invalid user id")
@@ -95,7 +95,7 @@ func (rc *UserRepository) GetByID(ctx context.Context, id string) (*model.User,
 func (rc *UserRepository) GetByUsernameOrEmail(ctx context.Context, usernameOrEmail string) (*model.User, error) {
 	var user model.User
 
-	q := rc.db.Model(&user)
+	q := rc.db.ModelContext(ctx, &user)
 
 	if usernameOrEmail == "" {
 		return nil, fmt.Errorf("invalid username or email")
@@ -111,7 +111,7 @@ func (rc *UserRepository) GetByUsernameOrEmail(ctx context.Context, usernameOrEm
 }
 
 func (rc *UserRepository) Delete(ctx context.Context, id string) error {
-	result, err := rc.db.Model(&model.User{}).Where("id = ?", id).Delete()
+	result, err := rc.db.ModelContext(ctx, &model.User{}).Where("id = ?", id).Delete()
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
 	"github.com/fleimkeipa/kubernetes-api/pkg"
 
 	"github.com/go-pg/pg"
+	"github.com/labstack/echo/v4"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -18,7 +21,24 @@ var (
 	terminateDB = func() {}
 )
 
-func initTestKubernetes() *kubernetes.Clientset {
+// newTestEcho returns the echo server of the API, with the requests made by the test user.
+func newTestEcho() *echo.Echo {
+	e := echo.New()
+	e.JSONSerializer = controller.JSONSerializer{}
+	e.HTTPErrorHandler = controller.HTTPErrorHandler
+	e.Validator = controller.NewValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := context.WithValue(c.Request().Context(), "user", model.Owner{ID: 1, Username: "test_username"})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	})
+
+	return e
+}
+
+func initTestKubernetes() kubernetes.Interface {
 	client, err := pkg.NewKubernetesClient()
 	if err != nil {
 		log.Fatalf("Failed to init kubernetes client: %v", err)
@@ -29,14 +49,14 @@ func initTestKubernetes() *kubernetes.Clientset {
 	return client
 }
 
-func deleteTestNamespace(client *kubernetes.Clientset) {
+func deleteTestNamespace(client kubernetes.Interface) {
 	err := client.CoreV1().Namespaces().Delete(context.TODO(), "test", v1.DeleteOptions{})
 	if err != nil {
 		log.Fatalf("failed to delete test namespace: %v", err)
 	}
 }
 
-func createTestNamespace(client *kubernetes.Clientset) {
+func createTestNamespace(client kubernetes.Interface) {
 	namespace := corev1.Namespace{
 		TypeMeta: v1.TypeMeta{
 			Kind:       "namespace",
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := `
+stage: prod
+api_service:
+  port: 9090
+database:
+  host: from-file
+  password: secret
+  statement_timeout: 15s
+jwt:
+  private_key: secret
+clusters:
+  items:
+    - name: staging
+      kubeconfig: /etc/kubeconfigs/staging
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("KAPI_API_SERVICE_PORT", "9191")
+	t.Setenv("PGHOST", "from-libpq")
+	t.Setenv("KAPI_UI_SERVICE_ALLOW_ORIGINS", "https://a.example.com,https://b.example.com")
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.Stage != "prod" {
+		t.Errorf("Load() Stage = %v, want prod from the file", cfg.Stage)
+	}
+	if cfg.APIService.Port != 9191 {
+		t.Errorf("Load() APIService.Port = %v, want 9191 from the environment", cfg.APIService.Port)
+	}
+	if cfg.Database.Host != "from-libpq" {
+		t.Errorf("Load() Database.Host = %v, want from-libpq from the environment", cfg.Database.Host)
+	}
+	if cfg.Database.StatementTimeout != 15*time.Second {
+		t.Errorf("Load() Database.StatementTimeout = %v, want 15s", cfg.Database.StatementTimeout)
+	}
+	if cfg.Database.Port != 5432 || cfg.Log.Level != "info" {
+		t.Errorf("Load() Database.Port = %v, Log.Level = %v, want the defaults", cfg.Database.Port, cfg.Log.Level)
+	}
+	if len(cfg.Clusters.Items) != 1 || cfg.Clusters.Items[0].Name != "staging" {
+		t.Errorf("Load() Clusters.Items = %v, want the staging cluster", cfg.Clusters.Items)
+	}
+	if got := cfg.UIService.Origins(); len(got) != 2 {
+		t.Errorf("Load() UIService.Origins() = %v, want both origins of the environment", got)
+	}
+	if config.Get() != cfg {
+		t.Errorf("Get() = %v, want the loaded configuration", config.Get())
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := func() *config.Config {
+		cfg := *config.Get()
+		cfg.JWT.PrivateKey = "secret"
+		cfg.Database.Password = "secret"
+		return &cfg
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(cfg *config.Config)
+		wantProblems int
+	}{
+		{
+			name:   "defaults with a jwt key",
+			modify: func(cfg *config.Config) {},
+		},
+		{
+			name: "missing jwt key",
+			modify: func(cfg *config.Config) {
+				cfg.JWT.PrivateKey = ""
+			},
+			wantProblems: 1,
+		},
+		{
+			name: "every problem is listed",
+			modify: func(cfg *config.Config) {
+				cfg.Stage = "staging"
+				cfg.APIService.Port = 0
+				cfg.Log.Level = "verbose"
+				cfg.Database.SSLMode = "prefer"
+			},
+			wantProblems: 4,
+		},
+		{
+			name: "missing database password",
+			modify: func(cfg *config.Config) {
+				cfg.Database.Password = ""
+			},
+			wantProblems: 1,
+		},
+		{
+			name: "database password from a file",
+			modify: func(cfg *config.Config) {
+				cfg.Database.Password = ""
+				cfg.Database.PasswordFile = "/run/secrets/postgres-password"
+			},
+		},
+		{
+			name: "memory admin without password",
+			modify: func(cfg *config.Config) {
+				cfg.Database.Driver = "memory"
+				cfg.Database.Memory.Admin.Username = "admin"
+			},
+			wantProblems: 1,
+		},
+		{
+			name: "duplicate cluster names",
+			modify: func(cfg *config.Config) {
+				cfg.Clusters.Items = []config.ClusterConfig{
+					{Name: "default", Kubeconfig: "/etc/kubeconfigs/default"},
+					{Name: "staging"},
+				}
+			},
+			wantProblems: 2,
+		},
+		{
+			name: "archiving without an interval",
+			modify: func(cfg *config.Config) {
+				cfg.Events.Retention.HotDays = 90
+				cfg.Events.Retention.Interval = 0
+			},
+			wantProblems: 1,
+		},
+		{
+			name: "negative shutdown delay",
+			modify: func(cfg *config.Config) {
+				cfg.APIService.ShutdownDelay = -time.Second
+			},
+			wantProblems: 1,
+		},
+		{
+			name: "unknown log format",
+			modify: func(cfg *config.Config) {
+				cfg.Log.Format = "text"
+			},
+			wantProblems: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.wantProblems == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *config.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want a ValidationError", err)
+			}
+			if len(validationErr.Problems) != tt.wantProblems {
+				t.Errorf("Validate() problems = %q, want %d", validationErr.Problems, tt.wantProblems)
+			}
+		})
+	}
+}
+
+func TestLoadDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := `
+database:
+  host: from-file
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := config.LoadDatabase(path); err == nil {
+		t.Fatalf("LoadDatabase() without database password error = nil, want an error")
+	}
+
+	// the migrations don't need the jwt key of the API
+	t.Setenv("PGPASSWORD", "secret")
+
+	cfg, err := config.LoadDatabase(path)
+	if err != nil {
+		t.Fatalf("LoadDatabase() error = %v", err)
+	}
+	if cfg.Database.Host != "from-file" || cfg.Database.Password != "secret" {
+		t.Errorf("LoadDatabase() Database = %+v, want the host of the file and the password of the environment", cfg.Database)
+	}
+
+	if _, err := config.Load(path); err == nil {
+		t.Errorf("Load() without jwt key error = nil, want an error")
+	}
+}
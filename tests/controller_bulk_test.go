@@ -0,0 +1,483 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// initBulkServer serves the pod and deployment deletions against a fake cluster holding the objects,
+// the events recorded are kept in the returned repository.
+func initBulkServer(objects ...runtime.Object) (*echo.Echo, kubernetes.Interface, *chainRepo) {
+	events := &chainRepo{}
+	e, client := newBulkServer(events, objects...)
+
+	return e, client, events
+}
+
+// newBulkServer serves the pod and deployment deletions recording their events in the repository.
+func newBulkServer(events interfaces.EventInterfaces, objects ...runtime.Object) (*echo.Echo, kubernetes.Interface) {
+	client := pkg.NewFakeKubernetesClientWithObjects(objects...)
+
+	clients := repositories.NewSingleKubeClient(client)
+	eventUC := uc.NewEventUC(events)
+	podUC := uc.NewPodUC(repositories.NewPodRepository(clients), repositories.NewClusterEventRepository(clients), repositories.NewReferenceRepository(clients), eventUC)
+	podHandlers := controller.NewPodHandler(podUC, nil, nil)
+	deploymentUC := uc.NewDeploymentUC(repositories.NewDeploymentInterfaces(clients), repositories.NewClusterEventRepository(clients), repositories.NewReferenceRepository(clients), eventUC)
+	deploymentHandlers := controller.NewDeploymentHandler(deploymentUC, nil)
+
+	e := newTestEcho()
+
+	e.DELETE("/pods", podHandlers.BulkDelete)
+	e.DELETE("/pods/:id", podHandlers.Delete)
+	e.DELETE("/deployments", deploymentHandlers.BulkDelete)
+	e.DELETE("/deployments/:id", deploymentHandlers.Delete)
+
+	return e, client
+}
+
+func TestDeploymentHandler_DeleteOnlyTheDeployment(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		byUID      bool
+		wantStatus int
+		wantLeft   []string
+	}{
+		{
+			name:       "by name",
+			id:         "web",
+			wantStatus: http.StatusOK,
+			wantLeft:   []string{"api"},
+		},
+		{
+			name:       "by UID",
+			id:         "web",
+			byUID:      true,
+			wantStatus: http.StatusOK,
+			wantLeft:   []string{"api"},
+		},
+		{
+			name:       "missing",
+			id:         "missing",
+			wantStatus: http.StatusNotFound,
+			wantLeft:   []string{"api", "web"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, client, _ := initBulkServer(
+				fakeDeployment("web", "web"),
+				fakeDeployment("api", "api"),
+			)
+
+			id := tt.id
+			if tt.byUID {
+				deployment, err := client.AppsV1().Deployments("demo").Get(context.Background(), tt.id, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("failed to get deployment: %v", err)
+				}
+				id = string(deployment.UID)
+			}
+
+			target := "/deployments/" + id + "?namespace=demo"
+			req := httptest.NewRequest(http.MethodDelete, target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("DELETE %s status = %d, want %d, body: %s", target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if left := deploymentNames(t, client); strings.Join(left, ",") != strings.Join(tt.wantLeft, ",") {
+				t.Errorf("DELETE %s deployments left = %v, want %v", target, left, tt.wantLeft)
+			}
+		})
+	}
+}
+
+func TestBulkDelete_FakeCluster(t *testing.T) {
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+			nodePod("web-1", "worker-1", "ReplicaSet", "web"),
+			nodePod("api-0", "worker-1", "ReplicaSet", "api"),
+			&policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "demo"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}},
+			},
+			fakeDeployment("web", "web"),
+			fakeDeployment("api", "api"),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		target       string
+		staleBy      string
+		wantPreview  []string
+		wantStatus   int
+		wantContains []string
+		wantPods     []string
+		wantEvents   []string
+	}{
+		{
+			name:        "delete pods",
+			target:      "/pods?namespace=demo&labelSelector=app%3Dweb",
+			wantPreview: []string{"web-0", "web-1"},
+			wantStatus:  http.StatusOK,
+			wantPods:    []string{"api-0"},
+			wantEvents:  []string{"pod/delete", "pod/delete"},
+		},
+		{
+			name:        "dry run",
+			target:      "/pods?namespace=demo&labelSelector=app%3Dweb&dryRun=true",
+			wantPreview: []string{"web-0", "web-1"},
+			wantStatus:  http.StatusOK,
+			wantPods:    []string{"api-0", "web-0", "web-1"},
+			wantEvents:  []string{},
+		},
+		{
+			name:         "evict pods protected by a budget",
+			target:       "/pods?namespace=demo&labelSelector=app&evict=true",
+			wantPreview:  []string{"api-0", "web-0", "web-1"},
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"succeeded":2`, `"failed":1`, "disruption budget api"},
+			wantPods:     []string{"api-0"},
+			wantEvents:   []string{"pod/evict", "pod/evict", "pod/evict"},
+		},
+		{
+			name:         "refused when the matched pods changed",
+			target:       "/pods?namespace=demo&labelSelector=app%3Dweb",
+			staleBy:      "web-1",
+			wantPreview:  []string{"web-0", "web-1"},
+			wantStatus:   http.StatusPreconditionFailed,
+			wantContains: []string{`"code":"precondition_failed"`},
+			wantPods:     []string{"api-0", "web-0"},
+			wantEvents:   []string{},
+		},
+		{
+			name:        "delete deployments",
+			target:      "/deployments?namespace=demo&labelSelector=app%3Dweb",
+			wantPreview: []string{"web"},
+			wantStatus:  http.StatusOK,
+			wantPods:    []string{"api-0", "web-0", "web-1"},
+			wantEvents:  []string{"deployment/delete"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, client, events := initBulkServer(objects()...)
+
+			preview := previewBulkDelete(t, e, tt.target)
+			names := make([]string, 0)
+			for _, v := range preview.Items {
+				names = append(names, v.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantPreview, ",") {
+				t.Fatalf("DELETE %s preview = %v, want %v", tt.target, names, tt.wantPreview)
+			}
+			if left := podNames(t, client); len(left) != 3 {
+				t.Fatalf("DELETE %s deleted pods on preview, left %v", tt.target, left)
+			}
+
+			if tt.staleBy != "" {
+				if err := client.CoreV1().Pods("demo").Delete(context.Background(), tt.staleBy, metav1.DeleteOptions{}); err != nil {
+					t.Fatalf("failed to delete pod: %v", err)
+				}
+			}
+
+			target := tt.target + "&confirm=" + preview.ConfirmationToken
+			req := httptest.NewRequest(http.MethodDelete, target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("DELETE %s status = %d, want %d, body: %s", target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("DELETE %s body = %s, want it to contain %s", target, rec.Body.String(), want)
+				}
+			}
+			if left := podNames(t, client); strings.Join(left, ",") != strings.Join(tt.wantPods, ",") {
+				t.Errorf("DELETE %s pods left = %v, want %v", target, left, tt.wantPods)
+			}
+
+			recorded := make([]string, 0)
+			for _, v := range events.events {
+				recorded = append(recorded, v.Category+"/"+v.Type)
+			}
+			if strings.Join(recorded, ",") != strings.Join(tt.wantEvents, ",") {
+				t.Errorf("DELETE %s events = %v, want %v", target, recorded, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestBulkDelete_InvalidRequests(t *testing.T) {
+	e, client, _ := initBulkServer(
+		nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+		fakeDeployment("web", "web"),
+	)
+
+	tests := []struct {
+		name         string
+		target       string
+		wantStatus   int
+		wantContains string
+	}{
+		{
+			name:         "without label selector",
+			target:       "/pods?namespace=demo",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: "labelSelector is required",
+		},
+		{
+			name:         "with an invalid label selector",
+			target:       "/pods?namespace=demo&labelSelector=app%3D%3D%3Dweb",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: "invalid labelSelector",
+		},
+		{
+			name:         "with an invalid evict",
+			target:       "/pods?namespace=demo&labelSelector=app&evict=maybe",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: "evict must be a boolean",
+		},
+		{
+			name:         "with a forged token",
+			target:       "/deployments?namespace=demo&labelSelector=app&confirm=forged",
+			wantStatus:   http.StatusPreconditionFailed,
+			wantContains: "changed since the preview",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("DELETE %s status = %d, want %d, body: %s", tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContains) {
+				t.Errorf("DELETE %s body = %s, want it to contain %s", tt.target, rec.Body.String(), tt.wantContains)
+			}
+		})
+	}
+
+	if left := podNames(t, client); len(left) != 1 {
+		t.Errorf("pods left = %v, want web-0", left)
+	}
+	if left := deploymentNames(t, client); len(left) != 1 {
+		t.Errorf("deployments left = %v, want web", left)
+	}
+}
+
+func TestBulkDelete_GracePeriod(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{
+			name:   "delete",
+			target: "/pods?namespace=demo&labelSelector=app%3Dweb",
+		},
+		{
+			name:   "evict",
+			target: "/pods?namespace=demo&labelSelector=app%3Dweb&evict=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, client, _ := initBulkServer(nodePod("web-0", "worker-1", "ReplicaSet", "web"))
+
+			preview := previewBulkDelete(t, e, tt.target)
+
+			target := tt.target + "&confirm=" + preview.ConfirmationToken
+			req := httptest.NewRequest(http.MethodDelete, target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"succeeded":1`) {
+				t.Fatalf("DELETE %s status = %d, want %d, body: %s", target, rec.Code, http.StatusOK, rec.Body.String())
+			}
+
+			// the pods keep their own termination grace period
+			deletions := 0
+			for _, action := range client.(*fake.Clientset).Actions() {
+				var gracePeriod *int64
+				switch action := action.(type) {
+				case k8stesting.CreateAction:
+					eviction, ok := action.GetObject().(*policyv1.Eviction)
+					if !ok {
+						continue
+					}
+					gracePeriod = eviction.DeleteOptions.GracePeriodSeconds
+				case k8stesting.DeleteAction:
+					gracePeriod = action.GetDeleteOptions().GracePeriodSeconds
+				default:
+					continue
+				}
+				deletions++
+
+				if gracePeriod != nil {
+					t.Errorf("DELETE %s grace period = %d, want it unset", target, *gracePeriod)
+				}
+			}
+			if deletions != 1 {
+				t.Errorf("DELETE %s deletions = %d, want 1", target, deletions)
+			}
+		})
+	}
+}
+
+func TestBulkDelete_EventFailure(t *testing.T) {
+	events := &failingEventRepo{chainRepo: &chainRepo{}, limit: 1}
+	e, client := newBulkServer(events,
+		nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+		nodePod("web-1", "worker-1", "ReplicaSet", "web"),
+		nodePod("web-2", "worker-1", "ReplicaSet", "web"),
+	)
+
+	target := "/pods?namespace=demo&labelSelector=app%3Dweb"
+	preview := previewBulkDelete(t, e, target)
+
+	target += "&confirm=" + preview.ConfirmationToken
+	req := httptest.NewRequest(http.MethodDelete, target, nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("DELETE %s status = %d, want %d, body: %s", target, rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var response struct {
+		Data model.BulkDeleteResult `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode result %s: %v", rec.Body.String(), err)
+	}
+	if response.Data.Succeeded != 1 || response.Data.Failed != 2 || len(response.Data.Items) != 3 {
+		t.Fatalf("DELETE %s result = %+v, want 1 succeeded and 2 failed", target, response.Data)
+	}
+	for _, v := range response.Data.Items[1:] {
+		if !strings.Contains(v.Error, "failed to create event") {
+			t.Errorf("DELETE %s item %s error = %q, want the event failure", target, v.Name, v.Error)
+		}
+	}
+
+	if left := podNames(t, client); strings.Join(left, ",") != "web-1,web-2" {
+		t.Errorf("DELETE %s pods left = %v, want [web-1 web-2]", target, left)
+	}
+}
+
+// failingEventRepo records the first events, up to the limit, and fails to record the others.
+type failingEventRepo struct {
+	*chainRepo
+	limit int
+}
+
+func (rc *failingEventRepo) Create(ctx context.Context, event *model.Event) (*model.Event, error) {
+	if len(rc.events) >= rc.limit {
+		return nil, errors.New("database is down")
+	}
+
+	return rc.chainRepo.Create(ctx, event)
+}
+
+// previewBulkDelete requests the preview of the bulk deletion.
+func previewBulkDelete(t *testing.T, e *echo.Echo, target string) model.BulkDeletePreview {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodDelete, target, nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("DELETE %s status = %d, want %d, body: %s", target, rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var response struct {
+		Data model.BulkDeletePreview `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode preview %s: %v", rec.Body.String(), err)
+	}
+	if response.Data.ConfirmationToken == "" {
+		t.Fatalf("DELETE %s preview without confirmation token: %s", target, rec.Body.String())
+	}
+
+	return response.Data
+}
+
+// fakeDeployment returns a deployment of the demo namespace labeled with the app.
+func fakeDeployment(name, app string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo", Labels: map[string]string{"app": app}},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+		},
+	}
+}
+
+func podNames(t *testing.T, client kubernetes.Interface) []string {
+	t.Helper()
+
+	pods, err := client.CoreV1().Pods("demo").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list pods: %v", err)
+	}
+
+	names := make([]string, 0, len(pods.Items))
+	for _, v := range pods.Items {
+		names = append(names, v.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func deploymentNames(t *testing.T, client kubernetes.Interface) []string {
+	t.Helper()
+
+	deployments, err := client.AppsV1().Deployments("demo").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list deployments: %v", err)
+	}
+
+	names := make([]string, 0, len(deployments.Items))
+	for _, v := range deployments.Items {
+		names = append(names, v.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// registeredKubeconfig is a kubeconfig of the prod cluster whose user authenticates with user.
+func registeredKubeconfig(cluster, user string) string {
+	return `apiVersion: v1
+kind: Config
+current-context: prod
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com:6443
+` + cluster + `
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+users:
+- name: admin
+  user:
+` + user
+}
+
+func TestClusterHandler_Create(t *testing.T) {
+	e := initFakeServer(t)
+
+	tests := []struct {
+		name         string
+		kubeconfig   string
+		wantStatus   int
+		wantContains string
+	}{
+		{
+			name:         "embedded credentials",
+			kubeconfig:   registeredKubeconfig("    certificate-authority-data: ZmFrZQ==", "    token: secret"),
+			wantStatus:   http.StatusCreated,
+			wantContains: `"data":"prod"`,
+		},
+		{
+			name:         "exec plugin",
+			kubeconfig:   registeredKubeconfig("", "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: sh\n      args: [\"-c\", \"id\"]"),
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantContains: `users[admin].exec is not allowed`,
+		},
+		{
+			name:         "auth provider",
+			kubeconfig:   registeredKubeconfig("", "    auth-provider:\n      name: oidc"),
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantContains: `users[admin].auth-provider is not allowed`,
+		},
+		{
+			name:         "token file",
+			kubeconfig:   registeredKubeconfig("", "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"),
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantContains: `users[admin].tokenFile is not allowed`,
+		},
+		{
+			name:         "certificate files",
+			kubeconfig:   registeredKubeconfig("    certificate-authority: /etc/kubernetes/pki/ca.crt", "    client-certificate: /etc/kubernetes/pki/admin.crt\n    client-key: /etc/kubernetes/pki/admin.key"),
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantContains: `"clusters[prod].certificate-authority is not allowed"},{"field":"kubeconfig","message":"users[admin].client-certificate is not allowed"},{"field":"kubeconfig","message":"users[admin].client-key is not allowed"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(model.ClusterRequest{Name: "prod", Kubeconfig: tt.kubeconfig})
+			if err != nil {
+				t.Fatalf("failed to marshal cluster request: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/clusters", strings.NewReader(string(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("POST /clusters status = %d, want %d, body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContains) {
+				t.Errorf("POST /clusters body = %s, want it to contain %s", rec.Body.String(), tt.wantContains)
+			}
+		})
+	}
+}
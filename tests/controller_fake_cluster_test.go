@@ -0,0 +1,840 @@
+package tests
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// initFakeServer serves the pod, deployment, namespace, apply and cluster registration routes against a fake cluster seeded with the fixtures.
+// The cluster serves the metrics API only when pod metrics are given.
+func initFakeServer(t *testing.T, podMetrics ...*unstructured.Unstructured) *echo.Echo {
+	client, err := pkg.NewFakeKubernetesClient("../fixtures")
+	if err != nil {
+		t.Fatalf("failed to init fake kubernetes client: %v", err)
+	}
+
+	if len(podMetrics) > 0 {
+		if err := pkg.ServeFakePodMetrics(client, podMetrics...); err != nil {
+			t.Fatalf("failed to serve fake pod metrics: %v", err)
+		}
+	}
+
+	return newFakeServer(client)
+}
+
+// newFakeServer serves the routes of initFakeServer against the cluster of client.
+func newFakeServer(client kubernetes.Interface) *echo.Echo {
+	clients := repositories.NewSingleKubeClient(client)
+	eventUC := uc.NewEventUC(&chainRepo{})
+	podUC := uc.NewPodUC(repositories.NewPodRepository(clients), repositories.NewClusterEventRepository(clients), repositories.NewReferenceRepository(clients), eventUC)
+	manifestRepo := repositories.NewManifestRepository(clients)
+	exportUC := uc.NewExportUC(manifestRepo)
+	metricsUC := uc.NewMetricsUC(repositories.NewMetricsRepository(clients), repositories.NewPodRepository(clients), repositories.NewNamespaceRepository(clients))
+	podHandlers := controller.NewPodHandler(podUC, exportUC, metricsUC)
+	deploymentUC := uc.NewDeploymentUC(repositories.NewDeploymentInterfaces(clients), repositories.NewClusterEventRepository(clients), repositories.NewReferenceRepository(clients), eventUC)
+	deploymentHandlers := controller.NewDeploymentHandler(deploymentUC, exportUC)
+	namespaceUC := uc.NewNamespaceUC(repositories.NewNamespaceRepository(clients), repositories.NewClusterEventRepository(clients), eventUC)
+	namespaceHandlers := controller.NewNamespaceHandler(namespaceUC, exportUC, metricsUC)
+	applyHandlers := controller.NewApplyHandler(uc.NewApplyUC(manifestRepo, eventUC))
+	clusterHandlers := controller.NewClusterHandler(uc.NewClusterUC(&clusterRepo{names: []string{"default"}}, eventUC, 0))
+
+	e := newTestEcho()
+
+	podsRoutes := e.Group("/pods")
+	podsRoutes.GET("", podHandlers.List)
+	podsRoutes.GET("/:id", podHandlers.GetByNameOrUID)
+	podsRoutes.GET("/:id/events", podHandlers.ListEvents)
+	podsRoutes.GET("/:id/metrics", podHandlers.Metrics)
+	podsRoutes.POST("", podHandlers.Create)
+	podsRoutes.PUT("/:id", podHandlers.Update)
+	podsRoutes.PATCH("/:id", podHandlers.Patch)
+	podsRoutes.DELETE("/:id", podHandlers.Delete)
+
+	deploymentsRoutes := e.Group("/deployments")
+	deploymentsRoutes.GET("/:id", deploymentHandlers.GetByNameOrUID)
+
+	namespacesRoutes := e.Group("/namespaces")
+	namespacesRoutes.GET("/:id", namespaceHandlers.GetByNameOrUID)
+	namespacesRoutes.GET("/:id/export", namespaceHandlers.Export)
+	namespacesRoutes.GET("/:id/metrics", namespaceHandlers.Metrics)
+
+	e.POST("/apply", applyHandlers.Apply)
+	e.POST("/clusters", clusterHandlers.Create)
+
+	return e
+}
+
+func TestPodHandler_FakeCluster(t *testing.T) {
+	e := initFakeServer(t)
+
+	// steps run in order against the same cluster
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		body         string
+		contentType  string
+		ifMatch      string
+		wantContains string
+		wantETag     string
+		wantStatus   int
+	}{
+		{
+			name:         "list fixture pods",
+			method:       http.MethodGet,
+			target:       "/pods?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: `"web-1"`,
+		},
+		{
+			name:         "get pod with its latest warnings",
+			method:       http.MethodGet,
+			target:       "/pods/web-1?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: `"reason":"BackOff"`,
+			wantETag:     `"1001"`,
+		},
+		{
+			name:         "update pod with a stale ETag",
+			method:       http.MethodPut,
+			target:       "/pods/web-1?namespace=demo",
+			body:         `{"pod":{"spec":{"containers":[{"name":"web","image":"nginx:1.28"}]}}}`,
+			ifMatch:      `"999"`,
+			wantStatus:   http.StatusPreconditionFailed,
+			wantContains: `"code":"precondition_failed"`,
+		},
+		{
+			name:         "update pod with several ETags",
+			method:       http.MethodPut,
+			target:       "/pods/web-1?namespace=demo",
+			body:         `{"pod":{"spec":{"containers":[{"name":"web","image":"nginx:1.28"}]}}}`,
+			ifMatch:      `"999", "1001"`,
+			wantStatus:   http.StatusBadRequest,
+			wantContains: `"code":"bad_request"`,
+		},
+		{
+			name:       "update pod with its ETag",
+			method:     http.MethodPut,
+			target:     "/pods/web-1?namespace=demo",
+			body:       `{"pod":{"spec":{"containers":[{"name":"web","image":"nginx:1.28"}]}}}`,
+			ifMatch:    `"1001"`,
+			wantStatus: http.StatusOK,
+			wantETag:   `"1001"`,
+		},
+		{
+			name:         "merge patch pod labels",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo",
+			body:         `{"metadata":{"labels":{"tier":"frontend"}}}`,
+			contentType:  "application/merge-patch+json",
+			wantStatus:   http.StatusOK,
+			wantContains: `"tier":"frontend"`,
+		},
+		{
+			name:         "dry run a pod patch",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo&dryRun=true",
+			body:         `{"metadata":{"labels":{"tier":"backend"}}}`,
+			contentType:  "application/merge-patch+json",
+			wantStatus:   http.StatusOK,
+			wantContains: `"tier":"backend"`,
+		},
+		{
+			name:         "diff a pod patch",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo&diff=true",
+			body:         `{"metadata":{"labels":{"tier":"backend"}}}`,
+			contentType:  "application/merge-patch+json",
+			wantStatus:   http.StatusOK,
+			wantContains: `{"from":"frontend","to":"backend","path":"metadata.labels.tier","op":"replace"}`,
+		},
+		{
+			name:         "get pod unchanged by the dry runs",
+			method:       http.MethodGet,
+			target:       "/pods/web-1?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: `"tier":"frontend"`,
+		},
+		{
+			name:         "strategic merge patch pod container",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo",
+			body:         `{"spec":{"containers":[{"name":"web","image":"nginx:1.29"}]}}`,
+			contentType:  "application/strategic-merge-patch+json",
+			wantStatus:   http.StatusOK,
+			wantContains: `"image":"nginx:1.29"`,
+		},
+		{
+			name:         "apply pod annotations",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo&fieldManager=tests&force=true",
+			body:         "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web-1\n  namespace: demo\n  annotations:\n    owner: team-a\n",
+			contentType:  "application/apply-patch+yaml",
+			wantStatus:   http.StatusOK,
+			wantContains: `"owner":"team-a"`,
+		},
+		{
+			name:        "merge patch pod with its ETag",
+			method:      http.MethodPatch,
+			target:      "/pods/web-1?namespace=demo",
+			body:        `{"metadata":{"labels":{"tier":"frontend"}}}`,
+			contentType: "application/merge-patch+json",
+			ifMatch:     `"1001"`,
+			wantStatus:  http.StatusOK,
+			wantETag:    `"1001"`,
+		},
+		{
+			name:         "patch pod with an unsupported format",
+			method:       http.MethodPatch,
+			target:       "/pods/web-1?namespace=demo",
+			body:         `[{"op":"add","path":"/metadata/labels/tier","value":"backend"}]`,
+			contentType:  "application/json-patch+json",
+			wantStatus:   http.StatusUnsupportedMediaType,
+			wantContains: `"code":"bad_request"`,
+		},
+		{
+			name:         "patch missing pod",
+			method:       http.MethodPatch,
+			target:       "/pods/missing?namespace=demo",
+			body:         `{"metadata":{"labels":{"tier":"frontend"}}}`,
+			contentType:  "application/merge-patch+json",
+			wantStatus:   http.StatusNotFound,
+			wantContains: `"code":"not_found"`,
+		},
+		{
+			name:         "delete pod with a stale ETag",
+			method:       http.MethodDelete,
+			target:       "/pods/web-1?namespace=demo",
+			ifMatch:      `"999"`,
+			wantStatus:   http.StatusPreconditionFailed,
+			wantContains: `"code":"precondition_failed"`,
+		},
+		{
+			name:         "list pod events",
+			method:       http.MethodGet,
+			target:       "/pods/web-1/events?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: `"reason":"Scheduled"`,
+		},
+		{
+			name:         "list pod events of an unknown type",
+			method:       http.MethodGet,
+			target:       "/pods/web-1/events?namespace=demo&type=Normal,reason%3DKilling",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: `type must be Normal or Warning`,
+		},
+		{
+			name:         "dry run pod creation",
+			method:       http.MethodPost,
+			target:       "/pods?dryRun=All",
+			body:         `{"pod":{"metadata":{"name":"api","namespace":"demo"},"spec":{"containers":[{"name":"api","image":"busybox"}]}}}`,
+			wantStatus:   http.StatusCreated,
+			wantContains: `Dry run, nothing was changed.`,
+		},
+		{
+			name:         "diff pod creation",
+			method:       http.MethodPost,
+			target:       "/pods?diff=true",
+			body:         `{"pod":{"metadata":{"name":"api","namespace":"demo"},"spec":{"containers":[{"name":"api","image":"busybox"}]}}}`,
+			wantStatus:   http.StatusOK,
+			wantContains: `"live":null`,
+		},
+		{
+			name:         "dry run with an invalid value",
+			method:       http.MethodPost,
+			target:       "/pods?dryRun=maybe",
+			body:         `{"pod":{"metadata":{"name":"api","namespace":"demo"},"spec":{"containers":[{"name":"api","image":"busybox"}]}}}`,
+			wantStatus:   http.StatusBadRequest,
+			wantContains: `"code":"bad_request"`,
+		},
+		{
+			name:         "create pod",
+			method:       http.MethodPost,
+			target:       "/pods",
+			body:         `{"pod":{"metadata":{"name":"api","namespace":"demo"},"spec":{"containers":[{"name":"api","image":"busybox"}]}}}`,
+			wantStatus:   http.StatusCreated,
+			wantContains: `"data":"api"`,
+		},
+		{
+			name:         "create invalid pod",
+			method:       http.MethodPost,
+			target:       "/pods",
+			body:         `{"pod":{"metadata":{"name":"Invalid_Name","namespace":"demo"},"spec":{"containers":[]}}}`,
+			wantStatus:   http.StatusUnprocessableEntity,
+			wantContains: `"field":"pod.metadata.name"`,
+		},
+		{
+			name:         "create existing pod",
+			method:       http.MethodPost,
+			target:       "/pods",
+			body:         `{"pod":{"metadata":{"name":"api","namespace":"demo"},"spec":{"containers":[{"name":"api","image":"busybox"}]}}}`,
+			wantStatus:   http.StatusConflict,
+			wantContains: `"code":"already_exists"`,
+		},
+		{
+			name:         "get created pod",
+			method:       http.MethodGet,
+			target:       "/pods/api?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: `"image":"busybox"`,
+		},
+		{
+			name:         "dry run pod deletion with foreground propagation",
+			method:       http.MethodDelete,
+			target:       "/pods/web-0?namespace=demo&dryRun=true&propagationPolicy=Foreground",
+			wantStatus:   http.StatusOK,
+			wantContains: `Dry run, nothing was changed.`,
+		},
+		{
+			name:         "diff pod deletion",
+			method:       http.MethodDelete,
+			target:       "/pods/web-0?namespace=demo&diff=true",
+			wantStatus:   http.StatusOK,
+			wantContains: `"result":null`,
+		},
+		{
+			name:         "delete pod with an invalid propagation policy",
+			method:       http.MethodDelete,
+			target:       "/pods/web-0?namespace=demo&propagationPolicy=Later",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: `"code":"bad_request"`,
+		},
+		{
+			name:       "delete pod",
+			method:     http.MethodDelete,
+			target:     "/pods/web-0?namespace=demo",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "get deleted pod",
+			method:       http.MethodGet,
+			target:       "/pods/web-0?namespace=demo",
+			wantStatus:   http.StatusNotFound,
+			wantContains: `"code":"not_found"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			contentType := tt.contentType
+			if contentType == "" {
+				contentType = echo.MIMEApplicationJSON
+			}
+			req.Header.Set(echo.HeaderContentType, contentType)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("%s %s status = %d, want %d, body: %s", tt.method, tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContains) {
+				t.Errorf("%s %s body = %s, want it to contain %s", tt.method, tt.target, rec.Body.String(), tt.wantContains)
+			}
+			if etag := rec.Header().Get("ETag"); tt.wantETag != "" && etag != tt.wantETag {
+				t.Errorf("%s %s ETag = %s, want %s", tt.method, tt.target, etag, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestPodHandler_PatchChangedMeanwhile(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		ifMatch     string
+		wantStatus  int
+	}{
+		{
+			name:        "merge patch",
+			body:        `{"metadata":{"labels":{"tier":"frontend"}}}`,
+			contentType: "application/merge-patch+json",
+			ifMatch:     `"1001"`,
+			wantStatus:  http.StatusPreconditionFailed,
+		},
+		{
+			name:        "strategic merge patch",
+			body:        `{"spec":{"containers":[{"name":"web","image":"nginx:1.29"}]}}`,
+			contentType: "application/strategic-merge-patch+json",
+			ifMatch:     `"1001"`,
+			wantStatus:  http.StatusPreconditionFailed,
+		},
+		{
+			name:        "apply",
+			body:        "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web-1\n  namespace: demo\n  annotations:\n    owner: team-a\n",
+			contentType: "application/apply-patch+yaml",
+			ifMatch:     `"1001"`,
+			wantStatus:  http.StatusPreconditionFailed,
+		},
+		{
+			name:        "merge patch without ETag",
+			body:        `{"metadata":{"labels":{"tier":"frontend"}}}`,
+			contentType: "application/merge-patch+json",
+			wantStatus:  http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := pkg.NewFakeKubernetesClient("../fixtures")
+			if err != nil {
+				t.Fatalf("failed to init fake kubernetes client: %v", err)
+			}
+
+			// another client updates the pod once it was read, right before the patch
+			client.(*fake.Clientset).PrependReactor("patch", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				podsResource := corev1.SchemeGroupVersion.WithResource("pods")
+				object, err := client.(*fake.Clientset).Tracker().Get(podsResource, "demo", "web-1")
+				if err != nil {
+					return true, nil, err
+				}
+
+				pod := object.(*corev1.Pod).DeepCopy()
+				pod.ResourceVersion = "1002"
+				return false, nil, client.(*fake.Clientset).Tracker().Update(podsResource, pod, "demo")
+			})
+
+			e := newFakeServer(client)
+
+			req := httptest.NewRequest(http.MethodPatch, "/pods/web-1?namespace=demo&fieldManager=tests&force=true", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("PATCH /pods/web-1 status = %d, want %d, body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestApplyHandler_FakeCluster(t *testing.T) {
+	e := initFakeServer(t)
+
+	manifests := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  labels:
+    app: shop
+data:
+  mode: fast
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: shop
+  labels:
+    app: shop
+`
+
+	// steps run in order against the same cluster
+	tests := []struct {
+		name         string
+		target       string
+		body         string
+		wantContains []string
+		wantStatus   int
+	}{
+		{
+			name:       "apply new objects, namespace first",
+			target:     "/apply?namespace=demo",
+			body:       manifests,
+			wantStatus: http.StatusOK,
+			wantContains: []string{
+				`{"apiVersion":"v1","kind":"Namespace","name":"shop","status":"created"},{"apiVersion":"v1","kind":"ConfigMap","namespace":"demo","name":"settings","status":"created"}`,
+			},
+		},
+		{
+			name:         "apply the same objects",
+			target:       "/apply?namespace=demo",
+			body:         manifests,
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"shop","status":"unchanged"`, `"name":"settings","status":"unchanged"`},
+		},
+		{
+			name:         "dry run a change",
+			target:       "/apply?namespace=demo&dryRun=All",
+			body:         strings.Replace(manifests, "mode: fast", "mode: safe", 1),
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"settings","status":"configured"`, `"dryRun":true`},
+		},
+		{
+			name:         "apply a JSON list with an unknown kind",
+			target:       "/apply?namespace=demo",
+			body:         `[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"extra","labels":{"app":"shop"}}},{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w"}}]`,
+			wantStatus:   http.StatusMultiStatus,
+			wantContains: []string{`"name":"extra","status":"created"`, `"name":"w","status":"failed"`},
+		},
+		{
+			name:         "prune the objects missing from the manifests",
+			target:       "/apply?namespace=demo&prune=app%3Dshop",
+			body:         manifests,
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"extra","status":"pruned"`},
+		},
+		{
+			name:         "invalid manifests",
+			target:       "/apply",
+			body:         "kind: ConfigMap\nmetadata:\n  name: nameless-version\n",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:         "invalid prune selector",
+			target:       "/apply?prune=app%3D%3D%3D",
+			body:         manifests,
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, "application/yaml")
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("POST %s status = %d, want %d, body: %s", tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("POST %s body = %s, want it to contain %s", tt.target, rec.Body.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestExport_FakeCluster(t *testing.T) {
+	e := initFakeServer(t)
+
+	tests := []struct {
+		name            string
+		target          string
+		wantContentType string
+		wantContains    []string
+		wantMissing     []string
+		wantStatus      int
+	}{
+		{
+			name:            "export pod as yaml",
+			target:          "/pods/web-1?namespace=demo&export=true",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/yaml",
+			wantContains:    []string{"apiVersion: v1\n", "kind: Pod\n", "name: web-1\n", "image: nginx"},
+			wantMissing:     []string{"status:", "resourceVersion", "uid:", "creationTimestamp", "managedFields", `"message"`},
+		},
+		{
+			name:            "export deployment as json",
+			target:          "/deployments/web?namespace=demo&export=true&format=json",
+			wantStatus:      http.StatusOK,
+			wantContentType: echo.MIMEApplicationJSON,
+			wantContains:    []string{`"apiVersion": "apps/v1"`, `"kind": "Deployment"`, `"replicas"`},
+			wantMissing:     []string{`"status"`, `"resourceVersion"`, `"uid"`, `"creationTimestamp"`, `"data"`},
+		},
+		{
+			name:            "export namespace",
+			target:          "/namespaces/demo?export=true&format=yaml",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/yaml",
+			wantContains:    []string{"kind: Namespace\n", "name: demo\n"},
+			wantMissing:     []string{"status:", "uid:"},
+		},
+		{
+			name:         "format without export",
+			target:       "/pods/web-1?namespace=demo&format=yaml",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:         "export with an unsupported format",
+			target:       "/pods/web-1?namespace=demo&export=true&format=xml",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:       "export missing pod",
+			target:     "/pods/missing?namespace=demo&export=true",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:            "export every object of a namespace",
+			target:          "/namespaces/demo/export",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/yaml",
+			wantContains:    []string{"kind: Namespace\n", "---\n", "kind: Deployment\n", "name: web-0\n", "kind: Pod\n"},
+			wantMissing:     []string{"status:", "resourceVersion", "kind: Event\n"},
+		},
+		{
+			name:         "export a namespace with an unsupported format",
+			target:       "/namespaces/demo/export?format=json",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("GET %s status = %d, want %d, body: %s", tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if contentType := rec.Header().Get(echo.HeaderContentType); tt.wantContentType != "" && contentType != tt.wantContentType {
+				t.Errorf("GET %s Content-Type = %s, want %s", tt.target, contentType, tt.wantContentType)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("GET %s body = %s, want it to contain %s", tt.target, rec.Body.String(), want)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(rec.Body.String(), missing) {
+					t.Errorf("GET %s body = %s, want it not to contain %s", tt.target, rec.Body.String(), missing)
+				}
+			}
+		})
+	}
+}
+
+func TestExport_FakeClusterScheduledPod(t *testing.T) {
+	isController := true
+	client := pkg.NewFakeKubernetesClientWithObjects(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-6d4cf56db6-x2x7k",
+			Namespace: "demo",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-6d4cf56db6", UID: "uid-web-6d4cf56db6", Controller: &isController},
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "worker-1",
+			Containers: []corev1.Container{{Name: "web", Image: "nginx:1.27"}},
+		},
+	})
+	e := newFakeServer(client)
+
+	req := httptest.NewRequest(http.MethodGet, "/pods/web-6d4cf56db6-x2x7k?namespace=demo&export=true", nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET export status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	for _, missing := range []string{"ownerReferences", "ReplicaSet", "nodeName", "worker-1"} {
+		if strings.Contains(rec.Body.String(), missing) {
+			t.Errorf("GET export body = %s, want it not to contain %s", rec.Body.String(), missing)
+		}
+	}
+}
+
+func TestExport_FakeClusterArchive(t *testing.T) {
+	e := initFakeServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/namespaces/demo/export?format=tar.gz", nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if disposition := rec.Header().Get(echo.HeaderContentDisposition); disposition != `attachment; filename="demo.tar.gz"` {
+		t.Errorf("Content-Disposition = %s", disposition)
+	}
+
+	gz, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("failed to read the archive: %v", err)
+	}
+	tr := tar.NewReader(gz)
+
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read the archive: %v", err)
+		}
+		names = append(names, header.Name)
+	}
+
+	want := []string{"demo/00-namespace-demo.yaml", "demo/01-pod-web-0.yaml", "demo/02-pod-web-1.yaml", "demo/03-deployment-web.yaml"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("archive files = %v, want %v", names, want)
+	}
+}
+
+func TestMetrics_FakeCluster(t *testing.T) {
+	withMetrics := initFakeServer(t,
+		podMetrics("demo", "web-0", map[string][2]string{"web": {"250m", "64Mi"}}),
+		podMetrics("demo", "web-1", map[string][2]string{"web": {"5m", "512Mi"}}),
+	)
+	withoutMetrics := initFakeServer(t)
+
+	tests := []struct {
+		server       *echo.Echo
+		name         string
+		target       string
+		wantContains []string
+		wantOrder    []string
+		wantStatus   int
+	}{
+		{
+			name:         "pod metrics",
+			server:       withMetrics,
+			target:       "/pods/web-0/metrics?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"web-0"`, `"cpu":{"usage":"250m"}`, `"memory":{"usage":"64Mi"}`},
+		},
+		{
+			name:       "pod metrics of a missing pod",
+			server:     withMetrics,
+			target:     "/pods/missing/metrics?namespace=demo",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:         "namespace metrics",
+			server:       withMetrics,
+			target:       "/namespaces/demo/metrics",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"namespace":"demo"`, `"cpu":{"usage":"255m"}`, `"memory":{"usage":"576Mi"}`},
+			wantOrder:    []string{`"name":"web-0"`, `"name":"web-1"`},
+		},
+		{
+			name:         "pods sorted by memory",
+			server:       withMetrics,
+			target:       "/pods?namespace=demo&sort=memory",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"usage":{"cpu":"5m","memory":"512Mi"}`},
+			wantOrder:    []string{`"name":"web-1"`, `"name":"web-0"`},
+		},
+		{
+			name:         "pods sorted by cpu",
+			server:       withMetrics,
+			target:       "/pods?namespace=demo&sort=cpu",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"usage":{"cpu":"250m","memory":"64Mi"}`},
+			wantOrder:    []string{`"name":"web-0"`, `"name":"web-1"`},
+		},
+		{
+			name:         "pods sorted by an unsupported resource",
+			server:       withMetrics,
+			target:       "/pods?namespace=demo&sort=disk",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:         "pods sorted by page",
+			server:       withMetrics,
+			target:       "/pods?namespace=demo&sort=cpu&limit=1",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`, "sort can't be used with limit or continue"},
+		},
+		{
+			name:         "next page of pods sorted",
+			server:       withMetrics,
+			target:       "/pods?namespace=demo&sort=memory&continue=token",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:         "pod metrics without metrics-server",
+			server:       withoutMetrics,
+			target:       "/pods/web-0/metrics?namespace=demo",
+			wantStatus:   http.StatusServiceUnavailable,
+			wantContains: []string{`"code":"unavailable"`, "metrics-server"},
+		},
+		{
+			name:         "pods sorted without metrics-server",
+			server:       withoutMetrics,
+			target:       "/pods?namespace=demo&sort=cpu",
+			wantStatus:   http.StatusServiceUnavailable,
+			wantContains: []string{`"code":"unavailable"`},
+		},
+		{
+			name:         "pods unsorted without metrics-server",
+			server:       withoutMetrics,
+			target:       "/pods?namespace=demo",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"web-0"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			tt.server.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("GET %s status = %d, want %d, body: %s", tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			body := rec.Body.String()
+			for _, want := range tt.wantContains {
+				if !strings.Contains(body, want) {
+					t.Errorf("GET %s body = %s, want it to contain %s", tt.target, body, want)
+				}
+			}
+			for i := 1; i < len(tt.wantOrder); i++ {
+				if strings.Index(body, tt.wantOrder[i-1]) > strings.Index(body, tt.wantOrder[i]) {
+					t.Errorf("GET %s body = %s, want %s before %s", tt.target, body, tt.wantOrder[i-1], tt.wantOrder[i])
+				}
+			}
+		})
+	}
+}
+
+// podMetrics returns the metrics-server PodMetrics of a pod, with the cpu and memory usage of each container.
+func podMetrics(namespace, name string, usage map[string][2]string) *unstructured.Unstructured {
+	containers := make([]interface{}, 0, len(usage))
+	for container, v := range usage {
+		containers = append(containers, map[string]interface{}{
+			"name":  container,
+			"usage": map[string]interface{}{"cpu": v[0], "memory": v[1]},
+		})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"timestamp":  "2024-05-01T10:00:00Z",
+		"window":     "30s",
+		"containers": containers,
+	}}
+}
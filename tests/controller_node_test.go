@@ -0,0 +1,441 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// initNodeServer serves the node routes against a fake cluster holding the objects.
+func initNodeServer(objects ...runtime.Object) (*echo.Echo, kubernetes.Interface) {
+	client := pkg.NewFakeKubernetesClientWithObjects(objects...)
+
+	clients := repositories.NewSingleKubeClient(client)
+	nodeUC := uc.NewNodeUC(repositories.NewNodeRepository(clients), repositories.NewPodRepository(clients), uc.NewEventUC(&chainRepo{}))
+	nodeHandlers := controller.NewNodeHandler(nodeUC)
+
+	e := newTestEcho()
+
+	nodesRoutes := e.Group("/nodes")
+	nodesRoutes.GET("", nodeHandlers.List)
+	nodesRoutes.GET("/:id", nodeHandlers.GetByNameOrUID)
+	nodesRoutes.POST("/:id/cordon", nodeHandlers.Cordon)
+	nodesRoutes.POST("/:id/uncordon", nodeHandlers.Uncordon)
+	nodesRoutes.POST("/:id/drain", nodeHandlers.Drain)
+
+	return e, client
+}
+
+func TestNodeHandler_FakeCluster(t *testing.T) {
+	e, client := initNodeServer(
+		fakeNode("worker-1", "control-plane"),
+		fakeNode("worker-2", ""),
+		nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+		nodePod("logs-x1", "worker-1", "DaemonSet", "logs"),
+		nodePod("web-1", "worker-2", "ReplicaSet", "web"),
+	)
+
+	// steps run in order against the same cluster
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		wantStatus   int
+		wantContains []string
+	}{
+		{
+			name:         "list nodes with their pods",
+			method:       http.MethodGet,
+			target:       "/nodes",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"worker-1"},"status":"Ready","roles":["control-plane"]`, `"cpu":"4"`, `"pods":2`, `"pods":1`},
+		},
+		{
+			name:         "get a node",
+			method:       http.MethodGet,
+			target:       "/nodes/worker-1",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"type":"Ready","status":"True"`, `"allocatable":{"cpu":"3800m"`, `"name":"logs-x1","namespace":"demo"`},
+		},
+		{
+			name:       "get a missing node",
+			method:     http.MethodGet,
+			target:     "/nodes/missing",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:         "dry run cordon",
+			method:       http.MethodPost,
+			target:       "/nodes/worker-2/cordon?dryRun=true",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{"Dry run, nothing was changed."},
+		},
+		{
+			name:         "still schedulable after the dry run",
+			method:       http.MethodGet,
+			target:       "/nodes",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"worker-2"},"status":"Ready",`},
+		},
+		{
+			name:       "cordon",
+			method:     http.MethodPost,
+			target:     "/nodes/worker-2/cordon",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "cordoned",
+			method:       http.MethodGet,
+			target:       "/nodes",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"worker-2"},"status":"Ready,SchedulingDisabled"`},
+		},
+		{
+			name:       "uncordon",
+			method:     http.MethodPost,
+			target:     "/nodes/worker-2/uncordon",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "uncordoned",
+			method:       http.MethodGet,
+			target:       "/nodes",
+			wantStatus:   http.StatusOK,
+			wantContains: []string{`"name":"worker-2"},"status":"Ready",`},
+		},
+		{
+			name:         "drain with an invalid timeout",
+			method:       http.MethodPost,
+			target:       "/nodes/worker-1/drain?timeout=soon",
+			wantStatus:   http.StatusBadRequest,
+			wantContains: []string{`"code":"bad_request"`},
+		},
+		{
+			name:       "drain a missing node",
+			method:     http.MethodPost,
+			target:     "/nodes/missing/drain",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("%s %s status = %d, want %d, body: %s", tt.method, tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			body := rec.Body.String()
+			for _, want := range tt.wantContains {
+				if !strings.Contains(body, want) {
+					t.Errorf("%s %s body = %s, want it to contain %s", tt.method, tt.target, body, want)
+				}
+			}
+		})
+	}
+
+	node, err := client.CoreV1().Nodes().Get(context.Background(), "worker-2", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get node: %v", err)
+	}
+	if node.Spec.Unschedulable {
+		t.Errorf("node worker-2 unschedulable after the uncordon")
+	}
+}
+
+func TestNodeHandler_Drain(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		target      string
+		wantStatus  int
+		wantSteps   []string
+		wantPods    []string
+		wantMessage string
+		wantCordon  bool
+	}{
+		{
+			name: "evicts the pods and skips the DaemonSet ones",
+			objects: []runtime.Object{
+				fakeNode("worker-1", ""),
+				nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+				nodePod("logs-x1", "worker-1", "DaemonSet", "logs"),
+				nodePod("web-1", "worker-2", "ReplicaSet", "web"),
+			},
+			target:     "/nodes/worker-1/drain",
+			wantStatus: http.StatusOK,
+			wantSteps:  []string{"cordoned", "skipped demo/logs-x1", "evicting demo/web-0", "evicted demo/web-0", "done"},
+			wantPods:   []string{"logs-x1", "web-1"},
+			wantCordon: true,
+		},
+		{
+			name: "refuses the pods without controller",
+			objects: []runtime.Object{
+				fakeNode("worker-1", ""),
+				nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+				nodePod("debug", "worker-1", "", ""),
+			},
+			target:      "/nodes/worker-1/drain",
+			wantStatus:  http.StatusBadRequest,
+			wantPods:    []string{"debug", "web-0"},
+			wantMessage: "demo/debug",
+		},
+		{
+			name: "evicts the pods without controller with force",
+			objects: []runtime.Object{
+				fakeNode("worker-1", ""),
+				nodePod("debug", "worker-1", "", ""),
+			},
+			target:     "/nodes/worker-1/drain?force=true",
+			wantStatus: http.StatusOK,
+			wantSteps:  []string{"cordoned", "evicting demo/debug", "evicted demo/debug", "done"},
+			wantPods:   []string{},
+			wantCordon: true,
+		},
+		{
+			name: "dry run",
+			objects: []runtime.Object{
+				fakeNode("worker-1", ""),
+				nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+			},
+			target:     "/nodes/worker-1/drain?dryRun=true",
+			wantStatus: http.StatusOK,
+			wantSteps:  []string{"cordoned", "evicting demo/web-0", "evicted demo/web-0", "done"},
+			wantPods:   []string{"web-0"},
+		},
+		{
+			name: "times out on a disruption budget",
+			objects: []runtime.Object{
+				fakeNode("worker-1", ""),
+				nodePod("web-0", "worker-1", "ReplicaSet", "web"),
+				&policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo"},
+					Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+					Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+				},
+			},
+			target:      "/nodes/worker-1/drain?timeout=1s",
+			wantStatus:  http.StatusOK,
+			wantSteps:   []string{"cordoned", "evicting demo/web-0", "blocked demo/web-0", "failed demo/web-0", "failed"},
+			wantPods:    []string{"web-0"},
+			wantMessage: "deadline exceeded",
+			wantCordon:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, client := initNodeServer(tt.objects...)
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("POST %s status = %d, want %d, body: %s", tt.target, rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantMessage) {
+				t.Errorf("POST %s body = %s, want it to contain %s", tt.target, rec.Body.String(), tt.wantMessage)
+			}
+
+			if tt.wantSteps != nil {
+				if contentType := rec.Header().Get(echo.HeaderContentType); contentType != "application/x-ndjson" {
+					t.Errorf("POST %s Content-Type = %s, want application/x-ndjson", tt.target, contentType)
+				}
+
+				steps := make([]string, 0)
+				for _, line := range strings.Split(strings.TrimSpace(rec.Body.String()), "\n") {
+					var progress model.DrainProgress
+					if err := json.Unmarshal([]byte(line), &progress); err != nil {
+						t.Fatalf("failed to decode progress %s: %v", line, err)
+					}
+
+					step := string(progress.Step)
+					if progress.Pod != "" {
+						step += " " + progress.Namespace + "/" + progress.Pod
+					}
+					steps = append(steps, step)
+				}
+				if strings.Join(steps, ", ") != strings.Join(tt.wantSteps, ", ") {
+					t.Errorf("POST %s steps = %v, want %v", tt.target, steps, tt.wantSteps)
+				}
+			}
+
+			pods, err := client.CoreV1().Pods("").List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("failed to list pods: %v", err)
+			}
+			names := make([]string, 0)
+			for _, v := range pods.Items {
+				names = append(names, v.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantPods, ",") {
+				t.Errorf("pods left = %v, want %v", names, tt.wantPods)
+			}
+
+			node, err := client.CoreV1().Nodes().Get(context.Background(), "worker-1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get node: %v", err)
+			}
+			if node.Spec.Unschedulable != tt.wantCordon {
+				t.Errorf("node unschedulable = %v, want %v", node.Spec.Unschedulable, tt.wantCordon)
+			}
+		})
+	}
+}
+
+func TestNodeHandler_DrainGracePeriod(t *testing.T) {
+	gracePeriod := int64(30)
+
+	tests := []struct {
+		name            string
+		target          string
+		wantGracePeriod *int64
+	}{
+		{
+			name:            "keeps the grace period of the pods",
+			target:          "/nodes/worker-1/drain",
+			wantGracePeriod: nil,
+		},
+		{
+			name:            "overrides the grace period of the pods",
+			target:          "/nodes/worker-1/drain?gracePeriodSeconds=30",
+			wantGracePeriod: &gracePeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, client := initNodeServer(fakeNode("worker-1", ""), nodePod("web-0", "worker-1", "ReplicaSet", "web"))
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("POST %s status = %d, want %d, body: %s", tt.target, rec.Code, http.StatusOK, rec.Body.String())
+			}
+
+			evictions := 0
+			for _, action := range client.(*fake.Clientset).Actions() {
+				create, ok := action.(k8stesting.CreateAction)
+				if !ok || create.GetSubresource() != "eviction" {
+					continue
+				}
+				evictions++
+
+				eviction := create.GetObject().(*policyv1.Eviction)
+				got := eviction.DeleteOptions.GracePeriodSeconds
+				if (got == nil) != (tt.wantGracePeriod == nil) || (got != nil && *got != *tt.wantGracePeriod) {
+					t.Errorf("eviction grace period = %v, want %v", got, tt.wantGracePeriod)
+				}
+			}
+			if evictions != 1 {
+				t.Errorf("evictions = %d, want 1", evictions)
+			}
+		})
+	}
+}
+
+func TestNodeHandler_DrainRecreatedPod(t *testing.T) {
+	pod := nodePod("web-0", "worker-1", "StatefulSet", "web")
+	pod.UID = "uid-web-0"
+	e, client := initNodeServer(fakeNode("worker-1", ""), pod)
+
+	// the StatefulSet brings the evicted pod back at once, with the same name
+	podsResource := corev1.SchemeGroupVersion.WithResource("pods")
+	client.(*fake.Clientset).PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+
+		recreated := nodePod("web-0", "worker-2", "StatefulSet", "web")
+		recreated.UID = "uid-web-0-recreated"
+		if err := client.(*fake.Clientset).Tracker().Delete(podsResource, "demo", "web-0"); err != nil {
+			return true, nil, err
+		}
+
+		return true, nil, client.(*fake.Clientset).Tracker().Add(recreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/nodes/worker-1/drain?timeout=5s", nil)
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST drain status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"step":"evicted"`) || !strings.Contains(rec.Body.String(), `"step":"done"`) {
+		t.Errorf("POST drain body = %s, want the recreated pod to count as evicted", rec.Body.String())
+	}
+}
+
+// fakeNode returns a ready node with 4 CPUs, of the role when not empty.
+func fakeNode(name, role string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3800m"),
+				corev1.ResourceMemory: resource.MustParse("15Gi"),
+			},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+			NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.30.2"},
+		},
+	}
+	if role != "" {
+		node.Labels["node-role.kubernetes.io/"+role] = ""
+	}
+
+	return node
+}
+
+// nodePod returns a running pod of the demo namespace scheduled on the node, controlled by the
+// controller of the kind when not empty.
+func nodePod(name, nodeName, controllerKind, controllerName string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo", Labels: map[string]string{"app": controllerName}},
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "app", Image: "nginx"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if controllerKind != "" {
+		controller := true
+		pod.OwnerReferences = []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: controllerKind, Name: controllerName, UID: types.UID("uid-" + controllerName), Controller: &controller},
+		}
+	}
+
+	return pod
+}
@@ -97,7 +97,7 @@ func TestPodHandlerCreate(t *testing.T) {
 			c := e.NewContext(req, rec)
 
 			// create a new PodHandler
-			podHandler := controller.NewPodHandler(tt.podsUC)
+			podHandler := controller.NewPodHandler(tt.podsUC, nil, nil)
 
 			// call the Create function
 			err = podHandler.Create(c)
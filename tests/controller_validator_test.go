@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	replicas := func(v int32) *int32 { return &v }
+
+	validPod := func() model.PodsCreateRequest {
+		return model.PodsCreateRequest{
+			Pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{Name: "web-0", Labels: map[string]string{"app": "web"}},
+				Spec: model.PodSpec{
+					Containers: []model.Container{{
+						Name:  "web",
+						Image: "nginx:1.27",
+						Ports: []model.ContainerPort{{Name: "http", ContainerPort: 80}},
+					}},
+				},
+			},
+		}
+	}
+
+	validDeployment := func() model.DeploymentCreateRequest {
+		return model.DeploymentCreateRequest{
+			Deployment: model.Deployment{
+				ObjectMeta: model.ObjectMeta{Name: "web"},
+				Spec: model.DeploymentSpec{
+					Replicas: replicas(3),
+					Selector: &model.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					Template: model.PodTemplateSpec{
+						ObjectMeta: model.ObjectMeta{Labels: map[string]string{"app": "web", "tier": "front"}},
+						Spec: model.PodSpec{
+							Containers: []model.Container{{Name: "web", Image: "registry.example.com/team/web@sha256:" + sha256Zero}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		request    func() interface{}
+		name       string
+		wantFields []string
+	}{
+		{
+			name:    "valid pod",
+			request: func() interface{} { r := validPod(); return &r },
+		},
+		{
+			name: "pod without containers and with an invalid name",
+			request: func() interface{} {
+				r := validPod()
+				r.Pod.Name = "Web_0"
+				r.Pod.Spec.Containers = nil
+				return &r
+			},
+			wantFields: []string{"pod.metadata.name", "pod.spec.containers"},
+		},
+		{
+			name: "pod with invalid labels, image and port",
+			request: func() interface{} {
+				r := validPod()
+				r.Pod.Labels = map[string]string{"app/": "web"}
+				r.Pod.Spec.Containers[0].Image = "nginx:latest latest"
+				r.Pod.Spec.Containers[0].Ports[0].ContainerPort = 70000
+				return &r
+			},
+			wantFields: []string{"pod.metadata.labels", "pod.spec.containers[0].image", "pod.spec.containers[0].ports[0].containerPort"},
+		},
+		{
+			name: "pod with invalid resources, restart policy and volume mount",
+			request: func() interface{} {
+				r := validPod()
+				r.Pod.Spec.RestartPolicy = "Sometimes"
+				r.Pod.Spec.Containers[0].Resources = model.ResourceRequirements{
+					Limits:   model.ResourceList{"cpu": "500m", "memory": "lots"},
+					Requests: model.ResourceList{"cpu": "250m"},
+				}
+				r.Pod.Spec.Containers[0].VolumeMounts = []model.VolumeMount{{Name: "data"}}
+				return &r
+			},
+			wantFields: []string{"pod.spec.containers[0].resources.limits[memory]", "pod.spec.containers[0].volumeMounts[0].mountPath", "pod.spec.restartPolicy"},
+		},
+		{
+			name: "env var with both a value and a source",
+			request: func() interface{} {
+				r := validPod()
+				r.Pod.Spec.Containers[0].Env = []model.EnvVar{{
+					Name:      "DB_PASSWORD",
+					Value:     "secret",
+					ValueFrom: &model.EnvVarSource{SecretKeyRef: &model.SecretKeySelector{LocalObjectReference: model.LocalObjectReference{Name: "db"}, Key: "password"}},
+				}}
+				return &r
+			},
+			wantFields: []string{"pod.spec.containers[0].env[0].valueFrom"},
+		},
+		{
+			name:    "valid deployment",
+			request: func() interface{} { r := validDeployment(); return &r },
+		},
+		{
+			name: "deployment selector not matching the template",
+			request: func() interface{} {
+				r := validDeployment()
+				r.Deployment.Spec.Selector.MatchLabels["app"] = "api"
+				return &r
+			},
+			wantFields: []string{"deployment.spec.template.metadata.labels"},
+		},
+		{
+			name: "deployment without selector and too many replicas",
+			request: func() interface{} {
+				r := validDeployment()
+				r.Deployment.Spec.Selector = nil
+				r.Deployment.Spec.Replicas = replicas(100_000)
+				return &r
+			},
+			wantFields: []string{"deployment.spec.replicas", "deployment.spec.selector"},
+		},
+		{
+			name: "namespace name must be a DNS label",
+			request: func() interface{} {
+				return &model.NamespaceCreateRequest{Namespace: model.Namespace{ObjectMeta: model.ObjectMeta{Name: "team.a"}}}
+			},
+			wantFields: []string{"namespace.metadata.name"},
+		},
+		{
+			name: "update with an invalid container name",
+			request: func() interface{} {
+				return &model.PodsUpdateRequest{Pod: model.PodUpdate{Spec: model.SpecRequest{
+					Containers: []model.ContainerRequest{{Name: "", Image: "busybox"}},
+				}}}
+			},
+			wantFields: []string{"pod.spec.containers[0].name"},
+		},
+		{
+			name: "user with an invalid email",
+			request: func() interface{} {
+				return &model.UserRequest{Username: "jane", Email: "jane", Password: "secret", RoleID: 7}
+			},
+			wantFields: []string{"email"},
+		},
+	}
+
+	validator := controller.NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(tt.request())
+
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want none", err)
+				}
+				return
+			}
+
+			var ucErr *uc.Error
+			if !errors.As(err, &ucErr) || ucErr.Code != uc.CodeInvalid {
+				t.Fatalf("Validate() error = %v, want an invalid uc.Error", err)
+			}
+
+			fields := make([]string, 0, len(ucErr.Causes))
+			for _, v := range ucErr.Causes {
+				if v.Message == "" {
+					t.Errorf("cause of %s has no message", v.Field)
+				}
+				fields = append(fields, v.Field)
+			}
+			slices.Sort(fields)
+
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("Validate() fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+const sha256Zero = "0000000000000000000000000000000000000000000000000000000000000000"
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+)
+
+func TestPod_Summary(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	running := &model.ContainerStateRunning{StartedAt: now.Add(-time.Hour)}
+	twoContainers := model.PodSpec{Containers: []model.Container{{Name: "api"}, {Name: "proxy"}}}
+
+	tests := []struct {
+		name string
+		pod  model.Pod
+		want model.PodSummary
+	}{
+		{
+			name: "running and ready",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-3*24*time.Hour - 4*time.Hour)},
+				Spec:       twoContainers,
+				Status: model.PodStatus{
+					Phase: "Running",
+					ContainerStatuses: []model.ContainerStatus{
+						{Name: "api", Ready: true, RestartCount: 1, State: model.ContainerState{Running: running}},
+						{Name: "proxy", Ready: true, State: model.ContainerState{Running: running}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "2/2", Status: "Running", Restarts: 1, Age: "3d4h"},
+		},
+		{
+			name: "crash loop",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-5 * time.Minute)},
+				Spec:       twoContainers,
+				Status: model.PodStatus{
+					Phase: "Running",
+					ContainerStatuses: []model.ContainerStatus{
+						{
+							Name:                 "api",
+							RestartCount:         14,
+							State:                model.ContainerState{Waiting: &model.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+							LastTerminationState: model.ContainerState{Terminated: &model.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+						},
+						{Name: "proxy", Ready: true, State: model.ContainerState{Running: running}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "1/2", Status: "CrashLoopBackOff", Restarts: 14, Age: "5m"},
+		},
+		{
+			name: "terminated without reason",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-30 * time.Second)},
+				Spec:       model.PodSpec{Containers: []model.Container{{Name: "api"}}},
+				Status: model.PodStatus{
+					Phase: "Failed",
+					ContainerStatuses: []model.ContainerStatus{
+						{Name: "api", State: model.ContainerState{Terminated: &model.ContainerStateTerminated{Signal: 9}}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "0/1", Status: "Signal:9", Age: "30s"},
+		},
+		{
+			name: "completed container next to a running one",
+			pod: model.Pod{
+				Spec: twoContainers,
+				Status: model.PodStatus{
+					Phase:      "Running",
+					Conditions: []model.PodCondition{{Type: "Ready", Status: "False"}},
+					ContainerStatuses: []model.ContainerStatus{
+						{Name: "api", Ready: true, State: model.ContainerState{Running: running}},
+						{Name: "proxy", State: model.ContainerState{Terminated: &model.ContainerStateTerminated{Reason: "Completed"}}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "1/2", Status: "NotReady", Age: "<unknown>"},
+		},
+		{
+			name: "waiting for init containers",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-2 * time.Minute)},
+				Spec: model.PodSpec{
+					InitContainers: []model.Container{{Name: "migrate"}, {Name: "seed"}},
+					Containers:     []model.Container{{Name: "api"}},
+				},
+				Status: model.PodStatus{
+					Phase: "Pending",
+					InitContainerStatuses: []model.ContainerStatus{
+						{Name: "migrate", State: model.ContainerState{Terminated: &model.ContainerStateTerminated{Reason: "Completed"}}},
+						{Name: "seed", State: model.ContainerState{Running: running}},
+					},
+					ContainerStatuses: []model.ContainerStatus{
+						{Name: "api", State: model.ContainerState{Waiting: &model.ContainerStateWaiting{Reason: "PodInitializing"}}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "0/1", Status: "Init:1/2", Age: "2m"},
+		},
+		{
+			name: "failing init container",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-2 * time.Minute)},
+				Spec: model.PodSpec{
+					InitContainers: []model.Container{{Name: "migrate"}},
+					Containers:     []model.Container{{Name: "api"}},
+				},
+				Status: model.PodStatus{
+					Phase: "Pending",
+					InitContainerStatuses: []model.ContainerStatus{
+						{Name: "migrate", RestartCount: 3, State: model.ContainerState{Waiting: &model.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "0/1", Status: "Init:CrashLoopBackOff", Restarts: 3, Age: "2m"},
+		},
+		{
+			name: "terminating",
+			pod: model.Pod{
+				ObjectMeta: model.ObjectMeta{CreationTimestamp: now.Add(-time.Hour), DeletionTimestamp: &now},
+				Spec:       model.PodSpec{Containers: []model.Container{{Name: "api"}}},
+				Status: model.PodStatus{
+					Phase: "Running",
+					ContainerStatuses: []model.ContainerStatus{
+						{Name: "api", Ready: true, State: model.ContainerState{Running: running}},
+					},
+				},
+			},
+			want: model.PodSummary{Ready: "1/1", Status: "Terminating", Age: "60m"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pod.Summary(now); got != tt.want {
+				t.Errorf("Pod.Summary() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
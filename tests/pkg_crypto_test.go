@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+)
+
+func TestDecrypt(t *testing.T) {
+	ciphertext, err := pkg.Encrypt("secret", []byte("kubeconfig"))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		secret     string
+		ciphertext string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			secret:     "secret",
+			ciphertext: ciphertext,
+			want:       "kubeconfig",
+		},
+		{
+			name:       "wrong secret",
+			secret:     "other",
+			ciphertext: ciphertext,
+			wantErr:    true,
+		},
+		{
+			name:       "tampered ciphertext",
+			secret:     "secret",
+			ciphertext: ciphertext[:len(ciphertext)-4] + "AAAA",
+			wantErr:    true,
+		},
+		{
+			name:       "missing secret",
+			ciphertext: ciphertext,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pkg.Decrypt(tt.secret, tt.ciphertext)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Decrypt() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
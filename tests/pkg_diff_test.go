@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+)
+
+func TestDiff(t *testing.T) {
+	live := &model.Namespace{
+		ObjectMeta: model.ObjectMeta{
+			Name:   "shop",
+			Labels: map[string]string{"app": "shop", "tier": "frontend"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		live        interface{}
+		result      interface{}
+		wantChanges []model.FieldChange
+		wantUnified string
+	}{
+		{
+			name: "changed, added and removed fields",
+			live: live,
+			result: &model.Namespace{
+				ObjectMeta: model.ObjectMeta{
+					Name:        "shop",
+					Labels:      map[string]string{"app": "store"},
+					Annotations: map[string]string{"owner": "team-a"},
+				},
+			},
+			wantChanges: []model.FieldChange{
+				{Path: "metadata.annotations", Operation: model.DiffOperationAdd, To: map[string]interface{}{"owner": "team-a"}},
+				{Path: "metadata.labels.app", Operation: model.DiffOperationReplace, From: "shop", To: "store"},
+				{Path: "metadata.labels.tier", Operation: model.DiffOperationRemove, From: "frontend"},
+			},
+			wantUnified: "-    app: shop\n",
+		},
+		{
+			name:        "unchanged object",
+			live:        live,
+			result:      live,
+			wantChanges: []model.FieldChange{},
+		},
+		{
+			name:        "deleted object",
+			live:        live,
+			result:      (*model.Namespace)(nil),
+			wantChanges: []model.FieldChange{{Path: "", Operation: model.DiffOperationRemove, From: mustContent(t, live)}},
+			wantUnified: "+++ result\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := pkg.Diff(tt.live, tt.result)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(diff.Changes, tt.wantChanges) {
+				t.Errorf("Diff() changes = %+v, want %+v", diff.Changes, tt.wantChanges)
+			}
+			if !strings.Contains(diff.Unified, tt.wantUnified) {
+				t.Errorf("Diff() unified = %q, want it to contain %q", diff.Unified, tt.wantUnified)
+			}
+		})
+	}
+}
+
+func mustContent(t *testing.T, object interface{}) interface{} {
+	diff, err := pkg.Diff(object, nil)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+
+	return diff.Live
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "multi-document YAML",
+			manifests: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: a\n---\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n",
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "JSON array",
+			manifests: `[{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"a"}},{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}]`,
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "List object",
+			manifests: `{"apiVersion":"v1","kind":"List","items":[{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"a"}}]}`,
+			wantNames: []string{"a"},
+		},
+		{
+			name:      "object without a kind",
+			manifests: "apiVersion: v1\nmetadata:\n  name: a\n",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid YAML",
+			manifests: "apiVersion: v1\nkind: [\n",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := pkg.DecodeManifests([]byte(tt.manifests))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeManifests() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(objects) != len(tt.wantNames) {
+				t.Fatalf("DecodeManifests() decoded %d objects, want %d", len(objects), len(tt.wantNames))
+			}
+			for i, v := range objects {
+				if v.GetName() != tt.wantNames[i] {
+					t.Errorf("DecodeManifests() object %d = %s, want %s", i, v.GetName(), tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestKubeRequestVerb(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		watch        string
+		wantVerb     string
+		wantResource string
+	}{
+		{
+			name:         "list namespaced pods",
+			method:       http.MethodGet,
+			path:         "/api/v1/namespaces/default/pods",
+			wantVerb:     "list",
+			wantResource: "pods",
+		},
+		{
+			name:         "get pod",
+			method:       http.MethodGet,
+			path:         "/api/v1/namespaces/default/pods/web-0",
+			wantVerb:     "get",
+			wantResource: "pods",
+		},
+		{
+			name:         "watch deployments",
+			method:       http.MethodGet,
+			path:         "/apis/apps/v1/namespaces/default/deployments",
+			watch:        "true",
+			wantVerb:     "watch",
+			wantResource: "deployments",
+		},
+		{
+			name:         "get namespace",
+			method:       http.MethodGet,
+			path:         "/api/v1/namespaces/default",
+			wantVerb:     "get",
+			wantResource: "namespaces",
+		},
+		{
+			name:         "create namespace",
+			method:       http.MethodPost,
+			path:         "/api/v1/namespaces",
+			wantVerb:     "create",
+			wantResource: "namespaces",
+		},
+		{
+			name:         "delete collection",
+			method:       http.MethodDelete,
+			path:         "/api/v1/namespaces/default/pods",
+			wantVerb:     "deletecollection",
+			wantResource: "pods",
+		},
+		{
+			name:         "patch pod status",
+			method:       http.MethodPatch,
+			path:         "/api/v1/namespaces/default/pods/web-0/status",
+			wantVerb:     "patch",
+			wantResource: "pods",
+		},
+		{
+			name:         "version",
+			method:       http.MethodGet,
+			path:         "/version",
+			wantVerb:     "list",
+			wantResource: "version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkg.KubeRequestVerb(tt.method, tt.path, tt.watch); got != tt.wantVerb {
+				t.Errorf("KubeRequestVerb() = %v, want %v", got, tt.wantVerb)
+			}
+			if got := pkg.KubeRequestResource(tt.path); got != tt.wantResource {
+				t.Errorf("KubeRequestResource() = %v, want %v", got, tt.wantResource)
+			}
+		})
+	}
+}
+
+func TestHTTPMetrics(t *testing.T) {
+	e := echo.New()
+	e.Use(pkg.HTTPMetrics)
+	e.GET("/metrics-test/:id", func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Param("id"))
+	})
+	e.POST("/metrics-test/login", func(c echo.Context) error {
+		return c.NoContent(http.StatusUnauthorized)
+	}, pkg.LoginMetrics("metrics-test"))
+
+	for _, path := range []string{"/metrics-test/a", "/metrics-test/b"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/metrics-test/login", nil))
+
+	rec := httptest.NewRecorder()
+	pkg.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	wantLines := []string{
+		`kubernetes_api_http_requests_total{method="GET",route="/metrics-test/:id",status="200"} 2`,
+		`kubernetes_api_http_requests_total{method="POST",route="/metrics-test/login",status="401"} 1`,
+		`kubernetes_api_logins_total{method="metrics-test",result="failure"} 1`,
+	}
+	for _, v := range wantLines {
+		if !strings.Contains(rec.Body.String(), v) {
+			t.Errorf("MetricsHandler() is missing %s", v)
+		}
+	}
+}
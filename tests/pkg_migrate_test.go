@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/fleimkeipa/kubernetes-api/migrations"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(content string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(content)}
+	}
+
+	tests := []struct {
+		fsys         fstest.MapFS
+		name         string
+		wantVersions []int64
+		wantErr      bool
+	}{
+		{
+			name: "success - sorted by version",
+			fsys: fstest.MapFS{
+				"0002_b.up.sql":   file("SELECT 2"),
+				"0002_b.down.sql": file("SELECT 2"),
+				"0001_a.up.sql":   file("SELECT 1"),
+				"0001_a.down.sql": file("SELECT 1"),
+				"README.md":       file("ignored"),
+			},
+			wantVersions: []int64{1, 2},
+		},
+		{
+			name: "missing down file",
+			fsys: fstest.MapFS{
+				"0001_a.up.sql": file("SELECT 1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "version used twice",
+			fsys: fstest.MapFS{
+				"0001_a.up.sql":   file("SELECT 1"),
+				"0001_a.down.sql": file("SELECT 1"),
+				"0001_b.up.sql":   file("SELECT 1"),
+				"0001_b.down.sql": file("SELECT 1"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pkg.LoadMigrations(tt.fsys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadMigrations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.wantVersions) {
+				t.Fatalf("LoadMigrations() = %d migrations, want %d", len(got), len(tt.wantVersions))
+			}
+			for i, v := range got {
+				if v.Version != tt.wantVersions[i] {
+					t.Errorf("LoadMigrations()[%d] version = %d, want %d", i, v.Version, tt.wantVersions[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	got, err := pkg.LoadMigrations(migrations.FS)
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+
+	for i, v := range got {
+		if v.Version != int64(i+1) {
+			t.Errorf("migration %s has version %d, want %d", v.Name, v.Version, i+1)
+		}
+	}
+}
+
+func TestMigrator_UpDown(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.TODO()
+
+	// the test instance applies every migration to a fresh database
+	test_db, terminateDB = pkg.GetTestInstance(ctx)
+	defer terminateDB()
+
+	migrator, err := pkg.NewMigrator(test_db, migrations.FS)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("Migrator.Status() error = %v", err)
+	}
+	for _, v := range statuses {
+		if !v.Applied {
+			t.Errorf("migration %d_%s is not applied", v.Version, v.Name)
+		}
+	}
+
+	reverted, err := migrator.Down(ctx, len(statuses)+1)
+	if err != nil || reverted != len(statuses) {
+		t.Fatalf("Migrator.Down() = %d, %v, want %d", reverted, err, len(statuses))
+	}
+
+	var users []string
+	if _, err := test_db.Query(&users, "SELECT to_regclass('users')::text"); err != nil || len(users) != 1 || users[0] != "" {
+		t.Errorf("users table still exists after reverting every migration: %v, %v", users, err)
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil || applied != len(statuses) {
+		t.Fatalf("Migrator.Up() = %d, %v, want %d", applied, err, len(statuses))
+	}
+
+	applied, err = migrator.Up(ctx)
+	if err != nil || applied != 0 {
+		t.Errorf("Migrator.Up() again = %d, %v, want nothing to apply", applied, err)
+	}
+
+	if _, err := test_db.Exec("INSERT INTO users (username, email) VALUES ('taken', 'first@example.com')"); err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	if _, err := test_db.Exec("INSERT INTO users (username, email) VALUES ('taken', 'second@example.com')"); err == nil {
+		t.Errorf("a second user named taken was inserted, want a unique violation")
+	}
+	if _, err := test_db.Exec("INSERT INTO users (username, email) VALUES ('other', 'first@example.com')"); err == nil {
+		t.Errorf("a second user with the email first@example.com was inserted, want a unique violation")
+	}
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+)
+
+func TestNewPSQLOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(passwordFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCA := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(invalidCA, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	base := pkg.PSQLConfig{
+		Host:     "db.example.com",
+		Port:     5433,
+		Name:     "kubernetes-api",
+		User:     "postgres",
+		Password: "password",
+	}
+
+	tests := []struct {
+		name           string
+		modify         func(cfg *pkg.PSQLConfig)
+		wantAddr       string
+		wantPassword   string
+		wantServerName string
+		wantTLS        bool
+		wantOnConnect  bool
+		wantErr        bool
+	}{
+		{
+			name:         "plain connection",
+			modify:       func(cfg *pkg.PSQLConfig) {},
+			wantAddr:     "db.example.com:5433",
+			wantPassword: "password",
+		},
+		{
+			name: "password file wins over password",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.PasswordFile = passwordFile
+			},
+			wantAddr:     "db.example.com:5433",
+			wantPassword: "from-file",
+		},
+		{
+			name: "missing password file",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.PasswordFile = filepath.Join(dir, "missing")
+			},
+			wantErr: true,
+		},
+		{
+			name: "statement timeout",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.StatementTimeout = 30 * time.Second
+			},
+			wantAddr:      "db.example.com:5433",
+			wantPassword:  "password",
+			wantOnConnect: true,
+		},
+		{
+			name: "require",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.SSLMode = pkg.SSLModeRequire
+			},
+			wantAddr:     "db.example.com:5433",
+			wantPassword: "password",
+			wantTLS:      true,
+		},
+		{
+			name: "verify-full checks the host name",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.SSLMode = pkg.SSLModeVerifyFull
+			},
+			wantAddr:       "db.example.com:5433",
+			wantPassword:   "password",
+			wantServerName: "db.example.com",
+			wantTLS:        true,
+		},
+		{
+			name: "invalid root certificate",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.SSLMode = pkg.SSLModeVerifyCA
+				cfg.SSLRootCert = invalidCA
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown sslmode",
+			modify: func(cfg *pkg.PSQLConfig) {
+				cfg.SSLMode = "prefer"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			tt.modify(&cfg)
+
+			got, err := pkg.NewPSQLOptions(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPSQLOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.Addr != tt.wantAddr {
+				t.Errorf("NewPSQLOptions() Addr = %v, want %v", got.Addr, tt.wantAddr)
+			}
+			if got.Password != tt.wantPassword {
+				t.Errorf("NewPSQLOptions() Password = %v, want %v", got.Password, tt.wantPassword)
+			}
+			if (got.TLSConfig != nil) != tt.wantTLS {
+				t.Errorf("NewPSQLOptions() TLSConfig = %v, want TLS %v", got.TLSConfig, tt.wantTLS)
+			}
+			if got.TLSConfig != nil && got.TLSConfig.ServerName != tt.wantServerName {
+				t.Errorf("NewPSQLOptions() ServerName = %v, want %v", got.TLSConfig.ServerName, tt.wantServerName)
+			}
+			if (got.OnConnect != nil) != tt.wantOnConnect {
+				t.Errorf("NewPSQLOptions() OnConnect set = %v, want %v", got.OnConnect != nil, tt.wantOnConnect)
+			}
+		})
+	}
+}
+
+func TestConnectPSQL_Unreachable(t *testing.T) {
+	cfg := pkg.PSQLConfig{
+		Host:            "127.0.0.1",
+		Port:            1,
+		ConnectAttempts: 3,
+		ConnectBackoff:  10 * time.Millisecond,
+	}
+
+	start := time.Now()
+
+	_, err := pkg.ConnectPSQL(context.Background(), cfg)
+	if err == nil {
+		t.Fatal("ConnectPSQL() error = nil, want an error")
+	}
+
+	// 10ms then 20ms of backoff between the three attempts
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("ConnectPSQL() gave up after %v, want it to back off", elapsed)
+	}
+}
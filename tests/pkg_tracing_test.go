@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	if _, err := pkg.InitTracing(context.TODO(), config.TracingConfig{}); err != nil {
+		t.Fatalf("InitTracing() error = %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		provider.Shutdown(context.TODO())
+	})
+
+	e := echo.New()
+	e.JSONSerializer = controller.JSONSerializer{}
+	e.Use(pkg.TracingMiddleware)
+	e.GET("/traced/:id", func(c echo.Context) error {
+		_, span := pkg.StartSpan(c.Request().Context(), "TracedUC.Get")
+		span.End()
+
+		return c.JSON(http.StatusBadRequest, controller.FailureResponse{
+			Error:   "failed",
+			Message: "failed",
+		})
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	req := httptest.NewRequest(http.MethodGet, "/traced/web-0", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	var res controller.FailureResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if res.TraceID != traceID {
+		t.Errorf("FailureResponse.TraceID = %v, want the incoming trace %v", res.TraceID, traceID)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want the use case and the server spans", len(spans))
+	}
+
+	useCase, server := spans[0], spans[1]
+	if server.Name != "GET /traced/:id" {
+		t.Errorf("server span name = %v, want GET /traced/:id", server.Name)
+	}
+	if server.Parent.TraceID().String() != traceID {
+		t.Errorf("server span parent trace = %v, want %v", server.Parent.TraceID(), traceID)
+	}
+	if useCase.Parent.SpanID() != server.SpanContext.SpanID() {
+		t.Errorf("use case span parent = %v, want the server span %v", useCase.Parent.SpanID(), server.SpanContext.SpanID())
+	}
+}
+
+func TestTracing_RepositorySpanRecordsError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		provider.Shutdown(context.TODO())
+	})
+
+	client, err := pkg.NewFakeKubernetesClient("../fixtures")
+	if err != nil {
+		t.Fatalf("failed to init fake kubernetes client: %v", err)
+	}
+	repo := repositories.NewPodRepository(repositories.NewSingleKubeClient(client))
+
+	if _, err := repo.GetByNameOrUID(context.TODO(), "demo", "missing", model.ListOptions{}); err == nil {
+		t.Fatalf("GetByNameOrUID() error = nil, want not found")
+	}
+	if _, err := repo.GetByNameOrUID(context.TODO(), "demo", "web-1", model.ListOptions{}); err != nil {
+		t.Fatalf("GetByNameOrUID() error = %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want one per call", len(spans))
+	}
+
+	failed, found := spans[0], spans[1]
+	if failed.Status.Code != codes.Error || len(failed.Events) != 1 || failed.Events[0].Name != "exception" {
+		t.Errorf("failed span status = %v, events = %v, want the error recorded", failed.Status, failed.Events)
+	}
+	if found.Status.Code != codes.Unset || len(found.Events) != 0 {
+		t.Errorf("span status = %v, events = %v, want no error", found.Status, found.Events)
+	}
+}
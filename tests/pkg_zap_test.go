@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/controller"
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestZapLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	e := echo.New()
+	e.JSONSerializer = controller.JSONSerializer{}
+	e.Use(pkg.RequestID())
+	e.Use(pkg.ZapLogger(zap.New(core)))
+	e.GET("/pods/:id", func(c echo.Context) error {
+		// as set by the authentication middleware
+		ctx := context.WithValue(c.Request().Context(), "user", model.Owner{ID: 7})
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return c.JSON(http.StatusNotFound, controller.FailureResponse{
+			Error:   "pod web-0 not found",
+			Message: "Pod not found.",
+		})
+	})
+
+	tests := []struct {
+		name          string
+		requestID     string
+		wantRequestID string
+	}{
+		{
+			name:          "incoming request ID is kept",
+			requestID:     "req-1",
+			wantRequestID: "req-1",
+		},
+		{
+			name:      "request ID is generated when missing",
+			requestID: "",
+		},
+		{
+			name:      "request ID with control characters is replaced",
+			requestID: "req\n2",
+		},
+		{
+			name:      "too long request ID is replaced",
+			requestID: strings.Repeat("a", 200),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/pods/web-0?namespace=default&token=secret", nil)
+			if tt.requestID != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(echo.HeaderXRequestID)
+			switch {
+			case tt.wantRequestID != "" && requestID != tt.wantRequestID:
+				t.Errorf("X-Request-ID = %q, want %q", requestID, tt.wantRequestID)
+			case tt.wantRequestID == "" && (requestID == "" || requestID == tt.requestID):
+				t.Errorf("X-Request-ID = %q, want a generated ID", requestID)
+			}
+
+			entries := logs.TakeAll()
+			if len(entries) != 1 {
+				t.Fatalf("got %d log entries, want 1", len(entries))
+			}
+
+			fields := entries[0].ContextMap()
+			want := map[string]interface{}{
+				"request_id": requestID,
+				"method":     http.MethodGet,
+				"route":      "/pods/:id",
+				"status":     int64(http.StatusNotFound),
+				"user_id":    int64(7),
+				"namespace":  "default",
+				"error":      "pod web-0 not found",
+				"query":      "namespace=default&token=REDACTED",
+			}
+			for k, v := range want {
+				if fields[k] != v {
+					t.Errorf("log field %s = %v, want %v", k, fields[k], v)
+				}
+			}
+			if _, ok := fields["latency"]; !ok {
+				t.Errorf("log field latency is missing")
+			}
+		})
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		sensitive []string
+		want      string
+	}{
+		{
+			name:      "sensitive parameters are masked",
+			query:     "code=abc&state=xyz&limit=10",
+			sensitive: []string{"code", "state"},
+			want:      "code=REDACTED&limit=10&state=REDACTED",
+		},
+		{
+			name:      "names are matched case insensitively",
+			query:     "Password=hunter2",
+			sensitive: []string{"password"},
+			want:      "Password=REDACTED",
+		},
+		{
+			name:  "nothing is masked without sensitive parameters",
+			query: "namespace=default",
+			want:  "namespace=default",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery() error = %v", err)
+			}
+
+			if got := pkg.RedactQuery(query, tt.sensitive); got != tt.want {
+				t.Errorf("RedactQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
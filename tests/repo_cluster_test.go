@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+)
+
+func TestClusterRepository_RegisteredAgainByAnotherReplica(t *testing.T) {
+	test_db, terminateDB = pkg.GetTestInstance(context.TODO())
+	defer terminateDB()
+
+	// two replicas sharing the database
+	first := repositories.NewClusterRepository(test_db, nil, "", "secret")
+	second := repositories.NewClusterRepository(test_db, nil, "", "secret")
+
+	ctx := context.WithValue(context.TODO(), "cluster", "prod")
+
+	kubeconfig := registeredKubeconfig("", "    token: secret")
+	if _, err := first.Create(ctx, model.Cluster{Name: "prod", Kubeconfig: kubeconfig}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if host := clientHost(t, ctx, first); host != "prod.example.com:6443" {
+		t.Fatalf("ClientFor() host = %s, want prod.example.com:6443", host)
+	}
+
+	if err := second.Delete(ctx, "prod"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := first.ClientFor(ctx); err == nil {
+		t.Errorf("ClientFor() of the deleted cluster error = nil, want not found")
+	}
+
+	moved := strings.Replace(kubeconfig, "prod.example.com", "prod-2.example.com", 1)
+	if _, err := second.Create(ctx, model.Cluster{Name: "prod", Kubeconfig: moved}); err != nil {
+		t.Fatalf("Create() again error = %v", err)
+	}
+	if host := clientHost(t, ctx, first); host != "prod-2.example.com:6443" {
+		t.Errorf("ClientFor() host = %s, want the cluster registered again prod-2.example.com:6443", host)
+	}
+}
+
+// clientHost returns the API server the repository sends the requests of the context to.
+func clientHost(t *testing.T, ctx context.Context, repo *repositories.ClusterRepository) string {
+	t.Helper()
+
+	client, err := repo.ClientFor(ctx)
+	if err != nil {
+		t.Fatalf("ClientFor() error = %v", err)
+	}
+
+	return client.CoreV1().RESTClient().Get().URL().Host
+}
@@ -0,0 +1,370 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/go-pg/pg"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// The conformance suites check the memory and postgres stores behave the same.
+// The postgres runs are skipped when docker is not available.
+
+func TestUserRepository_Conformance(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		testUserRepositoryConformance(t, func(t *testing.T) interfaces.UserInterfaces {
+			return repositories.NewMemoryUserRepository()
+		})
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		startConformanceDB(t)
+
+		testUserRepositoryConformance(t, func(t *testing.T) interfaces.UserInterfaces {
+			if err := clearTable("users"); err != nil {
+				t.Fatalf("failed to clear users: %v", err)
+			}
+
+			return repositories.NewUserRepository(test_db)
+		})
+	})
+}
+
+func TestEventRepository_Conformance(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		testEventRepositoryConformance(t, func(t *testing.T) interfaces.EventInterfaces {
+			return repositories.NewMemoryEventRepository()
+		})
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		startConformanceDB(t)
+
+		testEventRepositoryConformance(t, func(t *testing.T) interfaces.EventInterfaces {
+			for _, v := range []string{"events", "event_archives"} {
+				if err := clearTable(v); err != nil {
+					t.Fatalf("failed to clear %s: %v", v, err)
+				}
+			}
+
+			return repositories.NewEventRepository(test_db)
+		})
+	})
+}
+
+func startConformanceDB(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	test_db, terminateDB = pkg.GetTestInstance(context.TODO())
+	t.Cleanup(terminateDB)
+}
+
+func testUserRepositoryConformance(t *testing.T, newRepo func(t *testing.T) interfaces.UserInterfaces) {
+	ctx := context.TODO()
+
+	seed := func(t *testing.T, repo interfaces.UserInterfaces) []*model.User {
+		users := make([]*model.User, 0)
+		for _, v := range []model.User{
+			{Username: "alice", Email: "alice@test.com", Password: "secret", RoleID: model.ViewerRole},
+			{Username: "bob", Email: "bob@test.com", Password: "secret", RoleID: model.ViewerRole},
+			{Username: "carol", Email: "carol@test.com", Password: "secret", RoleID: model.AdminRole},
+		} {
+			v.CreatedAt = time.Now()
+
+			user, err := repo.Create(ctx, v)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			users = append(users, user)
+		}
+
+		return users
+	}
+
+	tests := []struct {
+		run  func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User)
+		name string
+	}{
+		{
+			name: "get by id",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				got, err := repo.GetByID(ctx, fmt.Sprint(users[1].ID))
+				if err != nil || got.Username != "bob" || got.Email != "bob@test.com" || got.RoleID != model.ViewerRole {
+					t.Errorf("GetByID() = %+v, %v, want bob", got, err)
+				}
+			},
+		},
+		{
+			name: "get missing id",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				if _, err := repo.GetByID(ctx, fmt.Sprint(users[2].ID+100)); !errors.Is(err, pg.ErrNoRows) {
+					t.Errorf("GetByID() error = %v, want %v", err, pg.ErrNoRows)
+				}
+				if _, err := repo.GetByID(ctx, "0"); err == nil {
+					t.Errorf("GetByID() with id 0 should fail")
+				}
+			},
+		},
+		{
+			name: "get by username or email",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				for _, v := range []string{"carol", "carol@test.com"} {
+					got, err := repo.GetByUsernameOrEmail(ctx, v)
+					if err != nil || got.ID != users[2].ID {
+						t.Errorf("GetByUsernameOrEmail(%s) = %+v, %v, want carol", v, got, err)
+					}
+				}
+			},
+		},
+		{
+			name: "list with filter and pagination",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				got, err := repo.List(ctx, &model.UserFindOpts{
+					RoleID:         model.Filter{IsSended: true, Value: fmt.Sprint(model.ViewerRole)},
+					PaginationOpts: model.PaginationOpts{Skip: 1, Limit: 1},
+				})
+				if err != nil {
+					t.Fatalf("List() error = %v", err)
+				}
+				if got.Total != 2 || len(got.Users) != 1 {
+					t.Errorf("List() = %d users of %d, want 1 of 2", len(got.Users), got.Total)
+				}
+
+				got, err = repo.List(ctx, &model.UserFindOpts{Username: model.Filter{IsSended: true, Value: "nobody"}})
+				if err != nil || got.Total != 0 || len(got.Users) != 0 {
+					t.Errorf("List() = %+v, %v, want no users", got, err)
+				}
+			},
+		},
+		{
+			name: "list without credentials",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				got, err := repo.List(ctx, &model.UserFindOpts{FieldsOpts: model.FieldsOpts{Fields: []string{model.ZeroCreds}}})
+				if err != nil || got.Total != 3 {
+					t.Fatalf("List() = %+v, %v, want 3 users", got, err)
+				}
+				for _, v := range got.Users {
+					if v.Password != "" || v.Username == "" {
+						t.Errorf("List() user = %+v, want username without password", v)
+					}
+				}
+			},
+		},
+		{
+			name: "update",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				user := *users[0]
+				user.Email = "alice@new.com"
+				if _, err := repo.Update(ctx, user); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+
+				got, err := repo.GetByID(ctx, fmt.Sprint(user.ID))
+				if err != nil || got.Email != "alice@new.com" {
+					t.Errorf("GetByID() after update = %+v, %v", got, err)
+				}
+
+				user.ID += 100
+				if _, err := repo.Update(ctx, user); err == nil {
+					t.Errorf("Update() of a missing user should fail")
+				}
+			},
+		},
+		{
+			name: "delete",
+			run: func(t *testing.T, repo interfaces.UserInterfaces, users []*model.User) {
+				id := fmt.Sprint(users[0].ID)
+				if err := repo.Delete(ctx, id); err != nil {
+					t.Fatalf("Delete() error = %v", err)
+				}
+
+				if _, err := repo.GetByID(ctx, id); err == nil {
+					t.Errorf("GetByID() of a deleted user should fail")
+				}
+
+				got, err := repo.List(ctx, &model.UserFindOpts{})
+				if err != nil || got.Total != 2 {
+					t.Errorf("List() after delete = %+v, %v, want 2 users", got, err)
+				}
+
+				if err := repo.Delete(ctx, id); err == nil {
+					t.Errorf("Delete() of a deleted user should fail")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRepo(t)
+			tt.run(t, repo, seed(t, repo))
+		})
+	}
+}
+
+func testEventRepositoryConformance(t *testing.T, newRepo func(t *testing.T) interfaces.EventInterfaces) {
+	ctx := context.TODO()
+
+	seed := func(t *testing.T, repo interfaces.EventInterfaces) []*model.Event {
+		events := make([]*model.Event, 0)
+		for _, v := range []model.Event{
+			{Category: model.PodCategory, Type: model.CreateEventType, Owner: model.Owner{ID: 1, Username: "alice"}},
+			{Category: model.PodCategory, Type: model.DeleteEventType, Owner: model.Owner{ID: 2, Username: "bob"}},
+			{Category: model.UserCategory, Type: model.CreateEventType, Owner: model.Owner{ID: 1, Username: "alice"}},
+		} {
+			v.CreatedAt = time.Now()
+
+			event, err := repo.Create(ctx, &v)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			events = append(events, event)
+		}
+
+		return events
+	}
+
+	tests := []struct {
+		run  func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event)
+		name string
+	}{
+		{
+			name: "get by id",
+			run: func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event) {
+				got, err := repo.GetByID(ctx, fmt.Sprint(events[1].ID))
+				if err != nil || got.Type != model.DeleteEventType || got.Owner.Username != "bob" {
+					t.Errorf("GetByID() = %+v, %v, want the delete event of bob", got, err)
+				}
+
+				if _, err := repo.GetByID(ctx, fmt.Sprint(events[2].ID+100)); !errors.Is(err, pg.ErrNoRows) {
+					t.Errorf("GetByID() error = %v, want %v", err, pg.ErrNoRows)
+				}
+			},
+		},
+		{
+			name: "list with filters and pagination",
+			run: func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event) {
+				filters := []struct {
+					opts      model.EventFindOpts
+					wantLen   int
+					wantTotal int
+				}{
+					{opts: model.EventFindOpts{Category: model.Filter{IsSended: true, Value: model.PodCategory}}, wantLen: 2, wantTotal: 2},
+					{opts: model.EventFindOpts{OwnerID: model.Filter{IsSended: true, Value: "1"}}, wantLen: 2, wantTotal: 2},
+					{opts: model.EventFindOpts{OwnerUsername: model.Filter{IsSended: true, Value: "bob"}}, wantLen: 1, wantTotal: 1},
+					{opts: model.EventFindOpts{
+						Category: model.Filter{IsSended: true, Value: model.PodCategory},
+						Type:     model.Filter{IsSended: true, Value: model.CreateEventType},
+					}, wantLen: 1, wantTotal: 1},
+					{opts: model.EventFindOpts{PaginationOpts: model.PaginationOpts{Skip: 2, Limit: 5}}, wantLen: 1, wantTotal: 3},
+				}
+				for _, v := range filters {
+					got, err := repo.List(ctx, &v.opts)
+					if err != nil {
+						t.Fatalf("List(%+v) error = %v", v.opts, err)
+					}
+					if got.Total != v.wantTotal || len(got.Events) != v.wantLen {
+						t.Errorf("List(%+v) = %d events of %d, want %d of %d", v.opts, len(got.Events), got.Total, v.wantLen, v.wantTotal)
+					}
+				}
+			},
+		},
+		{
+			name: "chain links every event",
+			run: func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event) {
+				chain, err := repo.ListChain(ctx, 0, 10)
+				if err != nil || len(chain) != 3 {
+					t.Fatalf("ListChain() = %d events, %v, want 3", len(chain), err)
+				}
+				for i := 1; i < len(chain); i++ {
+					if chain[i].PrevHash != chain[i-1].Hash || chain[i].ID <= chain[i-1].ID {
+						t.Errorf("ListChain() event %d is not linked to its predecessor", chain[i].ID)
+					}
+				}
+
+				report, err := uc.NewEventUC(repo).Verify(ctx)
+				if err != nil || !report.Valid || report.Checked != 3 {
+					t.Errorf("Verify() = %+v, %v, want a valid chain of 3", report, err)
+				}
+			},
+		},
+		{
+			name: "purge keeps the chain anchored",
+			run: func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event) {
+				err := repo.Purge(ctx, &model.EventArchive{
+					CreatedAt:    time.Now(),
+					From:         events[0].CreatedAt,
+					To:           events[1].CreatedAt,
+					FileName:     "events.jsonl.gz",
+					LastHash:     events[1].Hash,
+					FirstEventID: events[0].ID,
+					LastEventID:  events[1].ID,
+					Count:        2,
+				})
+				if err != nil {
+					t.Fatalf("Purge() error = %v", err)
+				}
+
+				archive, err := repo.LastArchive(ctx)
+				if err != nil || archive == nil || archive.LastHash != events[1].Hash {
+					t.Fatalf("LastArchive() = %+v, %v, want the purge archive", archive, err)
+				}
+
+				chain, err := repo.ListChain(ctx, 0, 10)
+				if err != nil || len(chain) != 1 || chain[0].ID != events[2].ID {
+					t.Fatalf("ListChain() after purge = %+v, %v, want the last event", chain, err)
+				}
+
+				report, err := uc.NewEventUC(repo).Verify(ctx)
+				if err != nil || !report.Valid || report.AnchorHash != events[1].Hash {
+					t.Errorf("Verify() after purge = %+v, %v, want a valid anchored chain", report, err)
+				}
+			},
+		},
+		{
+			name: "purge refuses events already archived",
+			run: func(t *testing.T, repo interfaces.EventInterfaces, events []*model.Event) {
+				newArchive := func() *model.EventArchive {
+					return &model.EventArchive{
+						CreatedAt:    time.Now(),
+						From:         events[0].CreatedAt,
+						To:           events[0].CreatedAt,
+						FileName:     "events.jsonl.gz",
+						LastHash:     events[0].Hash,
+						FirstEventID: events[0].ID,
+						LastEventID:  events[0].ID,
+						Count:        1,
+					}
+				}
+				if err := repo.Purge(ctx, newArchive()); err != nil {
+					t.Fatalf("Purge() error = %v", err)
+				}
+
+				// a second replica read the same events before the first one purged them
+				if err := repo.Purge(ctx, newArchive()); !errors.Is(err, interfaces.ErrAlreadyArchived) {
+					t.Fatalf("Purge() again error = %v, want %v", err, interfaces.ErrAlreadyArchived)
+				}
+
+				chain, err := repo.ListChain(ctx, 0, 10)
+				if err != nil || len(chain) != 2 {
+					t.Fatalf("ListChain() after purges = %d events, %v, want 2", len(chain), err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRepo(t)
+			tt.run(t, repo, seed(t, repo))
+		})
+	}
+}
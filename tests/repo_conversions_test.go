@@ -0,0 +1,261 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// fullPodSpec sets every field of the pod spec model, so a round trip through the cluster drops none.
+func fullPodSpec(restartPolicy model.RestartPolicy) model.PodSpec {
+	uid := int64(1000)
+	yes := true
+	mode := int32(0o440)
+	sizeLimit := "1Gi"
+	divisor := "1Mi"
+	grace := int64(30)
+	hostPathType := model.HostPathType("Directory")
+	expiration := int64(3600)
+
+	return model.PodSpec{
+		Volumes: []model.Volume{
+			{Name: "cache", VolumeSource: model.VolumeSource{EmptyDir: &model.EmptyDirVolumeSource{Medium: "Memory", SizeLimit: &sizeLimit}}},
+			{Name: "logs", VolumeSource: model.VolumeSource{HostPath: &model.HostPathVolumeSource{Path: "/var/log", Type: &hostPathType}}},
+			{Name: "settings", VolumeSource: model.VolumeSource{ConfigMap: &model.ConfigMapVolumeSource{
+				LocalObjectReference: model.LocalObjectReference{Name: "settings"},
+				Items:                []model.KeyToPath{{Key: "app.yaml", Path: "app.yaml", Mode: &mode}},
+				DefaultMode:          &mode,
+				Optional:             &yes,
+			}}},
+			{Name: "certs", VolumeSource: model.VolumeSource{Secret: &model.SecretVolumeSource{SecretName: "certs", DefaultMode: &mode}}},
+			{Name: "data", VolumeSource: model.VolumeSource{PersistentVolumeClaim: &model.PersistentVolumeClaimVolumeSource{ClaimName: "data", ReadOnly: true}}},
+			{Name: "podinfo", VolumeSource: model.VolumeSource{DownwardAPI: &model.DownwardAPIVolumeSource{Items: []model.DownwardAPIVolumeFile{
+				{Path: "labels", FieldRef: &model.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"}},
+				{Path: "memory", ResourceFieldRef: &model.ResourceFieldSelector{ContainerName: "web", Resource: "limits.memory", Divisor: &divisor}},
+			}}}},
+			{Name: "token", VolumeSource: model.VolumeSource{Projected: &model.ProjectedVolumeSource{Sources: []model.VolumeProjection{
+				{ServiceAccountToken: &model.ServiceAccountTokenProjection{Audience: "vault", ExpirationSeconds: &expiration, Path: "token"}},
+				{ConfigMap: &model.ConfigMapProjection{LocalObjectReference: model.LocalObjectReference{Name: "kube-root-ca.crt"}}},
+			}}}},
+		},
+		InitContainers: []model.Container{{Name: "migrate", Image: "web:1.0", Command: []string{"./migrate"}}},
+		Containers: []model.Container{{
+			Name:            "web",
+			Image:           "web:1.0",
+			ImagePullPolicy: "IfNotPresent",
+			Args:            []string{"--port=8080"},
+			Ports:           []model.ContainerPort{{Name: "http", ContainerPort: 8080, Protocol: "TCP"}},
+			EnvFrom: []model.EnvFromSource{
+				{ConfigMapRef: &model.ConfigMapEnvSource{LocalObjectReference: model.LocalObjectReference{Name: "settings"}}},
+				{Prefix: "DB_", SecretRef: &model.SecretEnvSource{LocalObjectReference: model.LocalObjectReference{Name: "db"}, Optional: &yes}},
+			},
+			Env: []model.EnvVar{
+				{Name: "MODE", Value: "fast"},
+				{Name: "DB_PASSWORD", ValueFrom: &model.EnvVarSource{SecretKeyRef: &model.SecretKeySelector{LocalObjectReference: model.LocalObjectReference{Name: "db"}, Key: "password"}}},
+				{Name: "LOG_LEVEL", ValueFrom: &model.EnvVarSource{ConfigMapKeyRef: &model.ConfigMapKeySelector{LocalObjectReference: model.LocalObjectReference{Name: "settings"}, Key: "level", Optional: &yes}}},
+				{Name: "POD_IP", ValueFrom: &model.EnvVarSource{FieldRef: &model.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+				{Name: "MEMORY_LIMIT", ValueFrom: &model.EnvVarSource{ResourceFieldRef: &model.ResourceFieldSelector{Resource: "limits.memory", Divisor: &divisor}}},
+			},
+			Resources: model.ResourceRequirements{
+				Limits:   model.ResourceList{"cpu": "1", "memory": "256Mi"},
+				Requests: model.ResourceList{"cpu": "500m", "memory": "128Mi"},
+			},
+			VolumeMounts: []model.VolumeMount{{Name: "data", MountPath: "/data", SubPath: "web", ReadOnly: true}},
+			LivenessProbe: &model.Probe{
+				ProbeHandler:        model.ProbeHandler{HTTPGet: &model.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http"), Scheme: "HTTP", HTTPHeaders: []model.HTTPHeader{{Name: "X-Probe", Value: "1"}}}},
+				InitialDelaySeconds: 5,
+				PeriodSeconds:       10,
+				FailureThreshold:    3,
+			},
+			ReadinessProbe: &model.Probe{ProbeHandler: model.ProbeHandler{TCPSocket: &model.TCPSocketAction{Port: intstr.FromInt32(8080)}}, TimeoutSeconds: 2},
+			StartupProbe:   &model.Probe{ProbeHandler: model.ProbeHandler{Exec: &model.ExecAction{Command: []string{"cat", "/tmp/ready"}}}, TerminationGracePeriodSeconds: &grace},
+			SecurityContext: &model.SecurityContext{
+				Capabilities:             &model.Capabilities{Add: []model.Capability{"NET_BIND_SERVICE"}, Drop: []model.Capability{"ALL"}},
+				RunAsUser:                &uid,
+				RunAsNonRoot:             &yes,
+				ReadOnlyRootFilesystem:   &yes,
+				AllowPrivilegeEscalation: new(bool),
+				SeccompProfile:           &model.SeccompProfile{Type: "RuntimeDefault"},
+			},
+		}},
+		RestartPolicy:                 restartPolicy,
+		TerminationGracePeriodSeconds: &grace,
+		NodeSelector:                  map[string]string{"kubernetes.io/os": "linux"},
+		ServiceAccountName:            "web",
+		SecurityContext: &model.PodSecurityContext{
+			RunAsUser:          &uid,
+			RunAsGroup:         &uid,
+			FSGroup:            &uid,
+			SupplementalGroups: []int64{2000},
+			Sysctls:            []model.Sysctl{{Name: "net.core.somaxconn", Value: "1024"}},
+		},
+		ImagePullSecrets: []model.LocalObjectReference{{Name: "registry"}},
+		Affinity: &model.Affinity{
+			NodeAffinity: &model.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &model.NodeSelector{NodeSelectorTerms: []model.NodeSelectorTerm{{
+					MatchExpressions: []model.NodeSelectorRequirement{{Key: "topology.kubernetes.io/zone", Operator: "In", Values: []string{"a", "b"}}},
+				}}},
+				PreferredDuringSchedulingIgnoredDuringExecution: []model.PreferredSchedulingTerm{{
+					Weight:     50,
+					Preference: model.NodeSelectorTerm{MatchFields: []model.NodeSelectorRequirement{{Key: "metadata.name", Operator: "NotIn", Values: []string{"node-1"}}}},
+				}},
+			},
+			PodAntiAffinity: &model.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []model.WeightedPodAffinityTerm{{
+					Weight: 100,
+					PodAffinityTerm: model.PodAffinityTerm{
+						LabelSelector: &model.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+						TopologyKey:   "kubernetes.io/hostname",
+					},
+				}},
+			},
+		},
+		Tolerations: []model.Toleration{{Key: "dedicated", Operator: "Equal", Value: "web", Effect: "NoSchedule"}},
+	}
+}
+
+func TestPodRepository_RoundTripsSpec(t *testing.T) {
+	rc := repositories.NewPodRepository(repositories.NewSingleKubeClient(pkg.NewFakeKubernetesClientWithObjects()))
+
+	pod := &model.Pod{
+		ObjectMeta: model.ObjectMeta{Name: "web-0", Namespace: "demo", Labels: map[string]string{"app": "web"}},
+		Spec:       fullPodSpec("OnFailure"),
+	}
+
+	created, err := rc.Create(context.TODO(), pod, model.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	assertSameJSON(t, "created pod spec", created.Spec, pod.Spec)
+	assertSameJSON(t, "created pod labels", created.Labels, pod.Labels)
+
+	got, err := rc.GetByNameOrUID(context.TODO(), "demo", "web-0", model.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetByNameOrUID() error = %v", err)
+	}
+	assertSameJSON(t, "pod spec read back", got.Spec, pod.Spec)
+}
+
+func TestPodRepository_ReadsStatus(t *testing.T) {
+	started := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(time.Hour))
+	yes := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-0", Namespace: "demo"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "api", Image: "api:1"}}},
+		Status: corev1.PodStatus{
+			Phase:     corev1.PodRunning,
+			HostIP:    "10.0.0.1",
+			PodIP:     "10.1.0.5",
+			PodIPs:    []corev1.PodIP{{IP: "10.1.0.5"}, {IP: "fd00::5"}},
+			StartTime: &started,
+			QOSClass:  corev1.PodQOSBurstable,
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "migrate",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed", StartedAt: started, FinishedAt: started}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:                 "api",
+				State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s restarting failed container"}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled", StartedAt: started, FinishedAt: finished}},
+				RestartCount:         14,
+				Image:                "api:1",
+				ImageID:              "docker.io/library/api@sha256:abc",
+				ContainerID:          "containerd://abc",
+				Started:              &yes,
+			}},
+		},
+	}
+	rc := repositories.NewPodRepository(repositories.NewSingleKubeClient(pkg.NewFakeKubernetesClientWithObjects(pod)))
+
+	got, err := rc.GetByNameOrUID(context.TODO(), "demo", "api-0", model.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetByNameOrUID() error = %v", err)
+	}
+
+	startTime := started.Time
+	assertSameJSON(t, "pod status", got.Status, model.PodStatus{
+		Phase:      "Running",
+		HostIP:     "10.0.0.1",
+		PodIP:      "10.1.0.5",
+		PodIPs:     []model.PodIP{{IP: "10.1.0.5"}, {IP: "fd00::5"}},
+		StartTime:  &startTime,
+		Conditions: []model.PodCondition{},
+		InitContainerStatuses: []model.ContainerStatus{{
+			Name:  "migrate",
+			State: model.ContainerState{Terminated: &model.ContainerStateTerminated{ExitCode: 0, Reason: "Completed", StartedAt: started.Time, FinishedAt: started.Time}},
+		}},
+		ContainerStatuses: []model.ContainerStatus{{
+			Name:                 "api",
+			State:                model.ContainerState{Waiting: &model.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s restarting failed container"}},
+			LastTerminationState: model.ContainerState{Terminated: &model.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled", StartedAt: started.Time, FinishedAt: finished.Time}},
+			RestartCount:         14,
+			Image:                "api:1",
+			ImageID:              "docker.io/library/api@sha256:abc",
+			ContainerID:          "containerd://abc",
+			Started:              &yes,
+		}},
+		QOSClass: "Burstable",
+	})
+}
+
+func TestDeploymentRepository_RoundTripsTemplate(t *testing.T) {
+	rc := repositories.NewDeploymentInterfaces(repositories.NewSingleKubeClient(pkg.NewFakeKubernetesClientWithObjects()))
+
+	deployment := &model.Deployment{
+		ObjectMeta: model.ObjectMeta{Name: "web", Namespace: "demo"},
+		Spec: model.DeploymentSpec{
+			Selector: &model.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: model.PodTemplateSpec{
+				ObjectMeta: model.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec:       fullPodSpec("Always"),
+			},
+		},
+	}
+
+	if _, err := rc.Create(context.TODO(), deployment, model.CreateOptions{}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := rc.GetByNameOrUID(context.TODO(), "demo", "web", model.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetByNameOrUID() error = %v", err)
+	}
+	assertSameJSON(t, "deployment template read back", got.Spec.Template, deployment.Spec.Template)
+
+	// an update replaces the template, it must keep every field too
+	update := got
+	update.Spec.Template.Spec.Containers[0].Resources.Limits["memory"] = "512Mi"
+	updated, err := rc.Update(context.TODO(), "demo", "web", update, model.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	assertSameJSON(t, "updated deployment template", updated.Spec.Template, update.Spec.Template)
+}
+
+// assertSameJSON compares the JSON encodings, where empty and missing fields are the same.
+func assertSameJSON(t *testing.T, name string, got, want interface{}) {
+	t.Helper()
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("%s: failed to encode: %v", name, err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("%s: failed to encode: %v", name, err)
+	}
+
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("%s = %s\nwant %s", name, gotJSON, wantJSON)
+	}
+}
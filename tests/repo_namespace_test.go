@@ -13,7 +13,7 @@ import (
 
 func TestNamespaceRepository_Get(t *testing.T) {
 	type fields struct {
-		client *kubernetes.Clientset
+		client kubernetes.Interface
 	}
 	type args struct {
 		ctx  context.Context
@@ -41,7 +41,7 @@ func TestNamespaceRepository_Get(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := repositories.NewNamespaceRepository(tt.fields.client)
+			rc := repositories.NewNamespaceRepository(repositories.NewSingleKubeClient(tt.fields.client))
 			got, err := rc.List(tt.args.ctx, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NamespaceRepository.Get() error = %v, wantErr %v", err, tt.wantErr)
@@ -2,14 +2,21 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/repositories"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestPodRepository_Create(t *testing.T) {
@@ -17,7 +24,7 @@ func TestPodRepository_Create(t *testing.T) {
 	defer deleteTestNamespace(client)
 
 	type fields struct {
-		client *kubernetes.Clientset
+		client kubernetes.Interface
 	}
 	type args struct {
 		ctx  context.Context
@@ -72,7 +79,7 @@ func TestPodRepository_Create(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := repositories.NewPodRepository(tt.fields.client)
+			rc := repositories.NewPodRepository(repositories.NewSingleKubeClient(tt.fields.client))
 			got, err := rc.Create(tt.args.ctx, tt.args.pod, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PodRepository.Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -87,7 +94,7 @@ func TestPodRepository_Create(t *testing.T) {
 
 func TestPodsRepository_Get(t *testing.T) {
 	type fields struct {
-		client *kubernetes.Clientset
+		client kubernetes.Interface
 	}
 	type args struct {
 		ctx       context.Context
@@ -123,7 +130,7 @@ func TestPodsRepository_Get(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := repositories.NewPodRepository(tt.fields.client)
+			rc := repositories.NewPodRepository(repositories.NewSingleKubeClient(tt.fields.client))
 			got, err := rc.List(tt.args.ctx, tt.args.namespace, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PodsRepository.Get() error = %v, wantErr %v", err, tt.wantErr)
@@ -135,3 +142,72 @@ func TestPodsRepository_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestPodRepository_UpdateConflicts(t *testing.T) {
+	staleVersion := "1"
+
+	tests := []struct {
+		name        string
+		opts        model.UpdateOptions
+		conflicts   int
+		wantUpdates int
+		wantErr     bool
+	}{
+		{
+			name:        "conflict without retry",
+			opts:        model.UpdateOptions{},
+			conflicts:   1,
+			wantUpdates: 1,
+			wantErr:     true,
+		},
+		{
+			name:        "conflict retried on the latest version",
+			opts:        model.UpdateOptions{RetryOnConflict: true},
+			conflicts:   2,
+			wantUpdates: 3,
+		},
+		{
+			name: "stale precondition is not retried",
+			opts: model.UpdateOptions{
+				RetryOnConflict: true,
+				Preconditions:   &model.Preconditions{ResourceVersion: &staleVersion},
+			},
+			wantUpdates: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := pkg.NewFakeKubernetesClientWithObjects(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo", ResourceVersion: "2"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx:1.27"}}},
+			})
+
+			updates := 0
+			client.(*fake.Clientset).PrependReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updates++
+				if updates <= tt.conflicts {
+					return true, nil, apierrors.NewConflict(corev1.Resource("pods"), "web", errors.New("the object has been modified"))
+				}
+				return false, nil, nil
+			})
+
+			rc := repositories.NewPodRepository(repositories.NewSingleKubeClient(client))
+			pod := &model.Pod{
+				ObjectMeta: model.ObjectMeta{Namespace: "demo"},
+				Spec:       model.PodSpec{Containers: []model.Container{{Name: "web", Image: "nginx:1.28"}}},
+			}
+
+			_, err := rc.Update(context.Background(), "web", pod, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PodRepository.Update() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !apierrors.IsConflict(err) {
+				t.Errorf("PodRepository.Update() error = %v, want a conflict", err)
+			}
+			if updates != tt.wantUpdates {
+				t.Errorf("PodRepository.Update() sent %d updates, want %d", updates, tt.wantUpdates)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+)
+
+func TestApplyUC_Events(t *testing.T) {
+	manifests := []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+        - name: web
+          image: nginx:1.27
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: shop
+`)
+
+	tests := []struct {
+		name           string
+		opts           model.ApplyOptions
+		wantCategories []string
+		wantTypes      []string
+	}{
+		{
+			name:           "one event per applied object",
+			opts:           model.ApplyOptions{Namespace: "shop", FieldManager: "tests"},
+			wantCategories: []string{"namespace", "deployment"},
+			wantTypes:      []string{model.CreateEventType, model.CreateEventType},
+		},
+		{
+			name:           "no event for dry runs",
+			opts:           model.ApplyOptions{Namespace: "shop", FieldManager: "tests", DryRun: []string{"All"}},
+			wantCategories: []string{},
+			wantTypes:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := pkg.NewFakeKubernetesClientWithObjects()
+			eventRepo := &chainRepo{}
+			rc := uc.NewApplyUC(repositories.NewManifestRepository(repositories.NewSingleKubeClient(client)), uc.NewEventUC(eventRepo))
+
+			ctx := context.WithValue(context.Background(), "user", model.Owner{ID: 1, Username: "test_username"})
+			list, err := rc.Apply(ctx, manifests, tt.opts)
+			if err != nil {
+				t.Fatalf("ApplyUC.Apply() error = %v", err)
+			}
+			if list.Failed() {
+				t.Fatalf("ApplyUC.Apply() results = %+v, want no failure", list.Items)
+			}
+
+			if len(eventRepo.events) != len(tt.wantCategories) {
+				t.Fatalf("ApplyUC.Apply() recorded %d events, want %d", len(eventRepo.events), len(tt.wantCategories))
+			}
+			for i, v := range eventRepo.events {
+				if v.Category != tt.wantCategories[i] || v.Type != tt.wantTypes[i] {
+					t.Errorf("event %d = %s/%s, want %s/%s", i, v.Category, v.Type, tt.wantCategories[i], tt.wantTypes[i])
+				}
+			}
+		})
+	}
+}
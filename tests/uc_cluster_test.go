@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+)
+
+// clusterRepo serves a fixed set of clusters, those missing from versions are unreachable.
+type clusterRepo struct {
+	versions map[string]string
+	names    []string
+}
+
+func (rc *clusterRepo) Create(ctx context.Context, cluster model.Cluster) (*model.Cluster, error) {
+	return &cluster, nil
+}
+
+func (rc *clusterRepo) List(ctx context.Context) ([]model.ClusterStatus, error) {
+	clusters := make([]model.ClusterStatus, 0, len(rc.names))
+	for _, v := range rc.names {
+		clusters = append(clusters, model.ClusterStatus{Name: v, Default: v == rc.Default()})
+	}
+
+	return clusters, nil
+}
+
+func (rc *clusterRepo) Default() string {
+	return rc.names[0]
+}
+
+func (rc *clusterRepo) Version(ctx context.Context, name string) (string, error) {
+	version, ok := rc.versions[name]
+	if !ok {
+		<-ctx.Done()
+		return "", errors.New("connection timed out")
+	}
+
+	return version, nil
+}
+
+func (rc *clusterRepo) Delete(ctx context.Context, name string) error {
+	return nil
+}
+
+func TestClusterUC_List(t *testing.T) {
+	repo := &clusterRepo{
+		names:    []string{"staging", "prod"},
+		versions: map[string]string{"staging": "v1.31.0"},
+	}
+
+	got, err := uc.NewClusterUC(repo, nil, 50*time.Millisecond).List(context.TODO())
+	if err != nil {
+		t.Fatalf("ClusterUC.List() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		wantVersion string
+		wantHealthy bool
+		wantDefault bool
+	}{
+		{
+			name:        "staging",
+			wantVersion: "v1.31.0",
+			wantHealthy: true,
+			wantDefault: true,
+		},
+		{
+			name: "prod",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := got.Clusters[i]
+			if cluster.Name != tt.name || cluster.Healthy != tt.wantHealthy || cluster.Version != tt.wantVersion || cluster.Default != tt.wantDefault {
+				t.Errorf("ClusterUC.List() cluster = %+v, want %+v", cluster, tt)
+			}
+			if !tt.wantHealthy && cluster.Error == "" {
+				t.Errorf("ClusterUC.List() cluster %s has no health error", tt.name)
+			}
+			if cluster.CheckedAt.IsZero() {
+				t.Errorf("ClusterUC.List() cluster %s was not checked", tt.name)
+			}
+		})
+	}
+}
@@ -14,11 +14,14 @@ import (
 )
 
 func TestDeploymentUC_List(t *testing.T) {
-	deploymentTestRepo := repositories.NewDeploymentInterfaces(initTestKubernetes())
+	kubeClient := initTestKubernetes()
+	deploymentTestRepo := repositories.NewDeploymentInterfaces(repositories.NewSingleKubeClient(kubeClient))
+	clusterEventTestRepo := repositories.NewClusterEventRepository(repositories.NewSingleKubeClient(kubeClient))
 
 	type fields struct {
-		deploymentRepo interfaces.DeploymentInterfaces
-		eventUC        *uc.EventUC
+		deploymentRepo   interfaces.DeploymentInterfaces
+		clusterEventRepo interfaces.ClusterEventInterfaces
+		eventUC          *uc.EventUC
 	}
 	type args struct {
 		ctx       context.Context
@@ -35,8 +38,9 @@ func TestDeploymentUC_List(t *testing.T) {
 		{
 			name: "success",
 			fields: fields{
-				deploymentRepo: deploymentTestRepo,
-				eventUC:        &uc.EventUC{},
+				deploymentRepo:   deploymentTestRepo,
+				clusterEventRepo: clusterEventTestRepo,
+				eventUC:          &uc.EventUC{},
 			},
 			args: args{
 				ctx:       context.TODO(),
@@ -49,7 +53,7 @@ func TestDeploymentUC_List(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := uc.NewDeploymentUC(tt.fields.deploymentRepo, tt.fields.eventUC)
+			rc := uc.NewDeploymentUC(tt.fields.deploymentRepo, tt.fields.clusterEventRepo, nil, tt.fields.eventUC)
 			got, err := rc.List(tt.args.ctx, tt.args.namespace, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeploymentUC.List() error = %v, wantErr %v", err, tt.wantErr)
@@ -63,10 +67,14 @@ func TestDeploymentUC_List(t *testing.T) {
 }
 
 func TestDeploymentUC_GetByNameOrUID(t *testing.T) {
-	deploymentTestRepo := repositories.NewDeploymentInterfaces(initTestKubernetes())
+	kubeClient := initTestKubernetes()
+	deploymentTestRepo := repositories.NewDeploymentInterfaces(repositories.NewSingleKubeClient(kubeClient))
+	clusterEventTestRepo := repositories.NewClusterEventRepository(repositories.NewSingleKubeClient(kubeClient))
+
 	type fields struct {
-		deploymentRepo interfaces.DeploymentInterfaces
-		eventUC        *uc.EventUC
+		deploymentRepo   interfaces.DeploymentInterfaces
+		clusterEventRepo interfaces.ClusterEventInterfaces
+		eventUC          *uc.EventUC
 	}
 	type args struct {
 		ctx       context.Context
@@ -84,8 +92,9 @@ func TestDeploymentUC_GetByNameOrUID(t *testing.T) {
 		{
 			name: "success",
 			fields: fields{
-				deploymentRepo: deploymentTestRepo,
-				eventUC:        &uc.EventUC{},
+				deploymentRepo:   deploymentTestRepo,
+				clusterEventRepo: clusterEventTestRepo,
+				eventUC:          &uc.EventUC{},
 			},
 			args: args{
 				ctx:       context.TODO(),
@@ -99,7 +108,7 @@ func TestDeploymentUC_GetByNameOrUID(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := uc.NewDeploymentUC(tt.fields.deploymentRepo, tt.fields.eventUC)
+			rc := uc.NewDeploymentUC(tt.fields.deploymentRepo, tt.fields.clusterEventRepo, nil, tt.fields.eventUC)
 			got, err := rc.GetByNameOrUID(tt.args.ctx, tt.args.namespace, tt.args.nameOrUID, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeploymentUC.GetByNameOrUID() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	"github.com/go-pg/pg"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestAsError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	tests := []struct {
+		err        error
+		name       string
+		wantCode   uc.ErrorCode
+		wantCauses []uc.ErrorCause
+	}{
+		{
+			name:     "not found",
+			err:      apierrors.NewNotFound(pods, "web-0"),
+			wantCode: uc.CodeNotFound,
+		},
+		{
+			name:     "wrapped not found",
+			err:      fmt.Errorf("failed to get pod: %w", apierrors.NewNotFound(pods, "web-0")),
+			wantCode: uc.CodeNotFound,
+		},
+		{
+			name:     "already exists",
+			err:      apierrors.NewAlreadyExists(pods, "web-0"),
+			wantCode: uc.CodeAlreadyExists,
+		},
+		{
+			name:     "conflict",
+			err:      apierrors.NewConflict(pods, "web-0", errors.New("object has been modified")),
+			wantCode: uc.CodeConflict,
+		},
+		{
+			name:     "forbidden",
+			err:      apierrors.NewForbidden(pods, "web-0", errors.New("denied")),
+			wantCode: uc.CodeForbidden,
+		},
+		{
+			name: "invalid with causes",
+			err: apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "web-0", field.ErrorList{
+				field.Required(field.NewPath("spec", "containers"), ""),
+			}),
+			wantCode:   uc.CodeInvalid,
+			wantCauses: []uc.ErrorCause{{Field: "spec.containers", Message: "Required value"}},
+		},
+		{
+			name:     "server timeout",
+			err:      apierrors.NewServerTimeout(pods, "list", 1),
+			wantCode: uc.CodeTimeout,
+		},
+		{
+			name:     "service unavailable",
+			err:      apierrors.NewServiceUnavailable("the metrics API is not available"),
+			wantCode: uc.CodeUnavailable,
+		},
+		{
+			name:     "deadline exceeded",
+			err:      fmt.Errorf("failed to list pods: %w", context.DeadlineExceeded),
+			wantCode: uc.CodeTimeout,
+		},
+		{
+			name:     "no rows",
+			err:      fmt.Errorf("failed to find user by id [1]: %w", pg.ErrNoRows),
+			wantCode: uc.CodeNotFound,
+		},
+		{
+			name:     "use case error",
+			err:      fmt.Errorf("failed: %w", uc.NewError(uc.CodeInvalid, "name is required")),
+			wantCode: uc.CodeInvalid,
+		},
+		{
+			name:     "other errors are internal",
+			err:      errors.New("connection refused"),
+			wantCode: uc.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := uc.AsError(tt.err)
+
+			if got.Code != tt.wantCode {
+				t.Errorf("AsError() code = %v, want %v", got.Code, tt.wantCode)
+			}
+			if len(got.Causes) != len(tt.wantCauses) {
+				t.Fatalf("AsError() causes = %v, want %v", got.Causes, tt.wantCauses)
+			}
+			for i := range tt.wantCauses {
+				if got.Causes[i] != tt.wantCauses[i] {
+					t.Errorf("AsError() cause %d = %v, want %v", i, got.Causes[i], tt.wantCauses[i])
+				}
+			}
+		})
+	}
+}
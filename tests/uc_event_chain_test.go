@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+)
+
+// chainRepo keeps an event chain in memory to exercise verification without a database.
+type chainRepo struct {
+	archive *model.EventArchive
+	events  []model.Event
+}
+
+func (rc *chainRepo) Create(ctx context.Context, event *model.Event) (*model.Event, error) {
+	prevHash := ""
+	if len(rc.events) != 0 {
+		prevHash = rc.events[len(rc.events)-1].Hash
+	}
+
+	event.ID = int64(len(rc.events) + 1)
+	event.PrevHash = prevHash
+	event.Hash = event.ComputeHash(prevHash)
+	rc.events = append(rc.events, *event)
+
+	return event, nil
+}
+
+func (rc *chainRepo) List(ctx context.Context, opts *model.EventFindOpts) (*model.EventList, error) {
+	return &model.EventList{Events: rc.events, Total: len(rc.events)}, nil
+}
+
+func (rc *chainRepo) GetByID(ctx context.Context, id string) (*model.Event, error) {
+	return nil, nil
+}
+
+func (rc *chainRepo) ListChain(ctx context.Context, afterID int64, limit int) ([]model.Event, error) {
+	events := make([]model.Event, 0)
+	for _, v := range rc.events {
+		if v.ID > afterID && len(events) < limit {
+			events = append(events, v)
+		}
+	}
+
+	return events, nil
+}
+
+func (rc *chainRepo) LastArchive(ctx context.Context) (*model.EventArchive, error) {
+	return rc.archive, nil
+}
+
+func (rc *chainRepo) Purge(ctx context.Context, archive *model.EventArchive) error {
+	rc.archive = archive
+
+	remaining := make([]model.Event, 0)
+	for _, v := range rc.events {
+		if v.ID > archive.LastEventID {
+			remaining = append(remaining, v)
+		}
+	}
+	rc.events = remaining
+
+	return nil
+}
+
+func newChainRepo(createdAt ...time.Time) *chainRepo {
+	repo := &chainRepo{}
+	for _, v := range createdAt {
+		repo.Create(context.TODO(), &model.Event{
+			CreatedAt: v,
+			Category:  model.PodCategory,
+			Type:      model.CreateEventType,
+			Owner: model.Owner{
+				ID:       1,
+				Username: "test_username",
+			},
+		})
+	}
+
+	return repo
+}
+
+func TestEventUC_Verify(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		tamper       func(repo *chainRepo)
+		name         string
+		wantBrokenAt int64
+		wantValid    bool
+	}{
+		{
+			name:      "success - untouched chain",
+			tamper:    func(repo *chainRepo) {},
+			wantValid: true,
+		},
+		{
+			name: "edited event",
+			tamper: func(repo *chainRepo) {
+				repo.events[1].Type = model.DeleteEventType
+			},
+			wantBrokenAt: 2,
+		},
+		{
+			name: "removed event",
+			tamper: func(repo *chainRepo) {
+				repo.events = append(repo.events[:1], repo.events[2:]...)
+			},
+			wantBrokenAt: 3,
+		},
+		{
+			name: "soft deleted event",
+			tamper: func(repo *chainRepo) {
+				repo.events[1].DeletedAt = time.Now()
+			},
+			wantBrokenAt: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newChainRepo(now, now, now)
+			tt.tamper(repo)
+
+			got, err := uc.NewEventUC(repo).Verify(context.TODO())
+			if err != nil {
+				t.Fatalf("EventUC.Verify() error = %v", err)
+			}
+			if got.Valid != tt.wantValid {
+				t.Errorf("EventUC.Verify() valid = %v, want %v", got.Valid, tt.wantValid)
+			}
+			if !tt.wantValid && (got.BrokenAt == nil || *got.BrokenAt != tt.wantBrokenAt) {
+				t.Errorf("EventUC.Verify() brokenAt = %v, want %v", got.BrokenAt, tt.wantBrokenAt)
+			}
+		})
+	}
+}
+
+func TestEventRetentionUC_Archive(t *testing.T) {
+	now := time.Now()
+	old := now.AddDate(0, 0, -100)
+
+	repo := newChainRepo(old, old, now)
+	archiveRepo := repositories.NewEventArchiveRepository(t.TempDir())
+
+	rc := uc.NewEventRetentionUC(repo, archiveRepo, model.EventRetentionOpts{HotDays: 90})
+
+	archive, err := rc.Archive(context.TODO(), now)
+	if err != nil {
+		t.Fatalf("EventRetentionUC.Archive() error = %v", err)
+	}
+	if archive == nil || archive.Count != 2 || archive.LastEventID != 2 {
+		t.Fatalf("EventRetentionUC.Archive() = %+v, want 2 events archived", archive)
+	}
+	if _, err := os.Stat(archive.FileName); err != nil {
+		t.Errorf("EventRetentionUC.Archive() archive file error = %v", err)
+	}
+
+	report, err := uc.NewEventUC(repo).Verify(context.TODO())
+	if err != nil {
+		t.Fatalf("EventUC.Verify() error = %v", err)
+	}
+	if !report.Valid || report.Checked != 1 {
+		t.Errorf("EventUC.Verify() after archive = %+v, want valid chain of 1", report)
+	}
+
+	archive, err = rc.Archive(context.TODO(), now)
+	if err != nil || archive != nil {
+		t.Errorf("EventRetentionUC.Archive() = %v, %v, want nothing left to archive", archive, err)
+	}
+}
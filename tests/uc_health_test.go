@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+)
+
+type healthCheck struct {
+	err   error
+	name  string
+	delay time.Duration
+}
+
+func (rc *healthCheck) Name() string {
+	return rc.name
+}
+
+func (rc *healthCheck) Check(ctx context.Context) error {
+	select {
+	case <-time.After(rc.delay):
+		return rc.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestHealthUC_Ready(t *testing.T) {
+	tests := []struct {
+		name        string
+		checks      []interfaces.HealthCheckInterfaces
+		drain       bool
+		wantStatus  string
+		wantHealthy []bool
+	}{
+		{
+			name: "every check passes",
+			checks: []interfaces.HealthCheckInterfaces{
+				&healthCheck{name: "postgres"},
+				&healthCheck{name: "kubernetes"},
+			},
+			wantStatus:  model.HealthStatusOK,
+			wantHealthy: []bool{true, true},
+		},
+		{
+			name: "failing check",
+			checks: []interfaces.HealthCheckInterfaces{
+				&healthCheck{name: "postgres"},
+				&healthCheck{name: "kubernetes", err: errors.New("connection refused")},
+			},
+			wantStatus:  model.HealthStatusFailing,
+			wantHealthy: []bool{true, false},
+		},
+		{
+			name: "check past the timeout",
+			checks: []interfaces.HealthCheckInterfaces{
+				&healthCheck{name: "postgres", delay: time.Second},
+			},
+			wantStatus:  model.HealthStatusFailing,
+			wantHealthy: []bool{false},
+		},
+		{
+			name: "draining",
+			checks: []interfaces.HealthCheckInterfaces{
+				&healthCheck{name: "postgres"},
+			},
+			drain:       true,
+			wantStatus:  model.HealthStatusDraining,
+			wantHealthy: []bool{true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := uc.NewHealthUC(50*time.Millisecond, tt.checks...)
+			if tt.drain {
+				rc.Drain()
+			}
+
+			got := rc.Ready(context.TODO())
+			if got.Status != tt.wantStatus {
+				t.Errorf("HealthUC.Ready() Status = %v, want %v", got.Status, tt.wantStatus)
+			}
+
+			if len(got.Checks) != len(tt.wantHealthy) {
+				t.Fatalf("HealthUC.Ready() Checks = %v, want %d checks", got.Checks, len(tt.wantHealthy))
+			}
+			for i, v := range got.Checks {
+				if v.Name != tt.checks[i].Name() || v.Healthy != tt.wantHealthy[i] {
+					t.Errorf("HealthUC.Ready() Checks[%d] = %+v, want %s healthy %v", i, v, tt.checks[i].Name(), tt.wantHealthy[i])
+				}
+				if !v.Healthy && v.Error == "" {
+					t.Errorf("HealthUC.Ready() Checks[%d] has no error", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMetricsUC_ComparesUsage(t *testing.T) {
+	pod := func(name string, resources ...corev1.ResourceRequirements) *corev1.Pod {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"}}
+		for i, v := range resources {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: []string{"api", "proxy"}[i], Image: "api:1", Resources: v})
+		}
+		return pod
+	}
+	limited := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{"cpu": resource.MustParse("200m"), "memory": resource.MustParse("256Mi")},
+		Limits:   corev1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")},
+	}
+	requested := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{"cpu": resource.MustParse("100m")},
+	}
+
+	client := pkg.NewFakeKubernetesClientWithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "demo"}},
+		pod("api-0", limited, requested),
+		pod("api-1", limited),
+		pod("api-2", limited),
+	)
+	err := pkg.ServeFakePodMetrics(client,
+		podMetrics("demo", "api-0", map[string][2]string{"api": {"300m", "384Mi"}, "proxy": {"50m", "32Mi"}}),
+		// metrics-server reports the CPU in nanocores
+		podMetrics("demo", "api-1", map[string][2]string{"api": {"99500000n", "128Mi"}}),
+	)
+	if err != nil {
+		t.Fatalf("ServeFakePodMetrics() error = %v", err)
+	}
+	clients := repositories.NewSingleKubeClient(client)
+	rc := uc.NewMetricsUC(repositories.NewMetricsRepository(clients), repositories.NewPodRepository(clients), repositories.NewNamespaceRepository(clients))
+
+	percent := func(v int64) *int64 { return &v }
+
+	got, err := rc.PodMetrics(context.TODO(), "demo", "api-0")
+	if err != nil {
+		t.Fatalf("PodMetrics() error = %v", err)
+	}
+	assertSameJSON(t, "api-0 containers", got.Containers, []model.ContainerMetrics{
+		{
+			Name:   "api",
+			CPU:    model.ResourceUsage{Usage: "300m", Request: "200m", Limit: "1", RequestPercent: percent(150), LimitPercent: percent(30)},
+			Memory: model.ResourceUsage{Usage: "384Mi", Request: "256Mi", Limit: "512Mi", RequestPercent: percent(150), LimitPercent: percent(75)},
+		},
+		{
+			Name:   "proxy",
+			CPU:    model.ResourceUsage{Usage: "50m", Request: "100m", RequestPercent: percent(50)},
+			Memory: model.ResourceUsage{Usage: "32Mi"},
+		},
+	})
+	// the proxy has no limits, so neither has the pod
+	assertSameJSON(t, "api-0 cpu", got.CPU, model.ResourceUsage{Usage: "350m", Request: "300m", RequestPercent: percent(117)})
+	assertSameJSON(t, "api-0 memory", got.Memory, model.ResourceUsage{Usage: "416Mi", Request: "256Mi", RequestPercent: percent(163)})
+
+	namespace, err := rc.NamespaceMetrics(context.TODO(), "demo")
+	if err != nil {
+		t.Fatalf("NamespaceMetrics() error = %v", err)
+	}
+	if len(namespace.Pods) != 2 || namespace.Pods[0].Name != "api-0" || namespace.Pods[1].Name != "api-1" {
+		t.Fatalf("NamespaceMetrics() pods = %+v, want api-0 and api-1, most CPU using first", namespace.Pods)
+	}
+	assertSameJSON(t, "api-1 cpu", namespace.Pods[1].CPU, model.ResourceUsage{Usage: "100m", Request: "200m", Limit: "1", RequestPercent: percent(50), LimitPercent: percent(10)})
+	assertSameJSON(t, "namespace cpu", namespace.CPU, model.ResourceUsage{Usage: "450m", Request: "500m", RequestPercent: percent(90)})
+
+	if _, err := rc.PodMetrics(context.TODO(), "demo", "api-2"); uc.AsError(err).Code != uc.CodeNotFound {
+		t.Errorf("PodMetrics() of a pod without metrics error = %v, want code %s", err, uc.CodeNotFound)
+	}
+}
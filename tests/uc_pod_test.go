@@ -18,9 +18,12 @@ func TestPodsUC_GetByName(t *testing.T) {
 	test_db, terminateDB = pkg.GetTestInstance(context.TODO())
 	defer terminateDB()
 
+	kubeClient := initTestKubernetes()
+
 	type fields struct {
-		podsRepo interfaces.PodInterfaces
-		eventUC  *uc.EventUC
+		podsRepo         interfaces.PodInterfaces
+		clusterEventRepo interfaces.ClusterEventInterfaces
+		eventUC          *uc.EventUC
 	}
 	type args struct {
 		ctx       context.Context
@@ -38,8 +41,9 @@ func TestPodsUC_GetByName(t *testing.T) {
 		{
 			name: "success",
 			fields: fields{
-				podsRepo: repositories.NewPodRepository(initTestKubernetes()),
-				eventUC:  uc.NewEventUC(repositories.NewEventRepository(test_db)),
+				podsRepo:         repositories.NewPodRepository(repositories.NewSingleKubeClient(kubeClient)),
+				clusterEventRepo: repositories.NewClusterEventRepository(repositories.NewSingleKubeClient(kubeClient)),
+				eventUC:          uc.NewEventUC(repositories.NewEventRepository(test_db)),
 			},
 			args: args{
 				ctx:       context.Background(),
@@ -53,7 +57,7 @@ func TestPodsUC_GetByName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rc := uc.NewPodUC(tt.fields.podsRepo, tt.fields.eventUC)
+			rc := uc.NewPodUC(tt.fields.podsRepo, tt.fields.clusterEventRepo, nil, tt.fields.eventUC)
 			got, err := rc.GetByNameOrUID(tt.args.ctx, tt.args.namespace, tt.args.name, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("PodsUC.GetByName() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories"
+	"github.com/fleimkeipa/kubernetes-api/uc"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodUC_CreateWarnsOfMissingReferences(t *testing.T) {
+	yes := true
+	ref := func(name string) model.LocalObjectReference { return model.LocalObjectReference{Name: name} }
+
+	tests := []struct {
+		name         string
+		container    model.Container
+		wantWarnings []string
+	}{
+		{
+			name: "every reference exists",
+			container: model.Container{
+				EnvFrom: []model.EnvFromSource{{ConfigMapRef: &model.ConfigMapEnvSource{LocalObjectReference: ref("settings")}}},
+				Env: []model.EnvVar{
+					{Name: "DB_PASSWORD", ValueFrom: &model.EnvVarSource{SecretKeyRef: &model.SecretKeySelector{LocalObjectReference: ref("db"), Key: "password"}}},
+				},
+			},
+		},
+		{
+			name: "missing objects and key",
+			container: model.Container{
+				EnvFrom: []model.EnvFromSource{{SecretRef: &model.SecretEnvSource{LocalObjectReference: ref("api-keys")}}},
+				Env: []model.EnvVar{
+					{Name: "DB_USER", ValueFrom: &model.EnvVarSource{SecretKeyRef: &model.SecretKeySelector{LocalObjectReference: ref("db"), Key: "user"}}},
+					{Name: "MODE", ValueFrom: &model.EnvVarSource{ConfigMapKeyRef: &model.ConfigMapKeySelector{LocalObjectReference: ref("flags"), Key: "mode"}}},
+					{Name: "LEVEL", ValueFrom: &model.EnvVarSource{ConfigMapKeyRef: &model.ConfigMapKeySelector{LocalObjectReference: ref("flags"), Key: "level"}}},
+				},
+			},
+			wantWarnings: []string{
+				`secret "api-keys" not found in namespace demo, referenced by container web`,
+				`key "user" not found in secret "db", referenced by container web`,
+				`configmap "flags" not found in namespace demo, referenced by container web`,
+			},
+		},
+		{
+			name: "optional references are not checked",
+			container: model.Container{
+				EnvFrom: []model.EnvFromSource{{ConfigMapRef: &model.ConfigMapEnvSource{LocalObjectReference: ref("flags"), Optional: &yes}}},
+				Env: []model.EnvVar{
+					{Name: "DB_USER", ValueFrom: &model.EnvVarSource{SecretKeyRef: &model.SecretKeySelector{LocalObjectReference: ref("db"), Key: "user", Optional: &yes}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := pkg.NewFakeKubernetesClientWithObjects(
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "demo"}, Data: map[string]string{"mode": "fast"}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "demo"}, Data: map[string][]byte{"password": []byte("secret")}},
+			)
+			clients := repositories.NewSingleKubeClient(client)
+			rc := uc.NewPodUC(repositories.NewPodRepository(clients), repositories.NewClusterEventRepository(clients), repositories.NewReferenceRepository(clients), uc.NewEventUC(&chainRepo{}))
+
+			tt.container.Name = "web"
+			tt.container.Image = "web:1.0"
+			request := model.PodsCreateRequest{
+				Pod: model.Pod{
+					ObjectMeta: model.ObjectMeta{Name: "web-0", Namespace: "demo"},
+					Spec:       model.PodSpec{Containers: []model.Container{tt.container}},
+				},
+			}
+
+			ctx := context.WithValue(context.Background(), "user", model.Owner{ID: 1, Username: "test_username"})
+			pod, err := rc.Create(ctx, request)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			warnings := make([]string, 0, len(pod.Warnings))
+			for _, v := range pod.Warnings {
+				if v.Reason != model.WarningReasonReferenceNotFound {
+					t.Errorf("Create() warning reason = %s, want %s", v.Reason, model.WarningReasonReferenceNotFound)
+				}
+				warnings = append(warnings, v.Message)
+			}
+			if !slices.Equal(warnings, tt.wantWarnings) {
+				t.Errorf("Create() warnings = %q, want %q", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package uc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// applyOrder ranks the kinds other objects depend on, applied first. The other kinds,
+// like custom resources, come last in the order of the manifests.
+var applyOrder = []string{
+	"Namespace",
+	"CustomResourceDefinition",
+	"PriorityClass",
+	"StorageClass",
+	"ResourceQuota",
+	"LimitRange",
+	"ServiceAccount",
+	"Secret",
+	"ConfigMap",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicaSet",
+	"Deployment",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"HorizontalPodAutoscaler",
+	"PodDisruptionBudget",
+	"NetworkPolicy",
+	"IngressClass",
+	"Ingress",
+}
+
+type ApplyUC struct {
+	manifestRepo interfaces.ManifestInterfaces
+	eventUC      *EventUC
+}
+
+func NewApplyUC(manifestRepo interfaces.ManifestInterfaces, eventUC *EventUC) *ApplyUC {
+	return &ApplyUC{
+		manifestRepo: manifestRepo,
+		eventUC:      eventUC,
+	}
+}
+
+// Apply server-side applies the objects of the manifests in dependency order, recording an event for each.
+// An object failing does not stop the others, its result tells why. With a prune selector, the objects
+// of the applied kinds matching it but missing from the manifests are deleted, unless an object failed.
+func (rc *ApplyUC) Apply(ctx context.Context, manifests []byte, opts model.ApplyOptions) (_ *model.ApplyResultList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ApplyUC.Apply", attribute.String("k8s.namespace.name", opts.Namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	objects, err := pkg.DecodeManifests(manifests)
+	if err != nil {
+		return nil, NewError(CodeBadRequest, "invalid manifests: %w", err)
+	}
+	if len(objects) == 0 {
+		return nil, NewError(CodeBadRequest, "the manifests hold no object")
+	}
+
+	if opts.Prune != "" {
+		if _, err := labels.Parse(opts.Prune); err != nil {
+			return nil, NewError(CodeBadRequest, "invalid prune selector: %w", err)
+		}
+	}
+
+	if opts.Namespace == "" {
+		opts.Namespace = "default"
+	}
+
+	slices.SortStableFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return applyRank(a.GetKind()) - applyRank(b.GetKind())
+	})
+
+	list := model.ApplyResultList{
+		DryRun: len(opts.DryRun) > 0,
+	}
+	for _, object := range objects {
+		list.Items = append(list.Items, rc.apply(ctx, object, opts))
+	}
+
+	if opts.Prune != "" && !list.Failed() {
+		list.Items = append(list.Items, rc.prune(ctx, objects, opts)...)
+	}
+
+	return &list, nil
+}
+
+func (rc *ApplyUC) apply(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) model.ApplyResult {
+	result := model.ApplyResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+
+	namespaced, err := rc.manifestRepo.IsNamespaced(ctx, object.GroupVersionKind())
+	if err != nil {
+		return failedResult(result, err)
+	}
+
+	switch {
+	case !namespaced:
+		object.SetNamespace("")
+	case object.GetNamespace() == "":
+		object.SetNamespace(opts.Namespace)
+	}
+	result.Namespace = object.GetNamespace()
+
+	existing, err := rc.manifestRepo.Get(ctx, object)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return failedResult(result, err)
+	}
+
+	eventType := model.UpdateEventType
+	if existing == nil {
+		eventType = model.CreateEventType
+	}
+
+	if err := rc.recordEvent(ctx, object.GetKind(), eventType, opts); err != nil {
+		return failedResult(result, err)
+	}
+
+	applied, err := rc.manifestRepo.Apply(ctx, object, opts)
+	if err != nil {
+		return failedResult(result, err)
+	}
+
+	switch {
+	case existing == nil:
+		result.Status = model.ApplyStatusCreated
+	case isChanged(existing, applied):
+		result.Status = model.ApplyStatusConfigured
+	default:
+		result.Status = model.ApplyStatusUnchanged
+	}
+
+	return result
+}
+
+// prune deletes the objects of the applied kinds and namespaces matching the prune selector,
+// which are not part of the manifests.
+func (rc *ApplyUC) prune(ctx context.Context, objects []*unstructured.Unstructured, opts model.ApplyOptions) []model.ApplyResult {
+	type scope struct {
+		gvk       schema.GroupVersionKind
+		namespace string
+	}
+
+	applied := make(map[string]bool, len(objects))
+	scopes := make([]scope, 0)
+	for _, v := range objects {
+		applied[objectKey(v)] = true
+
+		s := scope{gvk: v.GroupVersionKind(), namespace: v.GetNamespace()}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+
+	results := make([]model.ApplyResult, 0)
+	for _, s := range scopes {
+		items, err := rc.manifestRepo.List(ctx, s.gvk, s.namespace, opts.Prune)
+		if err != nil {
+			results = append(results, failedResult(model.ApplyResult{
+				APIVersion: s.gvk.GroupVersion().String(),
+				Kind:       s.gvk.Kind,
+				Namespace:  s.namespace,
+			}, fmt.Errorf("failed to list the objects to prune: %w", err)))
+			continue
+		}
+
+		for _, item := range items {
+			if applied[objectKey(&item)] {
+				continue
+			}
+
+			results = append(results, rc.delete(ctx, &item, opts))
+		}
+	}
+
+	return results
+}
+
+func (rc *ApplyUC) delete(ctx context.Context, object *unstructured.Unstructured, opts model.ApplyOptions) model.ApplyResult {
+	result := model.ApplyResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Namespace:  object.GetNamespace(),
+		Name:       object.GetName(),
+	}
+
+	if err := rc.recordEvent(ctx, object.GetKind(), model.DeleteEventType, opts); err != nil {
+		return failedResult(result, err)
+	}
+
+	if err := rc.manifestRepo.Delete(ctx, object, opts); err != nil {
+		return failedResult(result, err)
+	}
+
+	result.Status = model.ApplyStatusPruned
+
+	return result
+}
+
+// recordEvent records the change of an object of the kind, dry runs change nothing and are not recorded.
+func (rc *ApplyUC) recordEvent(ctx context.Context, kind, eventType string, opts model.ApplyOptions) error {
+	event := model.Event{
+		Category: strings.ToLower(kind),
+		Type:     eventType,
+	}
+	if _, err := rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun); err != nil {
+		return fmt.Errorf("failed to create event for %s: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+func applyRank(kind string) int {
+	if i := slices.Index(applyOrder, kind); i >= 0 {
+		return i
+	}
+
+	return len(applyOrder)
+}
+
+func objectKey(object *unstructured.Unstructured) string {
+	return strings.Join([]string{object.GroupVersionKind().GroupKind().String(), object.GetNamespace(), object.GetName()}, "/")
+}
+
+// isChanged tells if applying changed the object. Dry runs keep the resourceVersion, so the objects
+// are compared without their server managed fields as well.
+func isChanged(existing, applied *unstructured.Unstructured) bool {
+	if existing.GetResourceVersion() != applied.GetResourceVersion() {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(withoutServerFields(existing), withoutServerFields(applied))
+}
+
+func withoutServerFields(object *unstructured.Unstructured) map[string]interface{} {
+	content := object.DeepCopy().Object
+	unstructured.RemoveNestedField(content, "metadata", "managedFields")
+	unstructured.RemoveNestedField(content, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(content, "metadata", "generation")
+	unstructured.RemoveNestedField(content, "status")
+
+	return content
+}
+
+func failedResult(result model.ApplyResult, err error) model.ApplyResult {
+	result.Status = model.ApplyStatusFailed
+	result.Error = err.Error()
+
+	return result
+}
@@ -0,0 +1,127 @@
+package uc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// bulkDeleteFunc deletes, or evicts, one of the objects matched by a bulk deletion.
+type bulkDeleteFunc func(ctx context.Context, object model.ObjectReference, opts model.DeleteOptions) error
+
+// checkBulkDeleteOptions refuses the bulk deletions without label selector, which would match every
+// object, and defaults the action to a deletion. Only the pods can be evicted.
+func checkBulkDeleteOptions(opts *model.BulkDeleteOptions, evictable bool) error {
+	if strings.TrimSpace(opts.LabelSelector) == "" {
+		return NewError(CodeBadRequest, "labelSelector is required, a bulk deletion never matches every object")
+	}
+
+	if _, err := labels.Parse(opts.LabelSelector); err != nil {
+		return NewError(CodeBadRequest, "invalid labelSelector: %v", err)
+	}
+
+	switch opts.Action {
+	case "":
+		opts.Action = model.BulkActionDelete
+	case model.BulkActionDelete:
+	case model.BulkActionEvict:
+		if !evictable {
+			return NewError(CodeBadRequest, "only the pods can be evicted")
+		}
+	default:
+		return NewError(CodeBadRequest, "action must be %s or %s, got %s", model.BulkActionDelete, model.BulkActionEvict, opts.Action)
+	}
+
+	return nil
+}
+
+// newBulkDeletePreview returns the preview of the deletion of the objects, sorted by name.
+func newBulkDeletePreview(kind, namespace string, opts model.BulkDeleteOptions, items []model.ObjectReference) *model.BulkDeletePreview {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	preview := &model.BulkDeletePreview{
+		Kind:          kind,
+		Namespace:     namespace,
+		LabelSelector: opts.LabelSelector,
+		Action:        opts.Action,
+		Items:         items,
+	}
+	preview.ConfirmationToken = bulkConfirmationToken(preview)
+
+	return preview
+}
+
+// bulkConfirmationToken hashes what the deletion affects, so the token of a preview confirms the
+// deletion as long as the same objects match. A pod recreated under the same name has a new UID.
+func bulkConfirmationToken(preview *model.BulkDeletePreview) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s|%s|%s|%s", preview.Kind, preview.Namespace, preview.LabelSelector, preview.Action)
+	for _, v := range preview.Items {
+		fmt.Fprintf(hash, "|%s", v.UID)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// runBulkDelete deletes the objects of the preview one by one, recording an event for each, once
+// the token confirms them. An object failing to delete doesn't stop the others, its error is
+// reported in the result. Failing to record an event stops the deletion, the objects left are
+// reported as failed with its error while those already deleted stay in the result.
+func runBulkDelete(ctx context.Context, eventUC *EventUC, category string, preview *model.BulkDeletePreview, opts model.BulkDeleteOptions, deleteFunc bulkDeleteFunc) (*model.BulkDeleteResult, error) {
+	if opts.ConfirmationToken != preview.ConfirmationToken {
+		return nil, NewError(CodePreconditionFailed, "the %ss matched by %s changed since the preview, preview them again and confirm with the new token", strings.ToLower(preview.Kind), preview.LabelSelector)
+	}
+
+	eventType := model.DeleteEventType
+	if opts.Action == model.BulkActionEvict {
+		eventType = model.EvictEventType
+	}
+
+	result := &model.BulkDeleteResult{
+		Action: opts.Action,
+		Items:  make([]model.BulkItemResult, 0, len(preview.Items)),
+	}
+	for i, object := range preview.Items {
+		event := model.Event{
+			Category: category,
+			Type:     eventType,
+		}
+		if _, err := eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun); err != nil {
+			for _, left := range preview.Items[i:] {
+				result.Items = append(result.Items, model.BulkItemResult{
+					ObjectReference: left,
+					Error:           fmt.Sprintf("skipped, failed to create event for %s: %v", event.Type, err),
+				})
+				result.Failed++
+			}
+
+			return result, nil
+		}
+
+		// only the object previewed, not one recreated since under the same name
+		uid := object.UID
+		deleteOpts := opts.DeleteOptions
+		deleteOpts.Preconditions = &model.Preconditions{UID: &uid}
+
+		item := model.BulkItemResult{ObjectReference: object}
+		if err := deleteFunc(ctx, object, deleteOpts); err != nil && !apierrors.IsNotFound(err) {
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,139 @@
+package uc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+)
+
+type ClusterUC struct {
+	clusterRepo   interfaces.ClusterInterfaces
+	eventUC       *EventUC
+	healthTimeout time.Duration
+}
+
+// NewClusterUC creates the cluster use case, health checks give up after healthTimeout (5s by default).
+func NewClusterUC(clusterRepo interfaces.ClusterInterfaces, eventUC *EventUC, healthTimeout time.Duration) *ClusterUC {
+	if healthTimeout <= 0 {
+		healthTimeout = 5 * time.Second
+	}
+
+	return &ClusterUC{
+		clusterRepo:   clusterRepo,
+		eventUC:       eventUC,
+		healthTimeout: healthTimeout,
+	}
+}
+
+func (rc *ClusterUC) Create(ctx context.Context, request model.ClusterRequest) (*model.Cluster, error) {
+	if request.Name == "" || request.Kubeconfig == "" {
+		err := NewError(CodeInvalid, "name and kubeconfig are required")
+		if request.Name == "" {
+			err.Causes = append(err.Causes, ErrorCause{Field: "name", Message: "Required value"})
+		}
+		if request.Kubeconfig == "" {
+			err.Causes = append(err.Causes, ErrorCause{Field: "kubeconfig", Message: "Required value"})
+		}
+		return nil, err
+	}
+
+	if err := checkKubeconfig(request.Kubeconfig); err != nil {
+		return nil, err
+	}
+
+	event := model.Event{
+		Category: model.ClusterCategory,
+		Type:     model.CreateEventType,
+	}
+	_, err := rc.eventUC.Create(ctx, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := model.Cluster{
+		CreatedAt:  time.Now(),
+		Name:       request.Name,
+		Kubeconfig: request.Kubeconfig,
+		Context:    request.Context,
+	}
+
+	return rc.clusterRepo.Create(ctx, cluster)
+}
+
+// checkKubeconfig refuses the kubeconfigs reading files or running commands on the API server,
+// only the credentials embedded in the kubeconfig are accepted.
+func checkKubeconfig(kubeconfig string) error {
+	fields, err := pkg.KubeconfigExternalReferences([]byte(kubeconfig))
+	if err != nil {
+		return NewError(CodeInvalid, "%v", err)
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	ucErr := NewError(CodeInvalid, "kubeconfig must embed its credentials, use the *-data and token fields instead of files and plugins")
+	for _, v := range fields {
+		ucErr.Causes = append(ucErr.Causes, ErrorCause{Field: "kubeconfig", Message: fmt.Sprintf("%s is not allowed", v)})
+	}
+
+	return ucErr
+}
+
+// List returns every known cluster along with the result of a health check of its API server.
+// Clusters are checked concurrently so an unreachable one only costs the health timeout.
+func (rc *ClusterUC) List(ctx context.Context) (*model.ClusterList, error) {
+	clusters, err := rc.clusterRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	for i := range clusters {
+		wg.Add(1)
+		go func(cluster *model.ClusterStatus) {
+			defer wg.Done()
+			rc.checkHealth(ctx, cluster)
+		}(&clusters[i])
+	}
+	wg.Wait()
+
+	return &model.ClusterList{
+		Default:  rc.clusterRepo.Default(),
+		Clusters: clusters,
+	}, nil
+}
+
+func (rc *ClusterUC) Delete(ctx context.Context, name string) error {
+	event := model.Event{
+		Category: model.ClusterCategory,
+		Type:     model.DeleteEventType,
+	}
+	_, err := rc.eventUC.Create(ctx, &event)
+	if err != nil {
+		return err
+	}
+
+	return rc.clusterRepo.Delete(ctx, name)
+}
+
+func (rc *ClusterUC) checkHealth(ctx context.Context, cluster *model.ClusterStatus) {
+	ctx, cancel := context.WithTimeout(ctx, rc.healthTimeout)
+	defer cancel()
+
+	version, err := rc.clusterRepo.Version(ctx, cluster.Name)
+
+	cluster.CheckedAt = time.Now()
+	if err != nil {
+		cluster.Error = err.Error()
+		return
+	}
+
+	cluster.Version = version
+	cluster.Healthy = true
+}
@@ -4,22 +4,32 @@ import (
 	"context"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type DeploymentUC struct {
-	deploymentRepo interfaces.DeploymentInterfaces
-	eventUC        *EventUC
+	deploymentRepo   interfaces.DeploymentInterfaces
+	clusterEventRepo interfaces.ClusterEventInterfaces
+	referenceRepo    interfaces.ReferenceInterfaces
+	eventUC          *EventUC
 }
 
-func NewDeploymentUC(deploymentRepo interfaces.DeploymentInterfaces, eventUC *EventUC) *DeploymentUC {
+func NewDeploymentUC(deploymentRepo interfaces.DeploymentInterfaces, clusterEventRepo interfaces.ClusterEventInterfaces, referenceRepo interfaces.ReferenceInterfaces, eventUC *EventUC) *DeploymentUC {
 	return &DeploymentUC{
-		deploymentRepo: deploymentRepo,
-		eventUC:        eventUC,
+		deploymentRepo:   deploymentRepo,
+		clusterEventRepo: clusterEventRepo,
+		referenceRepo:    referenceRepo,
+		eventUC:          eventUC,
 	}
 }
 
-func (rc *DeploymentUC) Create(ctx context.Context, request *model.DeploymentCreateRequest) (*model.Deployment, error) {
+func (rc *DeploymentUC) Create(ctx context.Context, request *model.DeploymentCreateRequest) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.Create")
+	defer func() { pkg.EndSpan(span, err) }()
+
 	request.Deployment.TypeMeta.Kind = "deployment"
 	if request.Deployment.ObjectMeta.Namespace == "" {
 		request.Deployment.ObjectMeta.Namespace = "default"
@@ -29,20 +39,31 @@ func (rc *DeploymentUC) Create(ctx context.Context, request *model.DeploymentCre
 		Category: model.DeploymentCategory,
 		Type:     model.CreateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, err
 	}
 
-	return rc.deploymentRepo.Create(ctx, &request.Deployment, request.Opts)
+	deployment, err := rc.deploymentRepo.Create(ctx, &request.Deployment, request.Opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the references are checked once the deployment is accepted, they may still be created afterwards
+	deployment.Warnings = envReferenceWarnings(ctx, rc.referenceRepo, request.Deployment.Namespace, &request.Deployment.Spec.Template.Spec)
+
+	return deployment, nil
 }
 
-func (rc *DeploymentUC) Update(ctx context.Context, namespace, id string, request *model.DeploymentUpdateRequest) (*model.Deployment, error) {
+func (rc *DeploymentUC) Update(ctx context.Context, namespace, id string, request *model.DeploymentUpdateRequest) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.Update", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	event := model.Event{
 		Category: model.DeploymentCategory,
 		Type:     model.UpdateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +71,36 @@ func (rc *DeploymentUC) Update(ctx context.Context, namespace, id string, reques
 	kubeDeployment := rc.fillDeployment(request)
 	kubeDeployment.Namespace = namespace
 
-	return rc.deploymentRepo.Update(ctx, namespace, id, kubeDeployment, request.Opts)
+	updated, err := rc.deploymentRepo.Update(ctx, namespace, id, kubeDeployment, request.Opts)
+	return updated, preconditionError(err, request.Opts.Preconditions)
+}
+
+func (rc *DeploymentUC) Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.Patch", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	opts.TypeMeta.Kind = "deployment"
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	event := model.Event{
+		Category: model.DeploymentCategory,
+		Type:     model.UpdateEventType,
+	}
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := rc.deploymentRepo.Patch(ctx, namespace, nameOrUID, patchType, data, opts)
+	return patched, preconditionError(err, opts.Preconditions)
 }
 
-func (rc *DeploymentUC) List(ctx context.Context, namespace string, opts model.ListOptions) (*model.DeploymentList, error) {
+func (rc *DeploymentUC) List(ctx context.Context, namespace string, opts model.ListOptions) (_ *model.DeploymentList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.List", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	opts.TypeMeta.Kind = "deployment"
 	if namespace == "" {
 		namespace = "default"
@@ -62,11 +109,41 @@ func (rc *DeploymentUC) List(ctx context.Context, namespace string, opts model.L
 	return rc.deploymentRepo.List(ctx, namespace, opts)
 }
 
-func (rc *DeploymentUC) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Deployment, error) {
-	return rc.deploymentRepo.GetByNameOrUID(ctx, namespace, nameOrUID, opts)
+func (rc *DeploymentUC) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.Deployment, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.GetByNameOrUID", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	deployment, err := rc.deploymentRepo.GetByNameOrUID(ctx, namespace, nameOrUID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// warnings are best effort, the deployment is still returned when events can't be read
+	events, err := rc.clusterEventRepo.ListByObject(ctx, deploymentReference(deployment), model.ListOptions{})
+	if err == nil {
+		deployment.Warnings = events.LatestWarnings(latestWarningsLimit)
+	}
+
+	return deployment, nil
+}
+
+// ListClusterEvents lists the events reported by the cluster for the deployment.
+func (rc *DeploymentUC) ListClusterEvents(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.ClusterEventList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.ListClusterEvents", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	deployment, err := rc.deploymentRepo.GetByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.clusterEventRepo.ListByObject(ctx, deploymentReference(deployment), opts)
 }
 
-func (rc *DeploymentUC) Delete(ctx context.Context, namespace, nameOrUID string, opts model.DeleteOptions) error {
+func (rc *DeploymentUC) Delete(ctx context.Context, namespace, nameOrUID string, opts model.DeleteOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.Delete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	opts.TypeMeta.Kind = "deployment"
 	if namespace == "" {
 		namespace = "default"
@@ -76,12 +153,67 @@ func (rc *DeploymentUC) Delete(ctx context.Context, namespace, nameOrUID string,
 		Category: model.DeploymentCategory,
 		Type:     model.DeleteEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
 	if err != nil {
 		return err
 	}
 
-	return rc.deploymentRepo.Delete(ctx, namespace, nameOrUID, opts)
+	err = rc.deploymentRepo.Delete(ctx, namespace, nameOrUID, opts)
+	return preconditionError(err, opts.Preconditions)
+}
+
+// PreviewBulkDelete lists the deployments matched by the label selector, with the token confirming their deletion.
+func (rc *DeploymentUC) PreviewBulkDelete(ctx context.Context, namespace string, opts model.BulkDeleteOptions) (_ *model.BulkDeletePreview, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.PreviewBulkDelete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	if err := checkBulkDeleteOptions(&opts, false); err != nil {
+		return nil, err
+	}
+
+	list, err := rc.deploymentRepo.List(ctx, namespace, model.ListOptions{LabelSelector: opts.LabelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]model.ObjectReference, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, deploymentReference(&list.Items[i]))
+	}
+
+	return newBulkDeletePreview("Deployment", namespace, opts, items), nil
+}
+
+// BulkDelete deletes the deployments matched by the label selector once the token of their preview
+// confirms them. It is refused when the deployments matched changed since the preview.
+func (rc *DeploymentUC) BulkDelete(ctx context.Context, namespace string, opts model.BulkDeleteOptions) (_ *model.BulkDeleteResult, err error) {
+	ctx, span := pkg.StartSpan(ctx, "DeploymentUC.BulkDelete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	preview, err := rc.PreviewBulkDelete(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Action = preview.Action
+	opts.TypeMeta.Kind = "deployment"
+
+	return runBulkDelete(ctx, rc.eventUC, model.DeploymentCategory, preview, opts, func(ctx context.Context, deployment model.ObjectReference, deleteOpts model.DeleteOptions) error {
+		return rc.deploymentRepo.Delete(ctx, deployment.Namespace, deployment.Name, deleteOpts)
+	})
+}
+
+func deploymentReference(deployment *model.Deployment) model.ObjectReference {
+	return model.ObjectReference{
+		Kind:      "Deployment",
+		Namespace: deployment.Namespace,
+		Name:      deployment.Name,
+		UID:       deployment.UID,
+	}
 }
 
 func (rc *DeploymentUC) fillDeployment(request *model.DeploymentUpdateRequest) *model.Deployment {
@@ -0,0 +1,133 @@
+package uc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+
+	"github.com/go-pg/pg"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ErrorCode is the stable, machine readable kind of an Error.
+type ErrorCode string
+
+const (
+	CodeBadRequest         ErrorCode = "bad_request"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeAlreadyExists      ErrorCode = "already_exists"
+	CodeConflict           ErrorCode = "conflict"
+	CodePreconditionFailed ErrorCode = "precondition_failed"
+	CodeForbidden          ErrorCode = "forbidden"
+	CodeInvalid            ErrorCode = "invalid"
+	CodeTimeout            ErrorCode = "timeout"
+	CodeUnavailable        ErrorCode = "unavailable"
+	CodeInternal           ErrorCode = "internal"
+)
+
+// pgUniqueViolation is the SQLSTATE of a unique constraint violation.
+const pgUniqueViolation = "23505"
+
+// ErrorCause is a problem with a field of the request.
+type ErrorCause struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error is the error of a use case, telling why it failed with its Code.
+// The causes list the offending fields of invalid requests.
+type Error struct {
+	Err    error
+	Code   ErrorCode
+	Causes []ErrorCause
+}
+
+// NewError returns an Error of code with the formatted message.
+func NewError(code ErrorCode, format string, args ...any) *Error {
+	return &Error{
+		Code: code,
+		Err:  fmt.Errorf(format, args...),
+	}
+}
+
+func (rc *Error) Error() string {
+	return rc.Err.Error()
+}
+
+func (rc *Error) Unwrap() error {
+	return rc.Err
+}
+
+// AsError returns the Error wrapped by err, or translates the errors of the Kubernetes API server,
+// of go-pg and of expired deadlines into one. Any other error is internal.
+func AsError(err error) *Error {
+	var ucErr *Error
+	if errors.As(err, &ucErr) {
+		return ucErr
+	}
+
+	ucErr = &Error{
+		Err:  err,
+		Code: CodeInternal,
+	}
+
+	switch {
+	case apierrors.IsNotFound(err), errors.Is(err, pg.ErrNoRows):
+		ucErr.Code = CodeNotFound
+	case apierrors.IsAlreadyExists(err), isPGUniqueViolation(err):
+		ucErr.Code = CodeAlreadyExists
+	case apierrors.IsConflict(err):
+		ucErr.Code = CodeConflict
+	case apierrors.IsForbidden(err):
+		ucErr.Code = CodeForbidden
+	case apierrors.IsInvalid(err):
+		ucErr.Code = CodeInvalid
+		ucErr.Causes = kubeErrorCauses(err)
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		ucErr.Code = CodeTimeout
+	case apierrors.IsBadRequest(err):
+		ucErr.Code = CodeBadRequest
+	case apierrors.IsServiceUnavailable(err):
+		ucErr.Code = CodeUnavailable
+	}
+
+	return ucErr
+}
+
+// preconditionError tells the conflicts of an operation run with a resourceVersion precondition
+// apart, the object changed since the caller read it.
+func preconditionError(err error, preconditions *model.Preconditions) error {
+	if err == nil || preconditions == nil || preconditions.ResourceVersion == nil || !apierrors.IsConflict(err) {
+		return err
+	}
+
+	return &Error{
+		Err:  err,
+		Code: CodePreconditionFailed,
+	}
+}
+
+func isPGUniqueViolation(err error) bool {
+	var pgErr pg.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation
+}
+
+// kubeErrorCauses returns the fields the API server rejected.
+func kubeErrorCauses(err error) []ErrorCause {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+
+	causes := make([]ErrorCause, 0, len(status.Status().Details.Causes))
+	for _, v := range status.Status().Details.Causes {
+		causes = append(causes, ErrorCause{
+			Field:   v.Field,
+			Message: v.Message,
+		})
+	}
+
+	return causes
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
 	"github.com/fleimkeipa/kubernetes-api/util"
 )
@@ -28,7 +29,22 @@ func (rc *EventUC) Create(ctx context.Context, event *model.Event) (*model.Event
 		return nil, errors.New("invalid owner")
 	}
 
-	return rc.eventRepo.Create(ctx, event)
+	created, err := rc.eventRepo.Create(ctx, event)
+	if err != nil {
+		pkg.ObserveEventWriteFailure(event.Category)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// CreateUnlessDryRun records the event of a change, unless the change is a dry run which changes nothing.
+func (rc *EventUC) CreateUnlessDryRun(ctx context.Context, event *model.Event, dryRun []string) (*model.Event, error) {
+	if len(dryRun) > 0 {
+		return event, nil
+	}
+
+	return rc.Create(ctx, event)
 }
 
 func (rc *EventUC) List(ctx context.Context, opts *model.EventFindOpts) (*model.EventList, error) {
@@ -38,3 +54,68 @@ func (rc *EventUC) List(ctx context.Context, opts *model.EventFindOpts) (*model.
 func (rc *EventUC) GetByID(ctx context.Context, id string) (*model.Event, error) {
 	return rc.eventRepo.GetByID(ctx, id)
 }
+
+// Verify walks the event hash chain and reports the first broken link.
+func (rc *EventUC) Verify(ctx context.Context) (*model.EventChainReport, error) {
+	const pageSize = 500
+
+	report := model.EventChainReport{Valid: true}
+
+	archive, err := rc.eventRepo.LastArchive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if archive != nil {
+		report.AnchorHash = archive.LastHash
+	}
+
+	expectedPrev := report.AnchorHash
+	chained := false
+
+	var afterID int64
+	for {
+		events, err := rc.eventRepo.ListChain(ctx, afterID, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			afterID = event.ID
+
+			// rows written before the chain existed have no hash, they can only precede it
+			if event.Hash == "" && !chained {
+				report.Unchained++
+				continue
+			}
+			chained = true
+			report.Checked++
+
+			// the hash doesn't cover the soft delete, a hidden event is reported like a removed one
+			if !event.DeletedAt.IsZero() {
+				return brokenChain(report, event.ID, "event is soft deleted"), nil
+			}
+
+			if event.PrevHash != expectedPrev {
+				return brokenChain(report, event.ID, "previous hash does not match the preceding event"), nil
+			}
+
+			if event.ComputeHash(event.PrevHash) != event.Hash {
+				return brokenChain(report, event.ID, "event content does not match its hash"), nil
+			}
+
+			expectedPrev = event.Hash
+		}
+
+		if len(events) < pageSize {
+			return &report, nil
+		}
+	}
+}
+
+func brokenChain(report model.EventChainReport, eventID int64, reason string) *model.EventChainReport {
+	report.Valid = false
+	report.BrokenAt = &eventID
+	report.Reason = reason
+
+	return &report
+}
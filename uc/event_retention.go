@@ -0,0 +1,117 @@
+package uc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+)
+
+type EventRetentionUC struct {
+	eventRepo   interfaces.EventInterfaces
+	archiveRepo interfaces.EventArchiveInterfaces
+	opts        model.EventRetentionOpts
+}
+
+func NewEventRetentionUC(eventRepo interfaces.EventInterfaces, archiveRepo interfaces.EventArchiveInterfaces, opts model.EventRetentionOpts) *EventRetentionUC {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = time.Hour
+	}
+
+	return &EventRetentionUC{
+		eventRepo:   eventRepo,
+		archiveRepo: archiveRepo,
+		opts:        opts,
+	}
+}
+
+// Run archives expired events every interval until the context is cancelled.
+func (rc *EventRetentionUC) Run(ctx context.Context) {
+	if rc.opts.HotDays <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(rc.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			archive, err := rc.Archive(ctx, time.Now())
+			if err != nil {
+				log.Printf("Failed to archive events: %v", err)
+				break
+			}
+			if archive == nil {
+				break
+			}
+
+			log.Printf("Archived %d events to %s", archive.Count, archive.FileName)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Archive moves one batch of events older than the hot period into an archive file.
+// It returns nil when there is nothing left to archive.
+func (rc *EventRetentionUC) Archive(ctx context.Context, now time.Time) (*model.EventArchive, error) {
+	cutoff := now.AddDate(0, 0, -rc.opts.HotDays)
+
+	chain, err := rc.eventRepo.ListChain(ctx, 0, rc.opts.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the oldest contiguous run is archived so the remaining chain stays intact
+	expired := make([]model.Event, 0, len(chain))
+	for _, event := range chain {
+		if !event.CreatedAt.Before(cutoff) {
+			break
+		}
+		expired = append(expired, event)
+	}
+
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	first, last := expired[0], expired[len(expired)-1]
+
+	name := fmt.Sprintf("events-%020d-%020d.jsonl.gz", first.ID, last.ID)
+	path, err := rc.archiveRepo.Write(ctx, name, expired)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := model.EventArchive{
+		CreatedAt:    now,
+		From:         first.CreatedAt,
+		To:           last.CreatedAt,
+		FileName:     path,
+		LastHash:     last.Hash,
+		FirstEventID: first.ID,
+		LastEventID:  last.ID,
+		Count:        len(expired),
+	}
+	if err := rc.eventRepo.Purge(ctx, &archive); err != nil {
+		if errors.Is(err, interfaces.ErrAlreadyArchived) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &archive, nil
+}
@@ -0,0 +1,218 @@
+package uc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The kinds exported on their own by the GET endpoints.
+var (
+	PodKind        = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	DeploymentKind = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	NamespaceKind  = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+)
+
+// namespaceExportKinds are the kinds of the objects bundled by the export of a namespace, in apply order.
+// The kinds the cluster does not serve are skipped.
+var namespaceExportKinds = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "ResourceQuota"},
+	{Version: "v1", Kind: "LimitRange"},
+	{Version: "v1", Kind: "ServiceAccount"},
+	{Version: "v1", Kind: "Secret"},
+	{Version: "v1", Kind: "ConfigMap"},
+	{Version: "v1", Kind: "PersistentVolumeClaim"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	{Version: "v1", Kind: "Service"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Version: "v1", Kind: "Pod"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "batch", Version: "v1", Kind: "CronJob"},
+	{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+	{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "NetworkPolicy"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+}
+
+// serverMetadataFields are set by the cluster, they are removed from the exported objects so they apply again anywhere.
+// The owner references point to the UIDs of the owners in this cluster.
+var serverMetadataFields = []string{
+	"managedFields",
+	"ownerReferences",
+	"uid",
+	"resourceVersion",
+	"creationTimestamp",
+	"generation",
+	"selfLink",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+}
+
+// lastAppliedAnnotation holds the previous configuration applied by kubectl, stale once the object is exported.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+type ExportUC struct {
+	manifestRepo interfaces.ManifestInterfaces
+}
+
+func NewExportUC(manifestRepo interfaces.ManifestInterfaces) *ExportUC {
+	return &ExportUC{
+		manifestRepo: manifestRepo,
+	}
+}
+
+// Export returns the object of the kind as a manifest to apply again, without the fields set by the cluster.
+func (rc *ExportUC) Export(ctx context.Context, gvk schema.GroupVersionKind, namespace, nameOrUID string) (_ *unstructured.Unstructured, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ExportUC.Export", attribute.String("k8s.kind", gvk.Kind), attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	object, err := rc.get(ctx, gvk, namespace, nameOrUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cleanManifest(object, gvk), nil
+}
+
+// ExportNamespace returns the namespace and its objects as manifests to apply again, in dependency order.
+// The objects the cluster creates by itself, like the pods of a deployment, are left out.
+func (rc *ExportUC) ExportNamespace(ctx context.Context, nameOrUID string, opts model.NamespaceExportOptions) (_ []*unstructured.Unstructured, err error) {
+	ctx, span := pkg.StartSpan(ctx, "ExportUC.ExportNamespace")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	namespace, err := rc.get(ctx, NamespaceKind, "", nameOrUID)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*unstructured.Unstructured{cleanManifest(namespace, NamespaceKind)}
+	for _, gvk := range namespaceExportKinds {
+		if gvk.Kind == "Secret" && !opts.IncludeSecrets {
+			continue
+		}
+
+		items, err := rc.manifestRepo.List(ctx, gvk, namespace.GetName(), "")
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range items {
+			items[i].SetGroupVersionKind(gvk)
+			if isClusterManaged(&items[i]) {
+				continue
+			}
+
+			objects = append(objects, cleanManifest(&items[i], gvk))
+		}
+	}
+
+	return objects, nil
+}
+
+// get returns the object of the kind named nameOrUID, or of that UID.
+func (rc *ExportUC) get(ctx context.Context, gvk schema.GroupVersionKind, namespace, nameOrUID string) (*unstructured.Unstructured, error) {
+	namespaced, err := rc.manifestRepo.IsNamespaced(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+	if !namespaced {
+		namespace = ""
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(gvk)
+	object.SetNamespace(namespace)
+	object.SetName(nameOrUID)
+
+	found, err := rc.manifestRepo.Get(ctx, object)
+	if !apierrors.IsNotFound(err) {
+		return found, err
+	}
+
+	items, listErr := rc.manifestRepo.List(ctx, gvk, namespace, "")
+	if listErr != nil {
+		return nil, listErr
+	}
+	for i := range items {
+		if string(items[i].GetUID()) == nameOrUID {
+			return &items[i], nil
+		}
+	}
+
+	return nil, err
+}
+
+// cleanManifest returns a copy of the object without its status and the fields set by the cluster.
+func cleanManifest(object *unstructured.Unstructured, gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	manifest := object.DeepCopy()
+	manifest.SetGroupVersionKind(gvk)
+
+	unstructured.RemoveNestedField(manifest.Object, "status")
+	for _, v := range serverMetadataFields {
+		unstructured.RemoveNestedField(manifest.Object, "metadata", v)
+	}
+
+	if annotations := manifest.GetAnnotations(); annotations != nil {
+		delete(annotations, lastAppliedAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		manifest.SetAnnotations(annotations)
+	}
+
+	switch gvk.Kind {
+	case "Service":
+		// the cluster IPs are allocated by each cluster
+		unstructured.RemoveNestedField(manifest.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(manifest.Object, "spec", "clusterIPs")
+	case "Pod":
+		// the node the pod was scheduled to, which may not exist elsewhere
+		unstructured.RemoveNestedField(manifest.Object, "spec", "nodeName")
+	}
+
+	return manifest
+}
+
+// isClusterManaged tells if the cluster creates the object by itself, from a controller or for every namespace.
+func isClusterManaged(object *unstructured.Unstructured) bool {
+	if slices.ContainsFunc(object.GetOwnerReferences(), func(v metav1.OwnerReference) bool {
+		return v.Controller != nil && *v.Controller
+	}) {
+		return true
+	}
+
+	switch object.GetKind() {
+	case "ServiceAccount":
+		return object.GetName() == "default"
+	case "ConfigMap":
+		return object.GetName() == "kube-root-ca.crt"
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(object.Object, "type")
+		return secretType == "kubernetes.io/service-account-token"
+	case "Service":
+		return object.GetNamespace() == "default" && object.GetName() == "kubernetes"
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package uc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+)
+
+type HealthUC struct {
+	checks   []interfaces.HealthCheckInterfaces
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+// NewHealthUC creates the health use case, each check gives up after timeout (2s by default).
+func NewHealthUC(timeout time.Duration, checks ...interfaces.HealthCheckInterfaces) *HealthUC {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	return &HealthUC{
+		checks:  checks,
+		timeout: timeout,
+	}
+}
+
+// Drain makes the API report itself as not ready, so no new traffic is routed to it while shutting down.
+func (rc *HealthUC) Drain() {
+	rc.draining.Store(true)
+}
+
+// Ready runs every check concurrently, the API is ready when all of them pass and it isn't draining.
+func (rc *HealthUC) Ready(ctx context.Context) *model.HealthReport {
+	checks := make([]model.HealthCheck, len(rc.checks))
+
+	var wg sync.WaitGroup
+	for i, v := range rc.checks {
+		wg.Add(1)
+		go func(check interfaces.HealthCheckInterfaces, result *model.HealthCheck) {
+			defer wg.Done()
+			*result = rc.check(ctx, check)
+		}(v, &checks[i])
+	}
+	wg.Wait()
+
+	report := model.HealthReport{
+		Status: model.HealthStatusOK,
+		Checks: checks,
+	}
+
+	for _, v := range checks {
+		if !v.Healthy {
+			report.Status = model.HealthStatusFailing
+		}
+	}
+
+	if rc.draining.Load() {
+		report.Status = model.HealthStatusDraining
+	}
+
+	return &report
+}
+
+func (rc *HealthUC) check(ctx context.Context, check interfaces.HealthCheckInterfaces) model.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, rc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+
+	result := model.HealthCheck{
+		Name:     check.Name(),
+		Duration: time.Since(start).Round(time.Millisecond).String(),
+		Healthy:  err == nil,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
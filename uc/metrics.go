@@ -0,0 +1,276 @@
+package uc
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// The resources metrics-server reports the usage of.
+const (
+	resourceCPU    model.ResourceName = "cpu"
+	resourceMemory model.ResourceName = "memory"
+)
+
+// MetricsUC compares the resource usage reported by metrics-server with the requests and limits
+// of the containers.
+type MetricsUC struct {
+	metricsRepo   interfaces.MetricsInterfaces
+	podsRepo      interfaces.PodInterfaces
+	namespaceRepo interfaces.NamespaceInterfaces
+}
+
+func NewMetricsUC(metricsRepo interfaces.MetricsInterfaces, podsRepo interfaces.PodInterfaces, namespaceRepo interfaces.NamespaceInterfaces) *MetricsUC {
+	return &MetricsUC{
+		metricsRepo:   metricsRepo,
+		podsRepo:      podsRepo,
+		namespaceRepo: namespaceRepo,
+	}
+}
+
+// PodMetrics returns the usage of the pod and of each of its containers.
+func (rc *MetricsUC) PodMetrics(ctx context.Context, namespace, nameOrUID string) (_ *model.PodMetrics, err error) {
+	ctx, span := pkg.StartSpan(ctx, "MetricsUC.PodMetrics", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	pod, err := rc.podsRepo.GetByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := rc.metricsRepo.GetPodMetrics(ctx, pod.Namespace, pod.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, NewError(CodeNotFound, "no metrics reported for pod %s yet, metrics-server reports the running pods once a minute", pod.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	compareUsage(metrics, pod)
+
+	return metrics, nil
+}
+
+// NamespaceMetrics returns the usage of the namespace and of each of its pods reported by metrics-server.
+func (rc *MetricsUC) NamespaceMetrics(ctx context.Context, nameOrUID string) (_ *model.NamespaceMetrics, err error) {
+	ctx, span := pkg.StartSpan(ctx, "MetricsUC.NamespaceMetrics")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	namespace, err := rc.namespaceRepo.GetByNameOrUID(ctx, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := rc.metricsRepo.ListPodMetrics(ctx, namespace.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := rc.podsRepo.List(ctx, namespace.Name, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	podsByName := make(map[string]*model.Pod, len(pods.Items))
+	for i := range pods.Items {
+		podsByName[pods.Items[i].Name] = &pods.Items[i]
+	}
+
+	var cpu, memory usageSum
+	podMetrics := make([]model.PodMetrics, 0, len(metrics))
+	for i := range metrics {
+		pod, ok := podsByName[metrics[i].Name]
+		if !ok {
+			// deleted since metrics-server scraped it
+			continue
+		}
+
+		compareUsage(&metrics[i], pod)
+		cpu.add(metrics[i].CPU)
+		memory.add(metrics[i].Memory)
+		podMetrics = append(podMetrics, metrics[i])
+	}
+
+	sortPodMetrics(podMetrics, model.MetricsSortCPU)
+
+	return &model.NamespaceMetrics{
+		Namespace: namespace.Name,
+		Pods:      podMetrics,
+		CPU:       cpu.resourceUsage(resourceCPU),
+		Memory:    memory.resourceUsage(resourceMemory),
+	}, nil
+}
+
+// SortPods sorts the pods of the list from the most using the resource, and sets their usage.
+// The pods without metrics yet come last.
+func (rc *MetricsUC) SortPods(ctx context.Context, namespace string, list *model.MiniPodList, by model.MetricsSort) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "MetricsUC.SortPods", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	metrics, err := rc.metricsRepo.ListPodMetrics(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	metricsByName := make(map[string]model.PodMetrics, len(metrics))
+	for i := range metrics {
+		// the pods only matter for the requests and limits, the list sorts by usage alone
+		compareUsage(&metrics[i], &model.Pod{})
+		metricsByName[metrics[i].Name] = metrics[i]
+	}
+
+	for i := range list.Items {
+		if metrics, ok := metricsByName[list.Items[i].Name]; ok {
+			list.Items[i].Usage = &model.PodUsage{CPU: metrics.CPU.Usage, Memory: metrics.Memory.Usage}
+		}
+	}
+
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		metricsI, okI := metricsByName[list.Items[i].Name]
+		metricsJ, okJ := metricsByName[list.Items[j].Name]
+		if okI != okJ {
+			return okI
+		}
+
+		usageI, usageJ := usageOf(metricsI, by), usageOf(metricsJ, by)
+		return usageI.Cmp(usageJ) > 0
+	})
+
+	return nil
+}
+
+// compareUsage sums the usage of the containers of the pod metrics, and compares it with the
+// requests and limits of the containers of the pod.
+func compareUsage(metrics *model.PodMetrics, pod *model.Pod) {
+	containers := make(map[string]model.Container, len(pod.Spec.Containers))
+	for _, v := range pod.Spec.Containers {
+		containers[v.Name] = v
+	}
+
+	var cpu, memory usageSum
+	for i := range metrics.Containers {
+		container := &metrics.Containers[i]
+		resources := containers[container.Name].Resources
+
+		container.CPU = newResourceUsage(container.CPU.Usage, resources.Requests[resourceCPU], resources.Limits[resourceCPU])
+		container.Memory = newResourceUsage(container.Memory.Usage, resources.Requests[resourceMemory], resources.Limits[resourceMemory])
+		cpu.add(container.CPU)
+		memory.add(container.Memory)
+	}
+
+	metrics.CPU = cpu.resourceUsage(resourceCPU)
+	metrics.Memory = memory.resourceUsage(resourceMemory)
+}
+
+// newResourceUsage compares the usage with the request and the limit, the empty ones are not set.
+func newResourceUsage(usage, request, limit string) model.ResourceUsage {
+	resourceUsage := model.ResourceUsage{
+		Usage:   usage,
+		Request: request,
+		Limit:   limit,
+	}
+
+	used, err := resource.ParseQuantity(usage)
+	if err != nil {
+		return resourceUsage
+	}
+
+	resourceUsage.RequestPercent = percentOf(used, request)
+	resourceUsage.LimitPercent = percentOf(used, limit)
+
+	return resourceUsage
+}
+
+// percentOf returns the usage in percent of the quantity, nil when the quantity is not set or zero.
+func percentOf(usage resource.Quantity, quantity string) *int64 {
+	total, err := resource.ParseQuantity(quantity)
+	if err != nil || total.IsZero() {
+		return nil
+	}
+
+	percent := int64(math.Round(usage.AsApproximateFloat64() / total.AsApproximateFloat64() * 100))
+	return &percent
+}
+
+// usageSum adds up the usages of containers or pods. The sum has a limit only when every usage
+// added has one, a single container without limit can use the whole node.
+type usageSum struct {
+	usage, request, limit resource.Quantity
+	hasRequest            bool
+	unlimited             bool
+	count                 int
+}
+
+func (rc *usageSum) add(usage model.ResourceUsage) {
+	rc.count++
+
+	if quantity, err := resource.ParseQuantity(usage.Usage); err == nil {
+		rc.usage.Add(quantity)
+	}
+
+	if quantity, err := resource.ParseQuantity(usage.Request); err == nil {
+		rc.request.Add(quantity)
+		rc.hasRequest = true
+	}
+
+	if quantity, err := resource.ParseQuantity(usage.Limit); err == nil {
+		rc.limit.Add(quantity)
+	} else {
+		rc.unlimited = true
+	}
+}
+
+func (rc *usageSum) resourceUsage(name model.ResourceName) model.ResourceUsage {
+	format := func(quantity resource.Quantity) string {
+		if name == resourceCPU {
+			return resource.NewMilliQuantity(quantity.MilliValue(), resource.DecimalSI).String()
+		}
+
+		return resource.NewQuantity(quantity.Value(), resource.BinarySI).String()
+	}
+
+	var request, limit string
+	if rc.hasRequest {
+		request = format(rc.request)
+	}
+	if rc.count > 0 && !rc.unlimited {
+		limit = format(rc.limit)
+	}
+
+	return newResourceUsage(format(rc.usage), request, limit)
+}
+
+// sortPodMetrics sorts the pod metrics from the most using the resource, by name on ties.
+func sortPodMetrics(metrics []model.PodMetrics, by model.MetricsSort) {
+	sort.SliceStable(metrics, func(i, j int) bool {
+		usageI, usageJ := usageOf(metrics[i], by), usageOf(metrics[j], by)
+		if cmp := usageI.Cmp(usageJ); cmp != 0 {
+			return cmp > 0
+		}
+
+		return metrics[i].Name < metrics[j].Name
+	})
+}
+
+// usageOf returns the usage of the resource by the pod.
+func usageOf(metrics model.PodMetrics, by model.MetricsSort) resource.Quantity {
+	usage := metrics.CPU.Usage
+	if by == model.MetricsSortMemory {
+		usage = metrics.Memory.Usage
+	}
+
+	quantity, _ := resource.ParseQuantity(usage)
+	return quantity
+}
@@ -8,14 +8,16 @@ import (
 )
 
 type NamespaceUC struct {
-	namespaceRepo interfaces.NamespaceInterfaces
-	eventUC       *EventUC
+	namespaceRepo    interfaces.NamespaceInterfaces
+	clusterEventRepo interfaces.ClusterEventInterfaces
+	eventUC          *EventUC
 }
 
-func NewNamespaceUC(namespaceRepo interfaces.NamespaceInterfaces, eventUC *EventUC) *NamespaceUC {
+func NewNamespaceUC(namespaceRepo interfaces.NamespaceInterfaces, clusterEventRepo interfaces.ClusterEventInterfaces, eventUC *EventUC) *NamespaceUC {
 	return &NamespaceUC{
-		namespaceRepo: namespaceRepo,
-		eventUC:       eventUC,
+		namespaceRepo:    namespaceRepo,
+		clusterEventRepo: clusterEventRepo,
+		eventUC:          eventUC,
 	}
 }
 
@@ -26,7 +28,7 @@ func (rc *NamespaceUC) Create(ctx context.Context, request model.NamespaceCreate
 		Category: model.NamespaceCategory,
 		Type:     model.CreateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err := rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +41,7 @@ func (rc *NamespaceUC) Update(ctx context.Context, nameOrUID string, request *mo
 		Category: model.NamespaceCategory,
 		Type:     model.UpdateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err := rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +51,22 @@ func (rc *NamespaceUC) Update(ctx context.Context, nameOrUID string, request *mo
 	return rc.namespaceRepo.Update(ctx, nameOrUID, kubeNamespace, request.Opts)
 }
 
+func (rc *NamespaceUC) Patch(ctx context.Context, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (*model.Namespace, error) {
+	opts.TypeMeta.Kind = "namespace"
+
+	event := model.Event{
+		Category: model.NamespaceCategory,
+		Type:     model.UpdateEventType,
+	}
+	_, err := rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := rc.namespaceRepo.Patch(ctx, nameOrUID, patchType, data, opts)
+	return patched, preconditionError(err, opts.Preconditions)
+}
+
 func (rc *NamespaceUC) List(ctx context.Context, opts model.ListOptions) (*model.NamespaceList, error) {
 	opts.TypeMeta.Kind = "namespace"
 
@@ -59,12 +77,22 @@ func (rc *NamespaceUC) GetByNameOrUID(ctx context.Context, nameOrUID string, opt
 	return rc.namespaceRepo.GetByNameOrUID(ctx, nameOrUID, opts)
 }
 
+// ListClusterEvents lists every event reported by the cluster in the namespace.
+func (rc *NamespaceUC) ListClusterEvents(ctx context.Context, nameOrUID string, opts model.ListOptions) (*model.ClusterEventList, error) {
+	namespace, err := rc.namespaceRepo.GetByNameOrUID(ctx, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.clusterEventRepo.ListByNamespace(ctx, namespace.Name, opts)
+}
+
 func (rc *NamespaceUC) Delete(ctx context.Context, name string, opts model.DeleteOptions) error {
 	event := model.Event{
 		Category: model.NamespaceCategory,
 		Type:     model.DeleteEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err := rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
 	if err != nil {
 		return err
 	}
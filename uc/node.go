@@ -0,0 +1,365 @@
+package uc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// evictionRetryInterval is the wait before retrying an eviction refused by a PodDisruptionBudget.
+	evictionRetryInterval = 5 * time.Second
+	// deletionPollInterval is the wait between the checks of an evicted pod being gone.
+	deletionPollInterval = time.Second
+)
+
+// mirrorPodAnnotation marks the mirror pods of the static pods, run by the kubelet out of the API server reach.
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// scheduledPodsSelector leaves the terminated pods out, like kubectl describe node.
+const scheduledPodsSelector = "status.phase!=Succeeded,status.phase!=Failed"
+
+type NodeUC struct {
+	nodeRepo interfaces.NodeInterfaces
+	podsRepo interfaces.PodInterfaces
+	eventUC  *EventUC
+}
+
+func NewNodeUC(nodeRepo interfaces.NodeInterfaces, podsRepo interfaces.PodInterfaces, eventUC *EventUC) *NodeUC {
+	return &NodeUC{
+		nodeRepo: nodeRepo,
+		podsRepo: podsRepo,
+		eventUC:  eventUC,
+	}
+}
+
+// List lists the nodes along with the pods scheduled on each of them.
+func (rc *NodeUC) List(ctx context.Context, opts model.ListOptions) (_ *model.NodeList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeUC.List")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	opts.TypeMeta.Kind = "node"
+
+	list, err := rc.nodeRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := rc.scheduledPods(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list.Items {
+		list.Items[i].Pods = nodePods(pods, list.Items[i].Name)
+	}
+
+	return list, nil
+}
+
+// GetByNameOrUID returns the node along with the pods scheduled on it.
+func (rc *NodeUC) GetByNameOrUID(ctx context.Context, nameOrUID string, opts model.ListOptions) (_ *model.Node, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeUC.GetByNameOrUID")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	node, err := rc.nodeRepo.GetByNameOrUID(ctx, nameOrUID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := rc.scheduledPods(ctx, node.Name)
+	if err != nil {
+		return nil, err
+	}
+	node.Pods = nodePods(pods, node.Name)
+
+	return node, nil
+}
+
+// Cordon marks the node unschedulable, the pods running on it are left alone.
+func (rc *NodeUC) Cordon(ctx context.Context, nameOrUID string, opts model.PatchOptions) (*model.Node, error) {
+	return rc.setUnschedulable(ctx, nameOrUID, true, model.CordonEventType, opts)
+}
+
+// Uncordon marks the node schedulable again.
+func (rc *NodeUC) Uncordon(ctx context.Context, nameOrUID string, opts model.PatchOptions) (*model.Node, error) {
+	return rc.setUnschedulable(ctx, nameOrUID, false, model.UncordonEventType, opts)
+}
+
+func (rc *NodeUC) setUnschedulable(ctx context.Context, nameOrUID string, unschedulable bool, eventType string, opts model.PatchOptions) (_ *model.Node, err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeUC.SetUnschedulable", attribute.Bool("k8s.node.unschedulable", unschedulable))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	node, err := rc.nodeRepo.GetByNameOrUID(ctx, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	event := model.Event{
+		Category: model.NodeCategory,
+		Type:     eventType,
+	}
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event for %s: %w", event.Type, err)
+	}
+
+	return rc.nodeRepo.SetUnschedulable(ctx, node.Name, unschedulable, opts)
+}
+
+// Drain cordons the node and evicts its pods through the eviction API, which respects the
+// PodDisruptionBudgets, reporting each step to progress as it happens. The pods of DaemonSets
+// and the static pods are left on the node. The pods no controller recreates are only evicted
+// with opts.Force, the drain refuses to start otherwise. It fails once opts.Timeout is over.
+//
+// The errors returned before the first step is reported leave the node unchanged.
+func (rc *NodeUC) Drain(ctx context.Context, nameOrUID string, opts model.DrainOptions, progress func(model.DrainProgress)) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "NodeUC.Drain")
+	defer func() { pkg.EndSpan(span, err) }()
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	node, err := rc.nodeRepo.GetByNameOrUID(ctx, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	pods, err := rc.scheduledPods(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+
+	evictions, skipped, unmanaged := drainablePods(pods, node.Name)
+	if len(unmanaged) > 0 && !opts.Force {
+		return NewError(CodeBadRequest, "pods %s are not managed by a controller and would be lost, drain with force to evict them anyway", strings.Join(unmanaged, ", "))
+	}
+
+	event := model.Event{
+		Category: model.NodeCategory,
+		Type:     model.DrainEventType,
+	}
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to create event for %s: %w", event.Type, err)
+	}
+
+	patchOpts := model.PatchOptions{UpdateOptions: model.UpdateOptions{DryRun: opts.DryRun}}
+	if _, err := rc.nodeRepo.SetUnschedulable(ctx, node.Name, true, patchOpts); err != nil {
+		return err
+	}
+
+	// the evictions report from their own goroutines
+	var mu sync.Mutex
+	report := func(step model.DrainStep, pod *model.Pod, message string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		p := model.DrainProgress{Time: time.Now(), Step: step, Message: message}
+		if pod != nil {
+			p.Namespace, p.Pod = pod.Namespace, pod.Name
+		}
+		progress(p)
+	}
+
+	report(model.DrainStepCordoned, nil, fmt.Sprintf("node %s cordoned", node.Name))
+	for _, v := range skipped {
+		report(model.DrainStepSkipped, &v.pod, v.reason)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(evictions))
+	for i := range evictions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = rc.evictPod(ctx, &evictions[i], opts, report)
+			if errs[i] != nil {
+				report(model.DrainStepFailed, &evictions[i], errs[i].Error())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var failed []string
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, evictions[i].Namespace+"/"+evictions[i].Name)
+		}
+	}
+	if len(failed) > 0 {
+		err := fmt.Errorf("failed to evict pods %s from node %s", strings.Join(failed, ", "), node.Name)
+		if ctx.Err() != nil {
+			err = fmt.Errorf("%w: %w", err, ctx.Err())
+		}
+		return err
+	}
+
+	report(model.DrainStepDone, nil, fmt.Sprintf("node %s drained, pods evicted: %d", node.Name, len(evictions)))
+
+	return nil
+}
+
+// evictPod evicts the pod, retrying while a PodDisruptionBudget refuses it, and waits for it to be gone.
+func (rc *NodeUC) evictPod(ctx context.Context, pod *model.Pod, opts model.DrainOptions, report func(model.DrainStep, *model.Pod, string)) error {
+	report(model.DrainStepEvicting, pod, "")
+
+	deleteOpts := model.DeleteOptions{
+		DryRun:             opts.DryRun,
+		GracePeriodSeconds: opts.GracePeriodSeconds,
+	}
+	for {
+		err := rc.podsRepo.Evict(ctx, pod.Namespace, pod.Name, deleteOpts)
+		if err == nil {
+			break
+		}
+		if apierrors.IsNotFound(err) {
+			report(model.DrainStepEvicted, pod, "already gone")
+			return nil
+		}
+		if !apierrors.IsTooManyRequests(err) {
+			return err
+		}
+
+		report(model.DrainStepBlocked, pod, err.Error())
+		if err := sleep(ctx, evictionRetryInterval); err != nil {
+			return fmt.Errorf("eviction still refused: %w", err)
+		}
+	}
+
+	if len(opts.DryRun) > 0 {
+		report(model.DrainStepEvicted, pod, "dry run, the pod is left running")
+		return nil
+	}
+
+	// a pod of a StatefulSet comes back with the same name, the UID tells them apart
+	for {
+		current, err := rc.podsRepo.Get(ctx, pod.Namespace, pod.Name)
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			report(model.DrainStepEvicted, pod, "")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := sleep(ctx, deletionPollInterval); err != nil {
+			return fmt.Errorf("evicted pod still terminating: %w", err)
+		}
+	}
+}
+
+// scheduledPods lists the pods scheduled on the node, on every node when empty, in every namespace.
+func (rc *NodeUC) scheduledPods(ctx context.Context, nodeName string) ([]model.Pod, error) {
+	selector := scheduledPodsSelector
+	if nodeName != "" {
+		selector = "spec.nodeName=" + nodeName + "," + selector
+	}
+
+	list, err := rc.podsRepo.List(ctx, "", model.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	// the selector only spares the transfer, not every cluster honors it
+	pods := make([]model.Pod, 0, len(list.Items))
+	for _, v := range list.Items {
+		if v.Spec.NodeName != "" && (nodeName == "" || v.Spec.NodeName == nodeName) && v.Status.Phase != "Succeeded" && v.Status.Phase != "Failed" {
+			pods = append(pods, v)
+		}
+	}
+
+	return pods, nil
+}
+
+// nodePods returns the pods of the node among pods.
+func nodePods(pods []model.Pod, nodeName string) []model.NodePod {
+	now := time.Now()
+
+	nodePods := make([]model.NodePod, 0)
+	for i := range pods {
+		if pods[i].Spec.NodeName == nodeName {
+			nodePods = append(nodePods, model.NodePod{
+				Name:      pods[i].Name,
+				Namespace: pods[i].Namespace,
+				Phase:     pods[i].Status.Phase,
+				Summary:   pods[i].Summary(now),
+			})
+		}
+	}
+
+	return nodePods
+}
+
+type skippedPod struct {
+	pod    model.Pod
+	reason string
+}
+
+// drainablePods splits the pods of the node into the ones to evict, the ones left on the node
+// with the reason, and the names of the ones no controller would recreate.
+func drainablePods(pods []model.Pod, nodeName string) ([]model.Pod, []skippedPod, []string) {
+	evictions := make([]model.Pod, 0, len(pods))
+	skipped := make([]skippedPod, 0)
+	unmanaged := make([]string, 0)
+	for _, pod := range pods {
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			skipped = append(skipped, skippedPod{pod: pod, reason: "static pod, managed by the kubelet"})
+			continue
+		}
+
+		controller := podController(&pod)
+		switch {
+		case controller == nil:
+			unmanaged = append(unmanaged, pod.Namespace+"/"+pod.Name)
+		case controller.Kind == "DaemonSet":
+			skipped = append(skipped, skippedPod{pod: pod, reason: "managed by DaemonSet " + controller.Name})
+			continue
+		}
+
+		evictions = append(evictions, pod)
+	}
+
+	return evictions, skipped, unmanaged
+}
+
+// podController returns the owner reference of the controller of the pod, nil when it has none.
+func podController(pod *model.Pod) *model.OwnerReference {
+	for i, v := range pod.OwnerReferences {
+		if v.Controller != nil && *v.Controller {
+			return &pod.OwnerReferences[i]
+		}
+	}
+
+	return nil
+}
+
+// sleep waits for d, or returns the error of ctx when it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -5,22 +5,35 @@ import (
 	"fmt"
 
 	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/pkg"
 	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// latestWarningsLimit caps the cluster warnings attached to a single pod or deployment.
+const latestWarningsLimit = 5
+
 type PodUC struct {
-	podsRepo interfaces.PodInterfaces
-	eventUC  *EventUC
+	podsRepo         interfaces.PodInterfaces
+	clusterEventRepo interfaces.ClusterEventInterfaces
+	referenceRepo    interfaces.ReferenceInterfaces
+	eventUC          *EventUC
 }
 
-func NewPodUC(podsRepo interfaces.PodInterfaces, eventUC *EventUC) *PodUC {
+func NewPodUC(podsRepo interfaces.PodInterfaces, clusterEventRepo interfaces.ClusterEventInterfaces, referenceRepo interfaces.ReferenceInterfaces, eventUC *EventUC) *PodUC {
 	return &PodUC{
-		podsRepo: podsRepo,
-		eventUC:  eventUC,
+		podsRepo:         podsRepo,
+		clusterEventRepo: clusterEventRepo,
+		referenceRepo:    referenceRepo,
+		eventUC:          eventUC,
 	}
 }
 
-func (rc *PodUC) Create(ctx context.Context, request model.PodsCreateRequest) (*model.Pod, error) {
+func (rc *PodUC) Create(ctx context.Context, request model.PodsCreateRequest) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.Create")
+	defer func() { pkg.EndSpan(span, err) }()
+
 	request.Pod.TypeMeta.Kind = "pod"
 	if request.Pod.ObjectMeta.Namespace == "" {
 		request.Pod.ObjectMeta.Namespace = "default"
@@ -30,20 +43,31 @@ func (rc *PodUC) Create(ctx context.Context, request model.PodsCreateRequest) (*
 		Category: model.PodCategory,
 		Type:     model.CreateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, err
 	}
 
-	return rc.podsRepo.Create(ctx, &request.Pod, request.Opts)
+	pod, err := rc.podsRepo.Create(ctx, &request.Pod, request.Opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the references are checked once the pod is accepted, they may still be created afterwards
+	pod.Warnings = envReferenceWarnings(ctx, rc.referenceRepo, request.Pod.Namespace, &request.Pod.Spec)
+
+	return pod, nil
 }
 
-func (rc *PodUC) Update(ctx context.Context, namespace, id string, request *model.PodsUpdateRequest) (*model.Pod, error) {
+func (rc *PodUC) Update(ctx context.Context, namespace, id string, request *model.PodsUpdateRequest) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.Update", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	event := model.Event{
 		Category: model.PodCategory,
 		Type:     model.UpdateEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, request.Opts.DryRun)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event for %s: %w", event.Type, err)
 	}
@@ -51,10 +75,36 @@ func (rc *PodUC) Update(ctx context.Context, namespace, id string, request *mode
 	kubePod := rc.fillPod(request)
 	kubePod.Namespace = namespace
 
-	return rc.podsRepo.Update(ctx, id, kubePod, request.Opts)
+	updated, err := rc.podsRepo.Update(ctx, id, kubePod, request.Opts)
+	return updated, preconditionError(err, request.Opts.Preconditions)
 }
 
-func (rc *PodUC) List(ctx context.Context, namespace string, opts model.ListOptions) (*model.PodList, error) {
+func (rc *PodUC) Patch(ctx context.Context, namespace, nameOrUID string, patchType model.PatchType, data []byte, opts model.PatchOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.Patch", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	opts.TypeMeta.Kind = "pod"
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	event := model.Event{
+		Category: model.PodCategory,
+		Type:     model.UpdateEventType,
+	}
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event for %s: %w", event.Type, err)
+	}
+
+	patched, err := rc.podsRepo.Patch(ctx, namespace, nameOrUID, patchType, data, opts)
+	return patched, preconditionError(err, opts.Preconditions)
+}
+
+func (rc *PodUC) List(ctx context.Context, namespace string, opts model.ListOptions) (_ *model.PodList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.List", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	opts.TypeMeta.Kind = "pod"
 	if namespace == "" {
 		namespace = "default"
@@ -63,11 +113,41 @@ func (rc *PodUC) List(ctx context.Context, namespace string, opts model.ListOpti
 	return rc.podsRepo.List(ctx, namespace, opts)
 }
 
-func (rc *PodUC) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (*model.Pod, error) {
-	return rc.podsRepo.GetByNameOrUID(ctx, namespace, nameOrUID, opts)
+func (rc *PodUC) GetByNameOrUID(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.Pod, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.GetByNameOrUID", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	pod, err := rc.podsRepo.GetByNameOrUID(ctx, namespace, nameOrUID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// warnings are best effort, the pod is still returned when events can't be read
+	events, err := rc.clusterEventRepo.ListByObject(ctx, podReference(pod), model.ListOptions{})
+	if err == nil {
+		pod.Warnings = events.LatestWarnings(latestWarningsLimit)
+	}
+
+	return pod, nil
+}
+
+// ListClusterEvents lists the events reported by the cluster for the pod.
+func (rc *PodUC) ListClusterEvents(ctx context.Context, namespace, nameOrUID string, opts model.ListOptions) (_ *model.ClusterEventList, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.ListClusterEvents", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	pod, err := rc.podsRepo.GetByNameOrUID(ctx, namespace, nameOrUID, model.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.clusterEventRepo.ListByObject(ctx, podReference(pod), opts)
 }
 
-func (rc *PodUC) Delete(ctx context.Context, namespace, name string, opts model.DeleteOptions) error {
+func (rc *PodUC) Delete(ctx context.Context, namespace, name string, opts model.DeleteOptions) (err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.Delete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
 	opts.TypeMeta.Kind = "pod"
 	if namespace == "" {
 		namespace = "default"
@@ -77,12 +157,71 @@ func (rc *PodUC) Delete(ctx context.Context, namespace, name string, opts model.
 		Category: model.PodCategory,
 		Type:     model.DeleteEventType,
 	}
-	_, err := rc.eventUC.Create(ctx, &event)
+	_, err = rc.eventUC.CreateUnlessDryRun(ctx, &event, opts.DryRun)
 	if err != nil {
 		return fmt.Errorf("failed to create event for %s: %w", event.Type, err)
 	}
 
-	return rc.podsRepo.Delete(ctx, namespace, name, opts)
+	err = rc.podsRepo.Delete(ctx, namespace, name, opts)
+	return preconditionError(err, opts.Preconditions)
+}
+
+// PreviewBulkDelete lists the pods matched by the label selector, with the token confirming their deletion.
+func (rc *PodUC) PreviewBulkDelete(ctx context.Context, namespace string, opts model.BulkDeleteOptions) (_ *model.BulkDeletePreview, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.PreviewBulkDelete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	if err := checkBulkDeleteOptions(&opts, true); err != nil {
+		return nil, err
+	}
+
+	list, err := rc.podsRepo.List(ctx, namespace, model.ListOptions{LabelSelector: opts.LabelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]model.ObjectReference, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, podReference(&list.Items[i]))
+	}
+
+	return newBulkDeletePreview("Pod", namespace, opts, items), nil
+}
+
+// BulkDelete deletes, or evicts, the pods matched by the label selector once the token of their
+// preview confirms them. It is refused when the pods matched changed since the preview.
+func (rc *PodUC) BulkDelete(ctx context.Context, namespace string, opts model.BulkDeleteOptions) (_ *model.BulkDeleteResult, err error) {
+	ctx, span := pkg.StartSpan(ctx, "PodUC.BulkDelete", attribute.String("k8s.namespace.name", namespace))
+	defer func() { pkg.EndSpan(span, err) }()
+
+	preview, err := rc.PreviewBulkDelete(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Action = preview.Action
+	opts.TypeMeta.Kind = "pod"
+
+	return runBulkDelete(ctx, rc.eventUC, model.PodCategory, preview, opts, func(ctx context.Context, pod model.ObjectReference, deleteOpts model.DeleteOptions) error {
+		if opts.Action == model.BulkActionEvict {
+			return rc.podsRepo.Evict(ctx, pod.Namespace, pod.Name, deleteOpts)
+		}
+
+		return rc.podsRepo.Delete(ctx, pod.Namespace, pod.Name, deleteOpts)
+	})
+}
+
+func podReference(pod *model.Pod) model.ObjectReference {
+	return model.ObjectReference{
+		Kind:      "Pod",
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		UID:       pod.UID,
+	}
 }
 
 func (rc *PodUC) fillPod(podRequest *model.PodsUpdateRequest) *model.Pod {
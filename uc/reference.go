@@ -0,0 +1,113 @@
+package uc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/fleimkeipa/kubernetes-api/model"
+	"github.com/fleimkeipa/kubernetes-api/repositories/interfaces"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// referenceChecker finds the config maps, secrets and keys referenced by the environment of the
+// containers of a pod but missing from its namespace. Each object is looked up once.
+type referenceChecker struct {
+	referenceRepo interfaces.ReferenceInterfaces
+	namespace     string
+	keys          map[string][]string
+	errs          map[string]error
+}
+
+// envReferenceWarnings returns a warning for each config map, secret or key referenced by the environment
+// of the containers and missing from the namespace. The optional references are not checked, and the
+// pod is created anyway: the missing objects may be created later on, until then its containers don't start.
+func envReferenceWarnings(ctx context.Context, referenceRepo interfaces.ReferenceInterfaces, namespace string, spec *model.PodSpec) []model.ClusterEventWarning {
+	checker := &referenceChecker{
+		referenceRepo: referenceRepo,
+		namespace:     namespace,
+		keys:          make(map[string][]string),
+		errs:          make(map[string]error),
+	}
+
+	messages := make([]string, 0)
+	add := func(message string) {
+		if message != "" && !slices.Contains(messages, message) {
+			messages = append(messages, message)
+		}
+	}
+
+	for _, container := range slices.Concat(spec.InitContainers, spec.Containers) {
+		for _, v := range container.EnvFrom {
+			if ref := v.ConfigMapRef; ref != nil && !isOptional(ref.Optional) {
+				add(checker.check(ctx, "configmap", ref.Name, "", container.Name))
+			}
+			if ref := v.SecretRef; ref != nil && !isOptional(ref.Optional) {
+				add(checker.check(ctx, "secret", ref.Name, "", container.Name))
+			}
+		}
+
+		for _, v := range container.Env {
+			if v.ValueFrom == nil {
+				continue
+			}
+			if ref := v.ValueFrom.ConfigMapKeyRef; ref != nil && !isOptional(ref.Optional) {
+				add(checker.check(ctx, "configmap", ref.Name, ref.Key, container.Name))
+			}
+			if ref := v.ValueFrom.SecretKeyRef; ref != nil && !isOptional(ref.Optional) {
+				add(checker.check(ctx, "secret", ref.Name, ref.Key, container.Name))
+			}
+		}
+	}
+
+	now := time.Now()
+	warnings := make([]model.ClusterEventWarning, 0, len(messages))
+	for _, v := range messages {
+		warnings = append(warnings, model.ClusterEventWarning{
+			LastTimestamp: now,
+			Reason:        model.WarningReasonReferenceNotFound,
+			Message:       v,
+		})
+	}
+
+	return warnings
+}
+
+// check returns what is missing of the object of the kind, configmap or secret, and of its key when not empty.
+// It returns an empty message when nothing is missing.
+func (rc *referenceChecker) check(ctx context.Context, kind, name, key, container string) string {
+	id := kind + "/" + name
+	if _, ok := rc.keys[id]; !ok && rc.errs[id] == nil {
+		var keys []string
+		var err error
+		if kind == "secret" {
+			keys, err = rc.referenceRepo.SecretKeys(ctx, rc.namespace, name)
+		} else {
+			keys, err = rc.referenceRepo.ConfigMapKeys(ctx, rc.namespace, name)
+		}
+		if err != nil {
+			rc.errs[id] = err
+		} else {
+			rc.keys[id] = keys
+		}
+	}
+
+	if err := rc.errs[id]; err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("%s %q not found in namespace %s, referenced by container %s", kind, name, rc.namespace, container)
+		}
+		return fmt.Sprintf("unable to check %s %q referenced by container %s: %v", kind, name, container, err)
+	}
+
+	if key != "" && !slices.Contains(rc.keys[id], key) {
+		return fmt.Sprintf("key %q not found in %s %q, referenced by container %s", key, kind, name, container)
+	}
+
+	return ""
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
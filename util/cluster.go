@@ -0,0 +1,31 @@
+package util
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ClusterSelector stores the cluster chosen by the cluster path or query parameter on the request context
+func ClusterSelector(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		cluster := c.Param("cluster")
+		if cluster == "" {
+			cluster = c.QueryParam("cluster")
+		}
+
+		if cluster != "" {
+			ctx := context.WithValue(c.Request().Context(), "cluster", cluster)
+			c.SetRequest(c.Request().WithContext(ctx))
+		}
+
+		return next(c)
+	}
+}
+
+// GetClusterFromCtx returns the cluster selected for the request, empty when the default cluster should be used
+func GetClusterFromCtx(ctx context.Context) string {
+	cluster, _ := ctx.Value("cluster").(string)
+
+	return cluster
+}
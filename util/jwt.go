@@ -7,15 +7,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/fleimkeipa/kubernetes-api/config"
 	"github.com/fleimkeipa/kubernetes-api/model"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
-	"github.com/spf13/viper"
 )
 
-// retrieve JWT key from .env file
-var privateKey = []byte(viper.GetString("jwt.private_key"))
+// privateKey returns the JWT signing key of the loaded configuration
+func privateKey() []byte {
+	return []byte(config.Get().JWT.PrivateKey)
+}
 
 // generate JWT token
 func GenerateJWT(user *model.User) (string, error) {
@@ -25,10 +27,10 @@ func GenerateJWT(user *model.User) (string, error) {
 		"email":    user.Email,
 		"role":     user.RoleID,
 		"iat":      time.Now().Unix(),
-		"eat":      time.Now().Add(time.Second * time.Duration(viper.GetInt("jwt.token_ttl"))).Unix(),
+		"eat":      time.Now().Add(time.Second * time.Duration(config.Get().JWT.TokenTTL)).Unix(),
 	})
 
-	return token.SignedString(privateKey)
+	return token.SignedString(privateKey())
 }
 
 // validate JWT token
@@ -184,7 +186,7 @@ func getToken(context echo.Context) (*jwt.Token, error) {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 
-		return privateKey, nil
+		return privateKey(), nil
 	})
 
 	return token, err
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/fleimkeipa/kubernetes-api/config"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter limits the requests of each client IP to rate_limit of the configuration.
+// The limit follows configuration reloads and a zero rate lets every request through.
+func RateLimiter() echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: &reloadableRateLimiterStore{},
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		ErrorHandler: func(c echo.Context, err error) error {
+			return c.JSON(http.StatusForbidden, echo.Map{"error": "Unable to identify the client"})
+		},
+		DenyHandler: func(c echo.Context, identifier string, err error) error {
+			return c.JSON(http.StatusTooManyRequests, echo.Map{"error": "Too many requests"})
+		},
+	})
+}
+
+// reloadableRateLimiterStore starts over with a new memory store whenever the configured limit changes.
+type reloadableRateLimiterStore struct {
+	store *middleware.RateLimiterMemoryStore
+	limit config.RateLimitConfig
+	mu    sync.Mutex
+}
+
+func (rc *reloadableRateLimiterStore) Allow(identifier string) (bool, error) {
+	limit := config.Get().RateLimit
+	if limit.RequestsPerSecond <= 0 {
+		return true, nil
+	}
+
+	rc.mu.Lock()
+	if rc.store == nil || rc.limit != limit {
+		rc.store = middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:  rate.Limit(limit.RequestsPerSecond),
+			Burst: limit.Burst,
+		})
+		rc.limit = limit
+	}
+	store := rc.store
+	rc.mu.Unlock()
+
+	return store.Allow(identifier)
+}